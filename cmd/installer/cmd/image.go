@@ -16,9 +16,12 @@ import (
 	"github.com/talos-systems/go-cmd/pkg/cmd"
 
 	"github.com/talos-systems/talos/cmd/installer/pkg"
+	"github.com/talos-systems/talos/cmd/installer/pkg/gcp"
 	"github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/cmd/installer/pkg/ova"
 	"github.com/talos-systems/talos/cmd/installer/pkg/qemuimg"
+	"github.com/talos-systems/talos/cmd/installer/pkg/vhd"
+	"github.com/talos-systems/talos/cmd/installer/pkg/vhdx"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
 	"github.com/talos-systems/talos/pkg/archiver"
@@ -28,6 +31,7 @@ import (
 var (
 	outputArg   string
 	tarToStdout bool
+	vhdxOutput  bool
 )
 
 // imageCmd represents the image command.
@@ -45,6 +49,7 @@ var imageCmd = &cobra.Command{
 func init() {
 	imageCmd.Flags().StringVar(&outputArg, "output", "/out", "The output path")
 	imageCmd.Flags().BoolVar(&tarToStdout, "tar-to-stdout", false, "Tar output and send to stdout")
+	imageCmd.Flags().BoolVar(&vhdxOutput, "vhdx", false, "Additionally output the image in the Hyper-V VHDX format")
 	rootCmd.AddCommand(imageCmd)
 }
 
@@ -96,6 +101,14 @@ func runImageCmd() (err error) {
 		return err
 	}
 
+	if vhdxOutput {
+		log.Print("creating VHDX image")
+
+		if err = vhdx.CreateFromRAW(img, filepath.Join(outputArg, fmt.Sprintf("%s-%s.vhdx", p.Name(), options.Arch))); err != nil {
+			return err
+		}
+	}
+
 	if err := finalize(p, img, options.Arch); err != nil {
 		return err
 	}
@@ -124,7 +137,7 @@ func finalize(platform runtime.Platform, img, arch string) (err error) {
 	case "azure":
 		file = name + ".vhd"
 
-		if err = qemuimg.Convert("raw", "vpc", "subformat=fixed,force_size", img, filepath.Join(dir, file)); err != nil {
+		if err = vhd.CreateFixedFromRAW(img, filepath.Join(dir, file)); err != nil {
 			return err
 		}
 
@@ -136,7 +149,7 @@ func finalize(platform runtime.Platform, img, arch string) (err error) {
 			return err
 		}
 	case "gcp":
-		if err = tar(fmt.Sprintf("gcp-%s.tar.gz", arch), file, dir); err != nil {
+		if err = gcp.CreateTarballFromRAW(img, filepath.Join(outputArg, fmt.Sprintf("gcp-%s.tar.gz", arch))); err != nil {
 			return err
 		}
 	case "hcloud":
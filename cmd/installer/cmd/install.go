@@ -14,7 +14,9 @@ import (
 	"github.com/talos-systems/talos/cmd/installer/pkg/install"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/version"
 )
 
@@ -78,6 +80,24 @@ func runInstallCmd() (err error) {
 		if config.Machine().Install().LegacyBIOSSupport() {
 			options.LegacyBIOSSupport = true
 		}
+
+		options.EphemeralMaxSize = config.Machine().Install().EphemeralMaxSize()
+
+		for _, partition := range config.Machine().Install().ExtraPartitions() {
+			options.ExtraPartitions = append(options.ExtraPartitions, install.ExtraPartition{
+				Label: partition.Label(),
+				Size:  partition.Size(),
+			})
+		}
+
+		if raidDevices := config.Machine().Install().RAIDDevices(); len(raidDevices) > 0 {
+			if err = raid.CheckAvailable(); err != nil {
+				return err
+			}
+
+			options.RAIDDevices = raidDevices
+			options.Disk = raid.DevicePath(constants.SystemRAIDArrayName)
+		}
 	}
 
 	return install.Install(p, seq, options)
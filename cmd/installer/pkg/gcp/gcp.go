@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package gcp implements GCP image tarball creation.
+//
+// Image format reference: https://cloud.google.com/compute/docs/import/import-existing-image#requirements.
+package gcp
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// DiskName is the name of the disk image in the tarball, as required by GCP.
+const DiskName = "disk.raw"
+
+// CreateTarballFromRAW creates the gzipped tarball with the raw disk image stored as disk.raw.
+func CreateTarballFromRAW(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	st, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close() //nolint:errcheck
+
+	if err = WriteTarball(out, in, st.Size(), st.ModTime()); err != nil {
+		return fmt.Errorf("error writing GCP tarball: %w", err)
+	}
+
+	return out.Close()
+}
+
+// WriteTarball writes the gzipped tarball with the single disk.raw file.
+//
+// GCP requires the tarball to be in the GNU format.
+func WriteTarball(out io.Writer, in io.Reader, size int64, modTime time.Time) error {
+	zw := gzip.NewWriter(out)
+	tw := tar.NewWriter(zw)
+
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     DiskName,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  modTime,
+		Format:   tar.FormatGNU,
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.CopyN(tw, in, size); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package gcp_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/gcp"
+)
+
+func TestCreateTarballFromRAW(t *testing.T) {
+	dir := t.TempDir()
+
+	src := filepath.Join(dir, "image.raw")
+	dst := filepath.Join(dir, "gcp-amd64.tar.gz")
+
+	raw := make([]byte, 1024*1024)
+	copy(raw[512:], "talos")
+
+	require.NoError(t, os.WriteFile(src, raw, 0o644))
+
+	require.NoError(t, gcp.CreateTarballFromRAW(src, dst))
+
+	f, err := os.Open(dst)
+	require.NoError(t, err)
+
+	defer f.Close() //nolint:errcheck
+
+	zr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	tr := tar.NewReader(zr)
+
+	hdr, err := tr.Next()
+	require.NoError(t, err)
+
+	assert.Equal(t, gcp.DiskName, hdr.Name)
+	assert.Equal(t, tar.FormatGNU, hdr.Format)
+	assert.EqualValues(t, len(raw), hdr.Size)
+
+	contents, err := io.ReadAll(tr)
+	require.NoError(t, err)
+
+	assert.Equal(t, raw, contents)
+
+	_, err = tr.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
 	"github.com/talos-systems/talos/internal/pkg/mount"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/kernel"
 	"github.com/talos-systems/talos/pkg/version"
@@ -36,6 +37,15 @@ type Options struct {
 	Force             bool
 	Zero              bool
 	LegacyBIOSSupport bool
+	EphemeralMaxSize  uint64
+	ExtraPartitions   []ExtraPartition
+	RAIDDevices       []string
+}
+
+// ExtraPartition represents an additional partition on the installation disk.
+type ExtraPartition struct {
+	Label string
+	Size  uint64
 }
 
 // Install installs Talos.
@@ -107,9 +117,11 @@ func NewInstaller(cmdline *procfs.Cmdline, seq runtime.Sequence, opts *Options)
 
 // Verify existence of boot partition.
 func (i *Installer) probeBootPartition() error {
-	// there's no reason to discover boot partition if the disk is about to be wiped
-	if !i.options.Zero {
-		dev, err := blockdevice.Open(i.options.Disk)
+	// there's no reason to discover boot partition if the disk is about to be wiped,
+	// or if the system RAID array doesn't exist yet
+	if !i.options.Zero && !i.raidArrayMissing() {
+		// partition paths are derived from the device name, so the array path is resolved to the kernel device
+		dev, err := blockdevice.Open(raid.Resolve(i.options.Disk))
 		if err != nil {
 			i.bootPartitionFound = false
 
@@ -170,6 +182,10 @@ func (i *Installer) probeBootPartition() error {
 	return err
 }
 
+func (i *Installer) raidArrayMissing() bool {
+	return len(i.options.RAIDDevices) > 0 && !raid.Exists(constants.SystemRAIDArrayName)
+}
+
 // Install fetches the necessary data locations and copies or extracts
 // to the target locations.
 //
@@ -199,7 +215,14 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 	// Mount the partitions.
 	mountpoints := mount.NewMountPoints()
 
-	for _, label := range []string{constants.BootPartitionLabel, constants.EFIPartitionLabel} {
+	labels := []string{constants.BootPartitionLabel, constants.EFIPartitionLabel}
+
+	if len(i.options.RAIDDevices) > 0 {
+		// EFI partitions of the RAID member devices are mounted one by one while installing the bootloader
+		labels = []string{constants.BootPartitionLabel}
+	}
+
+	for _, label := range labels {
 		err = func() error {
 			var device string
 			// searching targets for the device to be used
@@ -291,7 +314,12 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 
 	i.bootloader = conf
 
-	err = i.bootloader.Install(i.options.Disk, i.options.Arch)
+	if len(i.options.RAIDDevices) > 0 {
+		err = i.installBootloaderOnRAIDMembers()
+	} else {
+		err = i.bootloader.Install(i.options.Disk, i.options.Arch)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -332,3 +360,44 @@ func (i *Installer) Install(seq runtime.Sequence) (err error) {
 
 	return nil
 }
+
+// installBootloaderOnRAIDMembers installs the bootloader on each member device of the system RAID array,
+// so that the machine can boot from any of them.
+func (i *Installer) installBootloaderOnRAIDMembers() error {
+	for _, device := range i.options.RAIDDevices {
+		if err := i.installBootloaderOnRAIDMember(device); err != nil {
+			return fmt.Errorf("error installing bootloader on %q: %w", device, err)
+		}
+	}
+
+	return nil
+}
+
+func (i *Installer) installBootloaderOnRAIDMember(device string) error {
+	bd, err := blockdevice.Open(device)
+	if err != nil {
+		return err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	mountpoint, err := mount.SystemMountPointForLabel(bd, constants.EFIPartitionLabel)
+	if err != nil {
+		return err
+	}
+
+	mountpoints := mount.NewMountPoints()
+	mountpoints.Set(constants.EFIPartitionLabel, mountpoint)
+
+	if err = mount.Mount(mountpoints); err != nil {
+		return err
+	}
+
+	defer func() {
+		if e := mount.Unmount(mountpoints); e != nil {
+			log.Printf("failed to unmount: %v", e)
+		}
+	}()
+
+	return i.bootloader.Install(device, i.options.Arch)
+}
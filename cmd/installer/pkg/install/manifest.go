@@ -22,7 +22,9 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/board"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/internal/pkg/partition"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	machineryraid "github.com/talos-systems/talos/pkg/machinery/raid"
 )
 
 // Manifest represents the instructions for preparing all block devices
@@ -31,9 +33,22 @@ type Manifest struct {
 	PartitionOptions  *runtime.PartitionOptions
 	Devices           map[string]Device
 	Targets           map[string][]*Target
+	RAIDArrays        map[string]RAIDArray
 	LegacyBIOSSupport bool
 }
 
+// RAIDArray represents the software RAID array assembled from the partitions on the member devices.
+//
+// Targets for the array are keyed by the array path in the manifest.
+type RAIDArray struct {
+	Name    string
+	Level   machineryraid.Level
+	Members []*Target
+
+	// Create forces creating a new array, otherwise the existing array is assembled.
+	Create bool
+}
+
 // Device represents device options.
 type Device struct {
 	Device string
@@ -55,6 +70,7 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 	manifest = &Manifest{
 		Devices:           map[string]Device{},
 		Targets:           map[string][]*Target{},
+		RAIDArrays:        map[string]RAIDArray{},
 		LegacyBIOSSupport: opts.LegacyBIOSSupport,
 	}
 
@@ -99,11 +115,26 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		return nil, err
 	}
 
+	extraTargets, err := extraPartitionTargets(sequence, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// extra partitions which already exist on the disk are preserved on upgrades,
+	// so the partition table can't be reset
+	preserveExtraPartitions := false
+
+	for _, target := range extraTargets {
+		if target.Skip {
+			preserveExtraPartitions = true
+		}
+	}
+
 	manifest.Devices[opts.Disk] = Device{
 		Device: opts.Disk,
 
-		ResetPartitionTable: opts.Force,
-		Zero:                opts.Zero,
+		ResetPartitionTable: opts.Force && !preserveExtraPartitions,
+		Zero:                opts.Zero && !preserveExtraPartitions,
 
 		SkipOverlayMountsCheck: skipOverlayMountsCheck,
 	}
@@ -115,8 +146,14 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 		manifest.Targets[opts.Disk] = []*Target{}
 	}
 
-	efiTarget := EFITarget(opts.Disk, nil)
-	biosTarget := BIOSTarget(opts.Disk, nil)
+	var targets []*Target
+
+	if len(opts.RAIDDevices) > 0 {
+		// EFI and BIOS boot partitions can't be put on the array, so they are created on each member device
+		manifest.addRAIDMembers(sequence, skipOverlayMountsCheck, opts)
+	} else {
+		targets = append(targets, EFITarget(opts.Disk, nil), BIOSTarget(opts.Disk, nil))
+	}
 
 	var bootTarget *Target
 
@@ -149,10 +186,19 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 
 	ephemeralTarget := EphemeralTarget(opts.Disk, NoFilesystem)
 
-	targets := []*Target{efiTarget, biosTarget, bootTarget, metaTarget, stateTarget, ephemeralTarget}
+	if !preserveExtraPartitions {
+		ephemeralTarget.PartitionSize = opts.EphemeralMaxSize
+	}
+
+	targets = append(targets, bootTarget, metaTarget, stateTarget, ephemeralTarget)
+	targets = append(targets, extraTargets...)
 
 	if !opts.Force {
 		for _, target := range targets {
+			if target == nil {
+				continue
+			}
+
 			target.Force = false
 			target.Skip = true
 		}
@@ -169,17 +215,158 @@ func NewManifest(label string, sequence runtime.Sequence, bootPartitionFound boo
 	return manifest, nil
 }
 
+// addRAIDMembers adds member devices of the system RAID array to the manifest.
+//
+// On upgrades member devices are left intact, as the array is in use.
+func (m *Manifest) addRAIDMembers(sequence runtime.Sequence, skipOverlayMountsCheck bool, opts *Options) {
+	preserve := sequence == runtime.SequenceUpgrade || !opts.Force
+
+	array := RAIDArray{
+		Name:   constants.SystemRAIDArrayName,
+		Level:  machineryraid.Level1,
+		Create: !preserve,
+	}
+
+	for _, device := range opts.RAIDDevices {
+		m.Devices[device] = Device{
+			Device: device,
+
+			ResetPartitionTable: !preserve,
+			Zero:                opts.Zero && !preserve,
+
+			SkipOverlayMountsCheck: skipOverlayMountsCheck,
+		}
+
+		extra := &Target{Skip: preserve}
+
+		raidTarget := RAIDTarget(device, extra)
+
+		m.Targets[device] = []*Target{
+			EFITarget(device, extra),
+			BIOSTarget(device, extra),
+			raidTarget,
+		}
+
+		array.Members = append(array.Members, raidTarget)
+	}
+
+	m.RAIDArrays[opts.Disk] = array
+}
+
+// extraPartitionTargets builds the targets for the additional partitions on the system disk.
+//
+// Extra partitions are created only on install, on upgrades existing extra partitions are preserved.
+func extraPartitionTargets(sequence runtime.Sequence, opts *Options) ([]*Target, error) {
+	if len(opts.ExtraPartitions) == 0 {
+		return nil, nil
+	}
+
+	targets := make([]*Target, 0, len(opts.ExtraPartitions))
+
+	if sequence != runtime.SequenceUpgrade {
+		for _, extraPartition := range opts.ExtraPartitions {
+			targets = append(targets, ExtraPartitionTarget(opts.Disk, extraPartition, nil))
+		}
+
+		return targets, nil
+	}
+
+	bd, err := blockdevice.Open(opts.Disk)
+	if err != nil {
+		return nil, err
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	pt, err := bd.PartitionTable()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extraPartition := range opts.ExtraPartitions {
+		if pt.Partitions().FindByName(extraPartition.Label) == nil {
+			log.Printf("extra partition %q not found on %q, extra partitions are not created on upgrades", extraPartition.Label, opts.Disk)
+
+			continue
+		}
+
+		targets = append(targets, ExtraPartitionTarget(opts.Disk, extraPartition, &Target{Skip: true}))
+	}
+
+	return targets, nil
+}
+
 // Execute partitions and formats all disks in a manifest.
 func (m *Manifest) Execute() (err error) {
+	// stop the arrays which are going to be re-created, so that member devices are released
+	for _, array := range m.RAIDArrays {
+		if array.Create && raid.Exists(array.Name) {
+			if err = raid.Stop(array.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	// member devices should be partitioned before the arrays are built
 	for dev, targets := range m.Targets {
+		if _, isArray := m.RAIDArrays[dev]; isArray {
+			continue
+		}
+
 		if err = m.executeOnDevice(m.Devices[dev], targets); err != nil {
 			return err
 		}
 	}
 
+	for dev, array := range m.RAIDArrays {
+		if err = m.executeOnArray(dev, array); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// executeOnArray builds the array from the member partitions and partitions the array itself.
+func (m *Manifest) executeOnArray(dev string, array RAIDArray) (err error) {
+	members := make([]string, 0, len(array.Members))
+
+	for _, target := range array.Members {
+		members = append(members, target.PartitionName)
+	}
+
+	if array.Create {
+		err = raid.Create(array.Name, array.Level, members)
+	} else {
+		err = raid.Ensure(array.Name, array.Level, members)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// partition paths are derived from the kernel device name, so replace /dev/md/<name> with /dev/mdN
+	resolved := raid.Resolve(dev)
+
+	device := m.Devices[dev]
+	device.Device = resolved
+
+	targets := m.Targets[dev]
+
+	for _, target := range targets {
+		target.Device = resolved
+	}
+
+	delete(m.Devices, dev)
+	delete(m.Targets, dev)
+	delete(m.RAIDArrays, dev)
+
+	m.Devices[resolved] = device
+	m.Targets[resolved] = targets
+
+	return m.executeOnDevice(device, targets)
+}
+
 // checkMounts verifies that no active mounts in any mount namespace exist for the device.
 //
 //nolint:gocyclo
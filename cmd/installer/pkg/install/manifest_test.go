@@ -324,6 +324,159 @@ func (suite *manifestSuite) TestExecuteManifestPreserve() {
 	suite.verifyBlockdevice(manifest, "A", "B", true, true)
 }
 
+func (suite *manifestSuite) verifyExtraPartitions(ephemeralSize, cacheSize uint64) {
+	bd, err := blockdevice.Open(suite.loopbackDevice.Name())
+	suite.Require().NoError(err)
+
+	defer bd.Close() //nolint:errcheck
+
+	table, err := bd.PartitionTable()
+	suite.Require().NoError(err)
+
+	suite.Require().Len(table.Partitions().Items(), 8)
+
+	part := table.Partitions().Items()[5]
+	suite.Assert().Equal(constants.EphemeralPartitionLabel, part.Name)
+	suite.Assert().EqualValues(ephemeralSize/lbaSize, part.Length())
+
+	part = table.Partitions().Items()[6]
+	suite.Assert().Equal(partition.LinuxFilesystemData, strings.ToUpper(part.Type.String()))
+	suite.Assert().Equal("CACHE", part.Name)
+	suite.Assert().EqualValues(cacheSize/lbaSize, part.Length())
+
+	part = table.Partitions().Items()[7]
+	suite.Assert().Equal(partition.LinuxFilesystemData, strings.ToUpper(part.Type.String()))
+	suite.Assert().Equal("LOCAL-PV", part.Name)
+	suite.Assert().EqualValues((diskSize-partition.EFISize-partition.BIOSGrubSize-partition.BootSize-partition.MetaSize-partition.StateSize-ephemeralSize-cacheSize)/lbaSize-gptReserved, part.Length())
+
+	suite.Assert().NoError(bd.Close())
+}
+
+func (suite *manifestSuite) TestExecuteManifestExtraPartitions() {
+	suite.skipUnderBuildkit()
+
+	const (
+		ephemeralSize = 1024 * 1024 * 1024 // 1 GiB
+		cacheSize     = 256 * 1024 * 1024  // 256 MiB
+	)
+
+	opts := &install.Options{
+		Disk:             suite.loopbackDevice.Name(),
+		Bootloader:       true,
+		Force:            true,
+		Board:            constants.BoardNone,
+		EphemeralMaxSize: ephemeralSize,
+		ExtraPartitions: []install.ExtraPartition{
+			{
+				Label: "CACHE",
+				Size:  cacheSize,
+			},
+			{
+				Label: "LOCAL-PV",
+			},
+		},
+	}
+
+	manifest, err := install.NewManifest("A", runtime.SequenceInstall, false, opts)
+	suite.Require().NoError(err)
+
+	// in the tests overlay mounts should be ignored
+	dev := manifest.Devices[suite.loopbackDevice.Name()]
+	dev.SkipOverlayMountsCheck = true
+	manifest.Devices[suite.loopbackDevice.Name()] = dev
+
+	suite.Assert().NoError(manifest.Execute())
+
+	suite.verifyExtraPartitions(ephemeralSize, cacheSize)
+
+	// put some data to the extra partition to verify that it's preserved on upgrade
+	localPVPath := fmt.Sprintf("%sp%d", suite.loopbackDevice.Name(), 8)
+
+	suite.Require().NoError(ioutil.WriteFile(localPVPath, []byte("data"), 0o600))
+
+	// upgrade
+
+	manifest, err = install.NewManifest("B", runtime.SequenceUpgrade, true, opts)
+	suite.Require().NoError(err)
+
+	suite.Assert().False(manifest.Devices[suite.loopbackDevice.Name()].ResetPartitionTable)
+
+	// in the tests overlay mounts should be ignored
+	dev = manifest.Devices[suite.loopbackDevice.Name()]
+	dev.SkipOverlayMountsCheck = true
+	manifest.Devices[suite.loopbackDevice.Name()] = dev
+
+	suite.Assert().NoError(manifest.Execute())
+
+	suite.verifyExtraPartitions(ephemeralSize, cacheSize)
+
+	f, err := os.Open(localPVPath)
+	suite.Require().NoError(err)
+
+	buf := make([]byte, 4)
+
+	_, err = io.ReadFull(f, buf)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("data", string(buf))
+
+	suite.Assert().NoError(f.Close())
+}
+
+func (suite *manifestSuite) TestNewManifestRAID() {
+	arrayPath := "/dev/md/" + constants.SystemRAIDArrayName
+	members := []string{"/dev/sdx", "/dev/sdy"}
+
+	opts := &install.Options{
+		Disk:        arrayPath,
+		RAIDDevices: members,
+		Force:       true,
+		Board:       constants.BoardNone,
+		Bootloader:  true,
+	}
+
+	manifest, err := install.NewManifest("A", runtime.SequenceInstall, false, opts)
+	suite.Require().NoError(err)
+
+	suite.Require().Contains(manifest.RAIDArrays, arrayPath)
+	suite.Assert().True(manifest.RAIDArrays[arrayPath].Create)
+	suite.Assert().Len(manifest.RAIDArrays[arrayPath].Members, 2)
+
+	for _, member := range members {
+		suite.Assert().True(manifest.Devices[member].ResetPartitionTable)
+
+		labels := []string{}
+
+		for _, target := range manifest.Targets[member] {
+			labels = append(labels, target.Label)
+		}
+
+		suite.Assert().Equal([]string{constants.EFIPartitionLabel, constants.BIOSGrubPartitionLabel, constants.RAIDPartitionLabel}, labels)
+	}
+
+	labels := []string{}
+
+	for _, target := range manifest.Targets[arrayPath] {
+		labels = append(labels, target.Label)
+	}
+
+	suite.Assert().Equal([]string{constants.BootPartitionLabel, constants.MetaPartitionLabel, constants.StatePartitionLabel, constants.EphemeralPartitionLabel}, labels)
+
+	// on upgrades, member devices are left intact
+	manifest, err = install.NewManifest("B", runtime.SequenceUpgrade, true, opts)
+	suite.Require().NoError(err)
+
+	suite.Assert().False(manifest.RAIDArrays[arrayPath].Create)
+
+	for _, member := range members {
+		suite.Assert().False(manifest.Devices[member].ResetPartitionTable)
+
+		for _, target := range manifest.Targets[member] {
+			suite.Assert().True(target.Skip)
+		}
+	}
+}
+
 func (suite *manifestSuite) TestTargetInstall() {
 	// Create Temp dirname for mountpoint
 	dir, err := ioutil.TempDir("", "talostest")
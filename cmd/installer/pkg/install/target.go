@@ -50,6 +50,12 @@ type Target struct {
 	// Skipped partitions should exist on the disk by the time manifest execution starts.
 	Skip bool
 
+	// PartitionSize overrides the size of the created partition (FormatOptions.Size).
+	//
+	// Unlike FormatOptions.Size, it doesn't change the amount of data zeroed for partitions
+	// without a filesystem, so only the partition header is wiped.
+	PartitionSize uint64
+
 	// set during execution
 	PartitionName string
 	Contents      *bytes.Buffer
@@ -136,6 +142,32 @@ func EphemeralTarget(device string, extra *Target) *Target {
 	return target.enhance(extra)
 }
 
+// RAIDTarget builds the target for the system RAID array member partition.
+func RAIDTarget(device string, extra *Target) *Target {
+	target := &Target{
+		FormatOptions: partition.NewFormatOptions(constants.RAIDPartitionLabel),
+		Device:        device,
+	}
+
+	return target.enhance(extra)
+}
+
+// ExtraPartitionTarget builds the target for an additional partition on the system disk.
+func ExtraPartitionTarget(device string, extraPartition ExtraPartition, extra *Target) *Target {
+	target := &Target{
+		FormatOptions: &partition.FormatOptions{
+			Label:          extraPartition.Label,
+			PartitionType:  partition.LinuxFilesystemData,
+			FileSystemType: partition.FilesystemTypeNone,
+			Force:          true,
+		},
+		Device:        device,
+		PartitionSize: extraPartition.Size,
+	}
+
+	return target.enhance(extra)
+}
+
 func (t *Target) enhance(extra *Target) *Target {
 	if extra == nil {
 		return t
@@ -191,14 +223,19 @@ func (t *Target) Partition(pt *gpt.GPT, pos int, bd *blockdevice.BlockDevice) (e
 		return nil
 	}
 
-	log.Printf("partitioning %s - %s %q\n", t.Device, t.Label, humanize.Bytes(t.Size))
+	size := t.Size
+	if t.PartitionSize != 0 {
+		size = t.PartitionSize
+	}
+
+	log.Printf("partitioning %s - %s %q\n", t.Device, t.Label, humanize.Bytes(size))
 
 	opts := []gpt.PartitionOption{
 		gpt.WithPartitionType(t.PartitionType),
 		gpt.WithPartitionName(t.Label),
 	}
 
-	if t.Size == 0 {
+	if size == 0 {
 		opts = append(opts, gpt.WithMaximumSize(true))
 	}
 
@@ -206,7 +243,7 @@ func (t *Target) Partition(pt *gpt.GPT, pos int, bd *blockdevice.BlockDevice) (e
 		opts = append(opts, gpt.WithLegacyBIOSBootableAttribute(true))
 	}
 
-	part, err := pt.InsertAt(pos, t.Size, opts...)
+	part, err := pt.InsertAt(pos, size, opts...)
 	if err != nil {
 		return err
 	}
@@ -24,7 +24,13 @@ func VerifyEphemeralPartition(opts *Options) (err error) {
 		return nil
 	}
 
-	if err = VerifyDiskAvailability(opts.Disk, constants.EphemeralPartitionLabel); err != nil {
+	labels := []string{constants.EphemeralPartitionLabel}
+
+	for _, extraPartition := range opts.ExtraPartitions {
+		labels = append(labels, extraPartition.Label)
+	}
+
+	if err = VerifyDiskAvailability(opts.Disk, labels...); err != nil {
 		return fmt.Errorf("failed to verify disk availability: %w", err)
 	}
 
@@ -50,7 +56,7 @@ func VerifyBootPartition(opts *Options) (err error) {
 
 // VerifyDiskAvailability verifies that no filesystems currently exist with
 // the labels used by the OS.
-func VerifyDiskAvailability(devpath, label string) (err error) {
+func VerifyDiskAvailability(devpath string, labels ...string) (err error) {
 	var dev *blockdevice.BlockDevice
 
 	if dev, err = blockdevice.Open(devpath); err != nil {
@@ -64,6 +70,16 @@ func VerifyDiskAvailability(devpath, label string) (err error) {
 	//nolint:errcheck
 	defer dev.Close()
 
+	for _, label := range labels {
+		if err = verifyPartitionEmpty(dev, label); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyPartitionEmpty(dev *blockdevice.BlockDevice, label string) error {
 	part, err := dev.GetPartition(label)
 	if err != nil {
 		return err
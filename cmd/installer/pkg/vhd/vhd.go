@@ -0,0 +1,263 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package vhd implements fixed VHD image creation.
+//
+// VHD format reference: https://www.microsoft.com/en-us/download/details.aspx?id=23850.
+package vhd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FooterSize is the size of the VHD footer.
+const FooterSize = 512
+
+// Alignment is the virtual size alignment required by Azure.
+const Alignment = 1024 * 1024
+
+const (
+	cookie            = "conectix"
+	features          = 0x00000002
+	fileFormatVersion = 0x00010000
+	fixedDataOffset   = 0xFFFFFFFFFFFFFFFF
+	creatorVersion    = 0x00010000
+	creatorHostOS     = "Wi2k"
+	diskTypeFixed     = 2
+)
+
+// vhdEpoch is the VHD timestamp base: January 1, 2000 12:00:00 AM in UTC.
+var vhdEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Geometry is the CHS geometry of the disk.
+type Geometry struct {
+	Cylinders       uint16
+	Heads           uint8
+	SectorsPerTrack uint8
+}
+
+// Footer is the VHD hard disk footer.
+type Footer struct {
+	Timestamp    time.Time
+	OriginalSize uint64
+	CurrentSize  uint64
+	Geometry     Geometry
+	DiskType     uint32
+	UniqueID     uuid.UUID
+}
+
+// NewFixedFooter builds the footer for the fixed disk of the specified size.
+func NewFixedFooter(size uint64) *Footer {
+	return &Footer{
+		Timestamp:    time.Now(),
+		OriginalSize: size,
+		CurrentSize:  size,
+		Geometry:     CalculateGeometry(size),
+		DiskType:     diskTypeFixed,
+		UniqueID:     uuid.New(),
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f *Footer) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, FooterSize)
+
+	copy(buf[0:8], cookie)
+	binary.BigEndian.PutUint32(buf[8:12], features)
+	binary.BigEndian.PutUint32(buf[12:16], fileFormatVersion)
+	binary.BigEndian.PutUint64(buf[16:24], fixedDataOffset)
+	binary.BigEndian.PutUint32(buf[24:28], uint32(f.Timestamp.Sub(vhdEpoch)/time.Second))
+	copy(buf[28:32], "tals")
+	binary.BigEndian.PutUint32(buf[32:36], creatorVersion)
+	copy(buf[36:40], creatorHostOS)
+	binary.BigEndian.PutUint64(buf[40:48], f.OriginalSize)
+	binary.BigEndian.PutUint64(buf[48:56], f.CurrentSize)
+	binary.BigEndian.PutUint16(buf[56:58], f.Geometry.Cylinders)
+	buf[58] = f.Geometry.Heads
+	buf[59] = f.Geometry.SectorsPerTrack
+	binary.BigEndian.PutUint32(buf[60:64], f.DiskType)
+	copy(buf[68:84], f.UniqueID[:])
+
+	binary.BigEndian.PutUint32(buf[64:68], checksum(buf))
+
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Footer) UnmarshalBinary(buf []byte) error {
+	if len(buf) != FooterSize {
+		return fmt.Errorf("unexpected footer size %d", len(buf))
+	}
+
+	if !bytes.Equal(buf[0:8], []byte(cookie)) {
+		return errors.New("VHD footer cookie mismatch")
+	}
+
+	if sum := binary.BigEndian.Uint32(buf[64:68]); sum != checksum(buf) {
+		return fmt.Errorf("VHD footer checksum mismatch: %08x != %08x", sum, checksum(buf))
+	}
+
+	f.Timestamp = vhdEpoch.Add(time.Duration(binary.BigEndian.Uint32(buf[24:28])) * time.Second)
+	f.OriginalSize = binary.BigEndian.Uint64(buf[40:48])
+	f.CurrentSize = binary.BigEndian.Uint64(buf[48:56])
+	f.Geometry.Cylinders = binary.BigEndian.Uint16(buf[56:58])
+	f.Geometry.Heads = buf[58]
+	f.Geometry.SectorsPerTrack = buf[59]
+	f.DiskType = binary.BigEndian.Uint32(buf[60:64])
+	copy(f.UniqueID[:], buf[68:84])
+
+	return nil
+}
+
+// checksum is the one's complement of the sum of all the bytes in the footer without the checksum field.
+func checksum(buf []byte) uint32 {
+	var sum uint32
+
+	for i, b := range buf {
+		if i >= 64 && i < 68 {
+			continue
+		}
+
+		sum += uint32(b)
+	}
+
+	return ^sum
+}
+
+// CalculateGeometry calculates CHS geometry of the disk as defined in the VHD specification.
+//
+//nolint:gocyclo
+func CalculateGeometry(size uint64) Geometry {
+	var sectorsPerTrack, heads, cylindersTimesHeads uint64
+
+	totalSectors := size / 512
+
+	if totalSectors > 65535*16*255 {
+		totalSectors = 65535 * 16 * 255
+	}
+
+	if totalSectors >= 65535*16*63 {
+		sectorsPerTrack = 255
+		heads = 16
+		cylindersTimesHeads = totalSectors / sectorsPerTrack
+	} else {
+		sectorsPerTrack = 17
+		cylindersTimesHeads = totalSectors / sectorsPerTrack
+
+		heads = (cylindersTimesHeads + 1023) / 1024
+
+		if heads < 4 {
+			heads = 4
+		}
+
+		if cylindersTimesHeads >= heads*1024 || heads > 16 {
+			sectorsPerTrack = 31
+			heads = 16
+			cylindersTimesHeads = totalSectors / sectorsPerTrack
+		}
+
+		if cylindersTimesHeads >= heads*1024 {
+			sectorsPerTrack = 63
+			heads = 16
+			cylindersTimesHeads = totalSectors / sectorsPerTrack
+		}
+	}
+
+	return Geometry{
+		Cylinders:       uint16(cylindersTimesHeads / heads),
+		Heads:           uint8(heads),
+		SectorsPerTrack: uint8(sectorsPerTrack),
+	}
+}
+
+// CreateFixedFromRAW converts the raw disk image to the fixed VHD image.
+//
+// The virtual size of the disk is rounded up to 1 MiB, as required by Azure.
+func CreateFixedFromRAW(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close() //nolint:errcheck
+
+	if err = WriteFixed(out, in); err != nil {
+		return fmt.Errorf("error writing VHD image: %w", err)
+	}
+
+	return out.Close()
+}
+
+// WriteFixed writes the contents of the raw image followed by the VHD footer.
+func WriteFixed(out io.WriteSeeker, in io.Reader) error {
+	n, err := copySparse(out, in)
+	if err != nil {
+		return err
+	}
+
+	size := uint64(n)
+
+	if rem := size % Alignment; rem != 0 {
+		size += Alignment - rem
+	}
+
+	footer, err := NewFixedFooter(size).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if _, err = out.Seek(int64(size), io.SeekStart); err != nil {
+		return err
+	}
+
+	_, err = out.Write(footer)
+
+	return err
+}
+
+// copySparse copies the data skipping over zero blocks, so that the output file stays sparse.
+func copySparse(out io.WriteSeeker, in io.Reader) (int64, error) {
+	buf := make([]byte, 64*1024)
+	zero := make([]byte, len(buf))
+
+	var written int64
+
+	for {
+		n, err := io.ReadFull(in, buf)
+		if n > 0 {
+			if bytes.Equal(buf[:n], zero[:n]) {
+				if _, seekErr := out.Seek(int64(n), io.SeekCurrent); seekErr != nil {
+					return written, seekErr
+				}
+			} else if _, writeErr := out.Write(buf[:n]); writeErr != nil {
+				return written, writeErr
+			}
+
+			written += int64(n)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return written, nil
+		}
+
+		if err != nil {
+			return written, err
+		}
+	}
+}
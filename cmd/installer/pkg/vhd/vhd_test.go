@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vhd_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/vhd"
+)
+
+func TestCalculateGeometry(t *testing.T) {
+	for _, tt := range []struct {
+		size     uint64
+		expected vhd.Geometry
+	}{
+		{
+			size:     10 * 1024 * 1024,
+			expected: vhd.Geometry{Cylinders: 301, Heads: 4, SectorsPerTrack: 17},
+		},
+		{
+			size:     1246 * 1024 * 1024,
+			expected: vhd.Geometry{Cylinders: 2531, Heads: 16, SectorsPerTrack: 63},
+		},
+		{
+			size:     200 * 1024 * 1024 * 1024,
+			expected: vhd.Geometry{Cylinders: 65535, Heads: 16, SectorsPerTrack: 255},
+		},
+	} {
+		assert.Equal(t, tt.expected, vhd.CalculateGeometry(tt.size))
+	}
+}
+
+func TestCreateFixedFromRAW(t *testing.T) {
+	dir := t.TempDir()
+
+	src := filepath.Join(dir, "disk.raw")
+	dst := filepath.Join(dir, "disk.vhd")
+
+	// size is not aligned to 1 MiB
+	raw := make([]byte, 3*1024*1024+512)
+	copy(raw[1024*1024:], "talos")
+
+	require.NoError(t, os.WriteFile(src, raw, 0o644))
+
+	require.NoError(t, vhd.CreateFixedFromRAW(src, dst))
+
+	image, err := os.ReadFile(dst)
+	require.NoError(t, err)
+
+	const expectedSize = 4 * 1024 * 1024
+
+	require.Len(t, image, expectedSize+vhd.FooterSize)
+
+	assert.True(t, bytes.Equal(raw, image[:len(raw)]))
+
+	var footer vhd.Footer
+
+	require.NoError(t, footer.UnmarshalBinary(image[expectedSize:]))
+
+	assert.EqualValues(t, expectedSize, footer.CurrentSize)
+	assert.EqualValues(t, expectedSize, footer.OriginalSize)
+	assert.EqualValues(t, 2, footer.DiskType)
+	assert.Equal(t, vhd.CalculateGeometry(expectedSize), footer.Geometry)
+
+	// corrupted footer
+	image[expectedSize+100] ^= 0xff
+
+	assert.Error(t, footer.UnmarshalBinary(image[expectedSize:]))
+}
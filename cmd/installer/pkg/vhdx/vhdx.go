@@ -0,0 +1,342 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package vhdx implements dynamic VHDX image creation.
+//
+// VHDX format reference: https://docs.microsoft.com/en-us/openspecs/windows_protocols/ms-vhdx.
+package vhdx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"unicode/utf16"
+
+	"github.com/google/uuid"
+)
+
+// Layout of the VHDX file.
+//
+// Header section occupies first 1 MiB, it is followed by the log, the metadata region and the BAT;
+// payload blocks are written after the BAT.
+const (
+	KiB = 1024
+	MiB = 1024 * KiB
+
+	HeaderOffset1      = 64 * KiB
+	HeaderOffset2      = 128 * KiB
+	RegionTableOffset1 = 192 * KiB
+	RegionTableOffset2 = 256 * KiB
+
+	LogOffset      = 1 * MiB
+	LogLength      = 1 * MiB
+	MetadataOffset = 2 * MiB
+	MetadataLength = 1 * MiB
+	BATOffset      = 3 * MiB
+
+	// BlockSize is the size of the payload block.
+	BlockSize = 32 * MiB
+
+	// LogicalSectorSize is the sector size reported to the guest.
+	LogicalSectorSize = 512
+	// PhysicalSectorSize is the physical sector size reported to the guest.
+	PhysicalSectorSize = 4096
+)
+
+// Signatures of the VHDX structures.
+const (
+	FileSignature        = "vhdxfile"
+	HeaderSignature      = "head"
+	RegionTableSignature = "regi"
+	MetadataSignature    = "metadata"
+)
+
+// Well-known GUIDs.
+var (
+	BATRegionGUID      = uuid.MustParse("2DC27766-F623-4200-9D64-115E9BFD4A08")
+	MetadataRegionGUID = uuid.MustParse("8B7CA206-4790-4B9A-B8FE-575F050F886E")
+
+	FileParametersGUID     = uuid.MustParse("CAA16737-FA36-4D43-B3B6-33F0AA44E76B")
+	VirtualDiskSizeGUID    = uuid.MustParse("2FA54224-CD1B-4876-B211-5DBED83BF4B8")
+	VirtualDiskIDGUID      = uuid.MustParse("BECA12AB-B2E6-4523-93EF-C309E000C746")
+	LogicalSectorSizeGUID  = uuid.MustParse("8141BF1D-A96F-4709-BA47-F233A8FAAB5F")
+	PhysicalSectorSizeGUID = uuid.MustParse("CDA348C7-445D-4471-9CC9-E9885251C556")
+)
+
+// BAT entry states.
+const (
+	PayloadBlockNotPresent   = 0
+	PayloadBlockFullyPresent = 6
+)
+
+const (
+	headerSize      = 4 * KiB
+	regionTableSize = 64 * KiB
+
+	metadataEntryOffset = 64 * KiB
+
+	metadataFlagIsVirtualDisk = 1 << 1
+	metadataFlagIsRequired    = 1 << 2
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// ChunkRatio returns the number of payload blocks per sector bitmap block.
+func ChunkRatio() uint64 {
+	return (1 << 23) * LogicalSectorSize / BlockSize
+}
+
+// BATEntries returns the total number of BAT entries for the disk of the specified size.
+func BATEntries(size uint64) uint64 {
+	payloadBlocks := (size + BlockSize - 1) / BlockSize
+
+	if payloadBlocks == 0 {
+		return 0
+	}
+
+	return payloadBlocks + (payloadBlocks-1)/ChunkRatio()
+}
+
+// BATIndex returns the index of the BAT entry for the payload block.
+func BATIndex(block uint64) uint64 {
+	return block + block/ChunkRatio()
+}
+
+// BATEntry builds BAT entry from the state and the file offset.
+func BATEntry(state uint64, offset uint64) uint64 {
+	return state | (offset/MiB)<<20
+}
+
+// CreateFromRAW converts the raw disk image to the dynamic VHDX image.
+//
+// Payload blocks which contain only zeroes are not stored in the image.
+func CreateFromRAW(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint:errcheck
+
+	st, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close() //nolint:errcheck
+
+	if err = Write(out, in, uint64(st.Size())); err != nil {
+		return fmt.Errorf("error writing VHDX image: %w", err)
+	}
+
+	return out.Close()
+}
+
+// Write writes VHDX image with the contents of the raw disk.
+//
+// Virtual size of the disk is rounded up to the logical sector size.
+//
+//nolint:gocyclo
+func Write(out io.WriterAt, in io.Reader, size uint64) error {
+	if rem := size % LogicalSectorSize; rem != 0 {
+		size += LogicalSectorSize - rem
+	}
+
+	batLength := alignUp(BATEntries(size)*8, MiB)
+	if batLength == 0 {
+		batLength = MiB
+	}
+
+	fileWriteGUID, dataWriteGUID := uuid.New(), uuid.New()
+
+	if err := writeAt(out, fileIdentifier(), 0); err != nil {
+		return err
+	}
+
+	for i, offset := range []int64{HeaderOffset1, HeaderOffset2} {
+		if err := writeAt(out, header(uint64(i+1), fileWriteGUID, dataWriteGUID), offset); err != nil {
+			return err
+		}
+	}
+
+	regions := regionTable(batLength)
+
+	for _, offset := range []int64{RegionTableOffset1, RegionTableOffset2} {
+		if err := writeAt(out, regions, offset); err != nil {
+			return err
+		}
+	}
+
+	if err := writeAt(out, make([]byte, LogLength), LogOffset); err != nil {
+		return err
+	}
+
+	if err := writeAt(out, metadata(size, uuid.New()), MetadataOffset); err != nil {
+		return err
+	}
+
+	bat := make([]byte, batLength)
+	buf := make([]byte, BlockSize)
+	zero := make([]byte, BlockSize)
+	offset := uint64(BATOffset) + batLength
+
+	for block := uint64(0); block*BlockSize < size; block++ {
+		n, err := io.ReadFull(in, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if bytes.Equal(buf[:n], zero[:n]) {
+			continue
+		}
+
+		// last block is padded with zeroes
+		copy(buf[n:], zero)
+
+		if err = writeAt(out, buf, int64(offset)); err != nil {
+			return err
+		}
+
+		binary.LittleEndian.PutUint64(bat[BATIndex(block)*8:], BATEntry(PayloadBlockFullyPresent, offset))
+
+		offset += BlockSize
+	}
+
+	return writeAt(out, bat, BATOffset)
+}
+
+func writeAt(out io.WriterAt, buf []byte, offset int64) error {
+	_, err := out.WriteAt(buf, offset)
+
+	return err
+}
+
+func alignUp(n, alignment uint64) uint64 {
+	return (n + alignment - 1) / alignment * alignment
+}
+
+// guidBytes returns GUID in the Microsoft mixed-endian encoding.
+func guidBytes(id uuid.UUID) []byte {
+	b := make([]byte, 16)
+
+	binary.LittleEndian.PutUint32(b[0:4], binary.BigEndian.Uint32(id[0:4]))
+	binary.LittleEndian.PutUint16(b[4:6], binary.BigEndian.Uint16(id[4:6]))
+	binary.LittleEndian.PutUint16(b[6:8], binary.BigEndian.Uint16(id[6:8]))
+	copy(b[8:], id[8:])
+
+	return b
+}
+
+func fileIdentifier() []byte {
+	buf := make([]byte, 64*KiB)
+
+	copy(buf, FileSignature)
+
+	for i, r := range utf16.Encode([]rune("Talos")) {
+		binary.LittleEndian.PutUint16(buf[8+i*2:], r)
+	}
+
+	return buf
+}
+
+func header(sequenceNumber uint64, fileWriteGUID, dataWriteGUID uuid.UUID) []byte {
+	buf := make([]byte, headerSize)
+
+	copy(buf[0:4], HeaderSignature)
+	binary.LittleEndian.PutUint64(buf[8:16], sequenceNumber)
+	copy(buf[16:32], guidBytes(fileWriteGUID))
+	copy(buf[32:48], guidBytes(dataWriteGUID))
+	// LogGuid and LogVersion are left zero: there is no log to replay
+	binary.LittleEndian.PutUint16(buf[66:68], 1) // Version
+	binary.LittleEndian.PutUint32(buf[68:72], LogLength)
+	binary.LittleEndian.PutUint64(buf[72:80], LogOffset)
+
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(buf, crc32c))
+
+	return buf
+}
+
+func regionTable(batLength uint64) []byte {
+	buf := make([]byte, regionTableSize)
+
+	copy(buf[0:4], RegionTableSignature)
+	binary.LittleEndian.PutUint32(buf[8:12], 2)
+
+	for i, region := range []struct {
+		guid   uuid.UUID
+		offset uint64
+		length uint64
+	}{
+		{BATRegionGUID, BATOffset, batLength},
+		{MetadataRegionGUID, MetadataOffset, MetadataLength},
+	} {
+		entry := buf[16+i*32 : 16+(i+1)*32]
+
+		copy(entry[0:16], guidBytes(region.guid))
+		binary.LittleEndian.PutUint64(entry[16:24], region.offset)
+		binary.LittleEndian.PutUint32(entry[24:28], uint32(region.length))
+		binary.LittleEndian.PutUint32(entry[28:32], 1) // Required
+	}
+
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(buf, crc32c))
+
+	return buf
+}
+
+func metadata(size uint64, diskID uuid.UUID) []byte {
+	buf := make([]byte, MetadataLength)
+
+	fileParameters := make([]byte, 8)
+	binary.LittleEndian.PutUint32(fileParameters[0:4], BlockSize)
+
+	virtualDiskSize := make([]byte, 8)
+	binary.LittleEndian.PutUint64(virtualDiskSize, size)
+
+	logicalSectorSize := make([]byte, 4)
+	binary.LittleEndian.PutUint32(logicalSectorSize, LogicalSectorSize)
+
+	physicalSectorSize := make([]byte, 4)
+	binary.LittleEndian.PutUint32(physicalSectorSize, PhysicalSectorSize)
+
+	items := []struct {
+		guid  uuid.UUID
+		flags uint32
+		data  []byte
+	}{
+		{FileParametersGUID, metadataFlagIsRequired, fileParameters},
+		{VirtualDiskSizeGUID, metadataFlagIsRequired | metadataFlagIsVirtualDisk, virtualDiskSize},
+		{VirtualDiskIDGUID, metadataFlagIsRequired | metadataFlagIsVirtualDisk, guidBytes(diskID)},
+		{LogicalSectorSizeGUID, metadataFlagIsRequired | metadataFlagIsVirtualDisk, logicalSectorSize},
+		{PhysicalSectorSizeGUID, metadataFlagIsRequired | metadataFlagIsVirtualDisk, physicalSectorSize},
+	}
+
+	copy(buf[0:8], MetadataSignature)
+	binary.LittleEndian.PutUint16(buf[10:12], uint16(len(items)))
+
+	offset := uint32(metadataEntryOffset)
+
+	for i, item := range items {
+		entry := buf[32+i*32 : 32+(i+1)*32]
+
+		copy(entry[0:16], guidBytes(item.guid))
+		binary.LittleEndian.PutUint32(entry[16:20], offset)
+		binary.LittleEndian.PutUint32(entry[20:24], uint32(len(item.data)))
+		binary.LittleEndian.PutUint32(entry[24:28], item.flags)
+
+		copy(buf[offset:], item.data)
+
+		offset += uint32(len(item.data))
+	}
+
+	return buf
+}
@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vhdx_test
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/vhdx"
+)
+
+func TestBATEntries(t *testing.T) {
+	assert.EqualValues(t, 128, vhdx.ChunkRatio())
+
+	assert.EqualValues(t, 1, vhdx.BATEntries(1))
+	assert.EqualValues(t, 128, vhdx.BATEntries(128*vhdx.BlockSize))
+	// sector bitmap entry is inserted after every chunk
+	assert.EqualValues(t, 130, vhdx.BATEntries(128*vhdx.BlockSize+1))
+
+	assert.EqualValues(t, 127, vhdx.BATIndex(127))
+	assert.EqualValues(t, 129, vhdx.BATIndex(128))
+}
+
+//nolint:gocyclo
+func TestCreateFromRAW(t *testing.T) {
+	dir := t.TempDir()
+
+	src := filepath.Join(dir, "disk.raw")
+	dst := filepath.Join(dir, "disk.vhdx")
+
+	const size = 3*vhdx.BlockSize + 4096
+
+	raw := make([]byte, size)
+	copy(raw[0:], "first block")
+	copy(raw[2*vhdx.BlockSize+100:], "third block")
+	copy(raw[size-5:], "last")
+
+	require.NoError(t, os.WriteFile(src, raw, 0o644))
+
+	require.NoError(t, vhdx.CreateFromRAW(src, dst))
+
+	image, err := os.ReadFile(dst)
+	require.NoError(t, err)
+
+	crc32c := crc32.MakeTable(crc32.Castagnoli)
+
+	verifyChecksum := func(buf []byte) {
+		t.Helper()
+
+		expected := binary.LittleEndian.Uint32(buf[4:8])
+
+		data := append([]byte(nil), buf...)
+		binary.LittleEndian.PutUint32(data[4:8], 0)
+
+		assert.Equal(t, expected, crc32.Checksum(data, crc32c))
+	}
+
+	assert.Equal(t, vhdx.FileSignature, string(image[0:8]))
+
+	for _, offset := range []int{vhdx.HeaderOffset1, vhdx.HeaderOffset2} {
+		header := image[offset : offset+4096]
+
+		assert.Equal(t, vhdx.HeaderSignature, string(header[0:4]))
+		verifyChecksum(header)
+
+		assert.EqualValues(t, 1, binary.LittleEndian.Uint16(header[66:68]))
+		assert.EqualValues(t, vhdx.LogLength, binary.LittleEndian.Uint32(header[68:72]))
+		assert.EqualValues(t, vhdx.LogOffset, binary.LittleEndian.Uint64(header[72:80]))
+	}
+
+	for _, offset := range []int{vhdx.RegionTableOffset1, vhdx.RegionTableOffset2} {
+		regions := image[offset : offset+64*vhdx.KiB]
+
+		assert.Equal(t, vhdx.RegionTableSignature, string(regions[0:4]))
+		verifyChecksum(regions)
+
+		assert.EqualValues(t, 2, binary.LittleEndian.Uint32(regions[8:12]))
+		assert.EqualValues(t, vhdx.BATOffset, binary.LittleEndian.Uint64(regions[16+16:16+24]))
+		assert.EqualValues(t, vhdx.MetadataOffset, binary.LittleEndian.Uint64(regions[48+16:48+24]))
+	}
+
+	metadata := image[vhdx.MetadataOffset : vhdx.MetadataOffset+vhdx.MetadataLength]
+
+	assert.Equal(t, vhdx.MetadataSignature, string(metadata[0:8]))
+	assert.EqualValues(t, 5, binary.LittleEndian.Uint16(metadata[10:12]))
+
+	// second entry is the virtual disk size
+	sizeOffset := binary.LittleEndian.Uint32(metadata[64+16 : 64+20])
+	assert.EqualValues(t, size, binary.LittleEndian.Uint64(metadata[sizeOffset:sizeOffset+8]))
+
+	// read back the disk contents via the BAT
+	for block := uint64(0); block < 4; block++ {
+		entry := binary.LittleEndian.Uint64(image[vhdx.BATOffset+vhdx.BATIndex(block)*8:])
+
+		state := entry & 0x7
+		offset := (entry >> 20) * vhdx.MiB
+
+		expected := raw[block*vhdx.BlockSize:]
+		if len(expected) > vhdx.BlockSize {
+			expected = expected[:vhdx.BlockSize]
+		}
+
+		if block == 1 {
+			// all zeroes
+			assert.EqualValues(t, vhdx.PayloadBlockNotPresent, state)
+
+			continue
+		}
+
+		require.EqualValues(t, vhdx.PayloadBlockFullyPresent, state)
+		assert.Zero(t, offset%vhdx.MiB)
+		assert.Equal(t, expected, image[offset:offset+uint64(len(expected))])
+	}
+}
@@ -150,6 +150,7 @@ var (
 	badRTC                    bool
 	extraBootKernelArgs       string
 	dockerDisableIPv6         bool
+	dockerExtraNetworks       []string
 )
 
 // createCmd represents the cluster up command.
@@ -284,7 +285,8 @@ func create(ctx context.Context, flags *pflag.FlagSet) (err error) {
 
 				BundleURL: cniBundleURL,
 			},
-			DockerDisableIPv6: dockerDisableIPv6,
+			DockerDisableIPv6:   dockerDisableIPv6,
+			DockerExtraNetworks: dockerExtraNetworks,
 		},
 
 		Image:         nodeImage,
@@ -315,6 +317,10 @@ func create(ctx context.Context, flags *pflag.FlagSet) (err error) {
 		provisionOptions = append(provisionOptions, provision.WithDockerPorts(portList))
 	}
 
+	if len(dockerExtraNetworks) > 0 && provisionerName != "docker" {
+		return fmt.Errorf("docker-extra-networks flag only supported with docker provisioner")
+	}
+
 	disks, err := getDisks()
 	if err != nil {
 		return err
@@ -910,6 +916,7 @@ func init() {
 	createCmd.Flags().BoolVar(&badRTC, "bad-rtc", false, "launch VM with bad RTC state (QEMU only)")
 	createCmd.Flags().StringVar(&extraBootKernelArgs, "extra-boot-kernel-args", "", "add extra kernel args to the initial boot from vmlinuz and initramfs (QEMU only)")
 	createCmd.Flags().BoolVar(&dockerDisableIPv6, dockerDisableIPv6Flag, false, "skip enabling IPv6 in containers (Docker only)")
+	createCmd.Flags().StringSliceVar(&dockerExtraNetworks, "docker-extra-networks", nil, "names of existing Docker networks to attach the nodes to in addition to the cluster network (Docker only)")
 
 	Cmd.AddCommand(createCmd)
 }
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos"
+	"github.com/talos-systems/talos/pkg/cli"
+	clusterapi "github.com/talos-systems/talos/pkg/machinery/api/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+)
+
+// membersCmd represents the cluster members command.
+var membersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "Manage cluster members",
+	Long:  ``,
+}
+
+var membersRemoveCmdFlags struct {
+	force bool
+}
+
+// membersRemoveCmd represents the cluster members remove command.
+var membersRemoveCmd = &cobra.Command{
+	Use:   "remove <member>",
+	Short: "Remove the member from the cluster",
+	Long: `Remove the member (by node ID, node name or hostname) which is gone for good.
+If no member matches, the argument is used as the node ID.
+
+The command should be run against a control plane node: the member is blocked from joining the cluster
+with the same node ID on every node (the block is published via the Kubernetes registry, so it applies
+to the nodes which join later as well), deleted from the discovery service, the Kubernetes node is deleted,
+and the member is removed from etcd if it was a control plane node.
+The etcd member is matched by the member addresses, and it's removed only if the remaining healthy etcd members
+keep the quorum (use --force to skip the check).
+If no member matches, the Kubernetes node and etcd member removal steps are skipped.
+Worker nodes only block the member locally.
+
+The block is cleared with 'talosctl cluster members clear'.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			response, err := c.ClusterRemoveMember(ctx, &clusterapi.RemoveMemberRequest{
+				Member: args[0],
+				Force:  membersRemoveCmdFlags.force,
+			})
+			if err != nil {
+				if response == nil {
+					return fmt.Errorf("error removing member: %w", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+			fmt.Fprintln(w, "NODE\tNODE ID\tACTIONS")
+
+			for _, message := range response.Messages {
+				node := ""
+
+				if message.Metadata != nil {
+					node = message.Metadata.Hostname
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\n", node, message.NodeId, strings.Join(message.Actions, ", "))
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// membersClearCmd represents the cluster members clear command.
+var membersClearCmd = &cobra.Command{
+	Use:   "clear <node-id>",
+	Short: "Allow the removed member to join the cluster again",
+	Long: `Clear the block of the removed member by node ID.
+
+When run against a control plane node, the block is cleared for the whole cluster.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			return c.ClusterClearRemovedMember(ctx, &clusterapi.ClearRemovedMemberRequest{
+				NodeId: args[0],
+			})
+		})
+	},
+}
+
+func init() {
+	membersRemoveCmd.Flags().BoolVar(&membersRemoveCmdFlags.force, "force", false, "remove the etcd member even if the remaining etcd members don't keep the quorum")
+	membersCmd.AddCommand(membersRemoveCmd, membersClearCmd)
+	Cmd.AddCommand(membersCmd)
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mgmt
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/mgmt/gen"
+	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+var genMachineTypeCmdFlags struct {
+	configPath  string
+	secretsFrom string
+	output      string
+}
+
+// genMachineTypeCmd represents the `gen machine-type` command.
+var genMachineTypeCmd = &cobra.Command{
+	Use:   "machine-type <controlplane|worker>",
+	Short: "Converts the machine config to the control plane or worker machine type",
+	Long: `Converts the machine config to the control plane or worker machine type.
+
+Conversion to the control plane requires cluster secrets, which are taken from the existing control plane machine config.
+Conversion to the worker strips the control plane secrets from the machine config.
+
+The converted config should be applied to the node with 'talosctl apply-config', node reboots,
+joins etcd (or leaves it for the worker) and starts (stops) the control plane components.`,
+	Example: `  talosctl gen machine-type controlplane --config worker.yaml --secrets-from controlplane.yaml --output promoted.yaml`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		machineType, err := machine.ParseType(args[0])
+		if err != nil {
+			return err
+		}
+
+		cfg, err := configloader.NewFromFile(genMachineTypeCmdFlags.configPath)
+		if err != nil {
+			return fmt.Errorf("error loading machine config: %w", err)
+		}
+
+		v1alpha1Config, ok := cfg.Raw().(*v1alpha1.Config)
+		if !ok {
+			return fmt.Errorf("machine config is not v1alpha1")
+		}
+
+		var secrets *generate.SecretsBundle
+
+		if genMachineTypeCmdFlags.secretsFrom != "" {
+			secretsConfig, loadErr := configloader.NewFromFile(genMachineTypeCmdFlags.secretsFrom)
+			if loadErr != nil {
+				return fmt.Errorf("error loading cluster secrets: %w", loadErr)
+			}
+
+			secrets = generate.NewSecretsBundleFromConfig(generate.NewClock(), secretsConfig)
+		}
+
+		converted, err := generate.ConvertMachineType(v1alpha1Config, machineType, secrets)
+		if err != nil {
+			return err
+		}
+
+		data, err := converted.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+		if err != nil {
+			return err
+		}
+
+		if genMachineTypeCmdFlags.output == "" {
+			_, err = os.Stdout.Write(data)
+
+			return err
+		}
+
+		return ioutil.WriteFile(genMachineTypeCmdFlags.output, data, 0o600)
+	},
+}
+
+func init() {
+	genMachineTypeCmd.Flags().StringVar(&genMachineTypeCmdFlags.configPath, "config", "", "the machine config to convert")
+	genMachineTypeCmd.Flags().StringVar(&genMachineTypeCmdFlags.secretsFrom, "secrets-from", "", "the control plane machine config to take the cluster secrets from")
+	genMachineTypeCmd.Flags().StringVarP(&genMachineTypeCmdFlags.output, "output", "o", "", "the output file (defaults to stdout)")
+	cli.Should(cobra.MarkFlagRequired(genMachineTypeCmd.Flags(), "config"))
+
+	gen.Cmd.AddCommand(genMachineTypeCmd)
+}
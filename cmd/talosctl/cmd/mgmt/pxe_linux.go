@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mgmt
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/mgmt/pxe"
+)
+
+var pxeServeCmdFlags struct {
+	ifName          string
+	addr            string
+	httpPort        int
+	tftpPort        int
+	assetsDir       string
+	configsDir      string
+	defaultConfig   bool
+	extraKernelArgs []string
+}
+
+// pxeCmd represents the pxe command.
+var pxeCmd = &cobra.Command{
+	Use:   "pxe",
+	Short: "Network boot bare metal machines",
+	Long:  ``,
+}
+
+// pxeServeCmd represents the pxe serve command.
+var pxeServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run PXE boot server (DHCP proxy, TFTP and HTTP)",
+	Long: `Run PXE boot server which boots Talos on bare metal machines in the local network.
+
+DHCP proxy answers PXE requests alongside the existing DHCP server, pointing firmware to the iPXE binaries
+(undionly.kpxe, ipxe.efi, ipxe-arm64.efi) served over TFTP from the assets directory.
+iPXE then downloads the kernel and initramfs (vmlinuz-<arch>, initramfs-<arch>.xz) from the assets directory over HTTP.
+
+Machine configs are picked from the configs directory by the MAC address of the booting interface
+(<mac>.yaml, colon- or dash-separated), machines without the config boot into the maintenance mode.
+With --serve-default-config, default.yaml is served to the machines without the MAC-specific config.
+
+Machine configs contain cluster secrets and are served over plain HTTP to any client which knows the MAC address,
+so the server should be run only in the trusted network. With --serve-default-config, any client which can reach
+the server can fetch default.yaml.`,
+	Example: `  talosctl pxe serve --interface eth0 --assets-dir ./_out --configs-dir ./configs`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		server, err := pxe.NewServer(pxe.Options{
+			Interface:          pxeServeCmdFlags.ifName,
+			ServerIP:           net.ParseIP(pxeServeCmdFlags.addr),
+			HTTPPort:           pxeServeCmdFlags.httpPort,
+			TFTPPort:           pxeServeCmdFlags.tftpPort,
+			AssetsDir:          pxeServeCmdFlags.assetsDir,
+			ConfigsDir:         pxeServeCmdFlags.configsDir,
+			ServeDefaultConfig: pxeServeCmdFlags.defaultConfig,
+			ExtraKernelArgs:    pxeServeCmdFlags.extraKernelArgs,
+		})
+		if err != nil {
+			return err
+		}
+
+		return server.Serve(ctx)
+	},
+}
+
+func init() {
+	pxeServeCmd.Flags().StringVar(&pxeServeCmdFlags.ifName, "interface", "", "interface to run DHCP proxy on (DHCP proxy is disabled if not set)")
+	pxeServeCmd.Flags().StringVar(&pxeServeCmdFlags.addr, "addr", "", "server IPv4 address advertised to the clients (defaults to the interface address)")
+	pxeServeCmd.Flags().IntVar(&pxeServeCmdFlags.httpPort, "http-port", 8081, "HTTP server port")
+	pxeServeCmd.Flags().IntVar(&pxeServeCmdFlags.tftpPort, "tftp-port", 69, "TFTP server port")
+	pxeServeCmd.Flags().StringVar(&pxeServeCmdFlags.assetsDir, "assets-dir", "_out", "directory with kernel, initramfs and iPXE binaries")
+	pxeServeCmd.Flags().StringVar(&pxeServeCmdFlags.configsDir, "configs-dir", "", "directory with machine configs")
+	pxeServeCmd.Flags().BoolVar(&pxeServeCmdFlags.defaultConfig, "serve-default-config", false, "serve default.yaml to any machine without the MAC-specific config")
+	pxeServeCmd.Flags().StringSliceVar(&pxeServeCmdFlags.extraKernelArgs, "extra-kernel-args", nil, "additional kernel arguments (e.g. console=ttyS0)")
+
+	pxeCmd.AddCommand(pxeServeCmd)
+	addCommand(pxeCmd)
+}
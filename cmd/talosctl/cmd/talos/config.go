@@ -28,6 +28,9 @@ import (
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
 	"github.com/talos-systems/talos/pkg/machinery/role"
 )
 
@@ -85,6 +88,43 @@ var configEndpointCmd = &cobra.Command{
 	},
 }
 
+// configEndpointDiscoveryCmd represents the `config endpoint-discovery` command.
+var configEndpointDiscoveryCmd = &cobra.Command{
+	Use:   "endpoint-discovery <enable|disable>",
+	Short: "Enable or disable endpoint discovery for the current context",
+	Long: `When endpoint discovery is enabled, endpoints are refreshed from the control plane cluster members
+and cached in the client configuration file. Cluster discovery should be enabled in the machine config.`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"enable", "disable"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := openConfigAndContext("")
+		if err != nil {
+			return err
+		}
+
+		context := c.Contexts[c.Context]
+
+		switch args[0] {
+		case "enable":
+			if context.EndpointDiscovery == nil {
+				context.EndpointDiscovery = &clientconfig.EndpointDiscovery{}
+			}
+
+			context.EndpointDiscovery.Enabled = true
+		case "disable":
+			context.EndpointDiscovery = nil
+		default:
+			return fmt.Errorf("unknown argument %q, expected enable or disable", args[0])
+		}
+
+		if err := c.Save(Talosconfig); err != nil {
+			return fmt.Errorf("error writing config: %w", err)
+		}
+
+		return nil
+	},
+}
+
 // configNodeCmd represents the `config node` command.
 var configNodeCmd = &cobra.Command{
 	Use:     "node <endpoint>...",
@@ -411,6 +451,63 @@ var configInfoCmd = &cobra.Command{
 	},
 }
 
+var configMigrateCmdFlags struct {
+	to     string
+	output string
+}
+
+// configMigrateCmd represents the `config migrate` command.
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate <machine config>",
+	Short: "Migrate machine configuration to the newer Talos version",
+	Long: `Rewrites the machine configuration file to take advantage of the defaults of the target Talos version.
+
+Fields no longer supported by Talos are removed, deprecated fields are replaced with their successors.
+The report of the changes is printed to stderr.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		source, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		contract := config.TalosVersionCurrent
+
+		if configMigrateCmdFlags.to != "" {
+			contract, err = config.ParseContractFromVersion(configMigrateCmdFlags.to)
+			if err != nil {
+				return fmt.Errorf("invalid target version: %w", err)
+			}
+		}
+
+		cfg, changes, err := generate.MigrateConfig(source, contract)
+		if err != nil {
+			return err
+		}
+
+		out, err := cfg.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+		if err != nil {
+			return err
+		}
+
+		if len(changes) == 0 {
+			fmt.Fprintln(os.Stderr, "no changes required")
+		}
+
+		for _, change := range changes {
+			fmt.Fprintf(os.Stderr, "- %s\n", change)
+		}
+
+		if configMigrateCmdFlags.output == "" {
+			_, err = os.Stdout.Write(out)
+
+			return err
+		}
+
+		return ioutil.WriteFile(configMigrateCmdFlags.output, out, 0o600)
+	},
+}
+
 // CompleteConfigContext represents tab completion for `--context` argument and `config context` command.
 func CompleteConfigContext(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	c, err := clientconfig.Open(Talosconfig)
@@ -431,6 +528,7 @@ func CompleteConfigContext(cmd *cobra.Command, args []string, toComplete string)
 func init() {
 	configCmd.AddCommand(
 		configEndpointCmd,
+		configEndpointDiscoveryCmd,
 		configNodeCmd,
 		configContextCmd,
 		configAddCmd,
@@ -438,6 +536,7 @@ func init() {
 		configMergeCmd,
 		configNewCmd,
 		configInfoCmd,
+		configMigrateCmd,
 	)
 
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.ca, "ca", "", "the path to the CA certificate")
@@ -450,5 +549,8 @@ func init() {
 	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.roles, "roles", role.MakeSet(role.Admin).Strings(), "roles")
 	configNewCmd.Flags().DurationVar(&configNewCmdFlags.crtTTL, "crt-ttl", 87600*time.Hour, "certificate TTL")
 
+	configMigrateCmd.Flags().StringVar(&configMigrateCmdFlags.to, "to", "", "the target Talos version (e.g. v1.1), defaults to the current version")
+	configMigrateCmd.Flags().StringVarP(&configMigrateCmdFlags.output, "output", "o", "", "the path to write the migrated config to, defaults to stdout")
+
 	addCommand(configCmd)
 }
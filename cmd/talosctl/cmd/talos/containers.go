@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/output"
 	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/api/common"
 	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
@@ -24,6 +25,10 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
+var containersCmdFlags struct {
+	output string
+}
+
 // containersCmd represents the processes command.
 var containersCmd = &cobra.Command{
 	Use:     "containers",
@@ -57,6 +62,10 @@ var containersCmd = &cobra.Command{
 				cli.Warning("%s", err)
 			}
 
+			if containersCmdFlags.output != "table" {
+				return containerData(&remotePeer, resp, containersCmdFlags.output)
+			}
+
 			return containerRender(&remotePeer, resp)
 		})
 	},
@@ -95,8 +104,41 @@ func containerRender(remotePeer *peer.Peer, resp *machineapi.ContainersResponse)
 	return w.Flush()
 }
 
+func containerData(remotePeer *peer.Peer, resp *machineapi.ContainersResponse, format string) error {
+	w, err := output.NewDataWriter(format)
+	if err != nil {
+		return err
+	}
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, p := range msg.Containers {
+			data, dataErr := output.ProtoData(node, p)
+			if dataErr != nil {
+				return dataErr
+			}
+
+			if err = w.WriteData(data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
 func init() {
 	containersCmd.Flags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
+	containersCmd.Flags().StringVarP(&containersCmdFlags.output, "output", "o", "table",
+		"output mode (json, table, yaml, jsonpath=<expr>, go-template=<template>, custom-columns=<spec>)")
+	cli.Should(containersCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
 
 	containersCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	containersCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
@@ -24,16 +24,26 @@ var dashboardCmd = &cobra.Command{
 	Short: "Cluster dashboard with real-time metrics",
 	Long: `Provide quick UI to navigate through node real-time metrics.
 
+Screens:
+
+ - 1: summary with the node metrics and processes
+ - 2: etcd member status of the node and all the cluster members
+ - 3: Kubernetes node status and static pod health
+ - 4: KubeSpan peer status
+ - 5: service logs
+
 Keyboard shortcuts:
 
+ - 1-5: switch to the screen
  - h, <Left>: switch one node to the left
  - l, <Right>: switch one node to the right
- - j, <Down>: scroll process list down
- - k, <Up>: scroll process list up
- - <C-d>: scroll process list half page down
- - <C-u>: scroll process list half page up
- - <C-f>: scroll process list one page down
- - <C-b>: scroll process list one page up
+ - [, ]: select previous/next service on the logs screen
+ - j, <Down>: scroll the list down
+ - k, <Up>: scroll the list up
+ - <C-d>: scroll the list half page down
+ - <C-u>: scroll the list half page up
+ - <C-f>: scroll the list one page down
+ - <C-b>: scroll the list one page up
 `,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
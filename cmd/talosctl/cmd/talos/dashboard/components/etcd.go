@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gizak/termui/v3/widgets"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/dashboard/data"
+)
+
+// EtcdInfo represents the widget with the etcd member status of the node.
+type EtcdInfo struct {
+	widgets.Paragraph
+}
+
+// NewEtcdInfo initializes EtcdInfo.
+func NewEtcdInfo() *EtcdInfo {
+	widget := &EtcdInfo{
+		Paragraph: *widgets.NewParagraph(),
+	}
+
+	widget.Border = false
+	widget.Title = "ETCD"
+	widget.PaddingLeft = 1
+	widget.Text = noData
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *EtcdInfo) Update(node string, data *data.Data) {
+	nodeData := data.Nodes[node]
+
+	switch {
+	case nodeData == nil || nodeData.Resources == nil:
+		widget.Text = noData
+	case nodeData.Resources.EtcdMember == nil:
+		widget.Text = "etcd is not running on the node"
+	default:
+		member := nodeData.Resources.EtcdMember
+
+		errors := "none"
+		if len(member.Errors) > 0 {
+			errors = fmt.Sprintf("[%s](fg:red)", strings.Join(member.Errors, "; "))
+		}
+
+		widget.Text = fmt.Sprintf(
+			"Member  [%-16s](mod:bold)  Leader  [%-16s](mod:bold)  Version [%s](mod:bold)\n"+
+				"DB Size [%-16s](mod:bold)  In Use  [%-16s](mod:bold)  Learner [%v](mod:bold)\n"+
+				"Index   [%-16d](mod:bold)  Term    [%-16d](mod:bold)\n"+
+				"Errors  %s",
+			member.MemberID, member.LeaderID, member.Version,
+			humanize.Bytes(uint64(member.DBSize)), humanize.Bytes(uint64(member.DBSizeInUse)), member.IsLearner,
+			member.RaftIndex, member.RaftTerm,
+			errors,
+		)
+	}
+}
+
+// EtcdMembersTable represents the widget with etcd member status of all the nodes.
+type EtcdMembersTable struct {
+	widgets.List
+}
+
+// NewEtcdMembersTable initializes EtcdMembersTable.
+func NewEtcdMembersTable() *EtcdMembersTable {
+	widget := &EtcdMembersTable{
+		List: *widgets.NewList(),
+	}
+
+	widget.Border = false
+	widget.Title = fmt.Sprintf("%-20s  %-16s  %-6s  %-7s  %8s  %8s  %10s  %6s  %s",
+		"NODE",
+		"MEMBER",
+		"LEADER",
+		"LEARNER",
+		"DB SIZE",
+		"IN USE",
+		"RAFT INDEX",
+		"TERM",
+		"VERSION",
+	)
+	widget.Rows = []string{
+		noData,
+	}
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *EtcdMembersTable) Update(node string, data *data.Data) {
+	nodes := make([]string, 0, len(data.Nodes))
+
+	for name, nodeData := range data.Nodes {
+		if nodeData.Resources != nil && nodeData.Resources.EtcdMember != nil {
+			nodes = append(nodes, name)
+		}
+	}
+
+	sort.Strings(nodes)
+
+	widget.Rows = widget.Rows[:0]
+
+	for _, name := range nodes {
+		member := data.Nodes[name].Resources.EtcdMember
+
+		leader := ""
+		if member.IsLeader {
+			leader = "*"
+		}
+
+		learner := ""
+		if member.IsLearner {
+			learner = "*"
+		}
+
+		line := fmt.Sprintf("%-20s  %-16s  %-6s  %-7s  %8s  %8s  %10d  %6d  %s",
+			name,
+			member.MemberID,
+			leader,
+			learner,
+			humanize.Bytes(uint64(member.DBSize)),
+			humanize.Bytes(uint64(member.DBSizeInUse)),
+			member.RaftIndex,
+			member.RaftTerm,
+			member.Version,
+		)
+
+		// highlight the selected node
+		if name == node {
+			line = fmt.Sprintf("[%s](mod:bold)", line)
+		}
+
+		widget.Rows = append(widget.Rows, line)
+	}
+
+	if len(widget.Rows) == 0 {
+		widget.Rows = append(widget.Rows, noData)
+	}
+}
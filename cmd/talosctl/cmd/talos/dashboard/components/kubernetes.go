@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gizak/termui/v3/widgets"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/dashboard/data"
+)
+
+// NodeStatusInfo represents the widget with Kubernetes node status.
+type NodeStatusInfo struct {
+	widgets.Paragraph
+}
+
+// NewNodeStatusInfo initializes NodeStatusInfo.
+func NewNodeStatusInfo() *NodeStatusInfo {
+	widget := &NodeStatusInfo{
+		Paragraph: *widgets.NewParagraph(),
+	}
+
+	widget.Border = false
+	widget.Title = "KUBERNETES NODE"
+	widget.PaddingLeft = 1
+	widget.Text = noData
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *NodeStatusInfo) Update(node string, data *data.Data) {
+	nodeData := data.Nodes[node]
+
+	switch {
+	case nodeData == nil || nodeData.Resources == nil:
+		widget.Text = noData
+	case nodeData.Resources.NodeStatus == nil:
+		widget.Text = "Kubernetes node status is not available"
+	default:
+		status := nodeData.Resources.NodeStatus
+
+		labels := make([]string, 0, len(status.Labels))
+
+		for k, v := range status.Labels {
+			labels = append(labels, k+"="+v)
+		}
+
+		sort.Strings(labels)
+
+		widget.Text = fmt.Sprintf(
+			"Nodename [%s](mod:bold)  Ready %s  Schedulable %s  Kubelet [%s](mod:bold)\n"+
+				"Labels   %s",
+			status.Nodename,
+			yesNo(status.NodeReady),
+			yesNo(!status.Unschedulable),
+			status.KubeletVersion,
+			strings.Join(labels, ", "),
+		)
+	}
+}
+
+// StaticPodTable represents the widget with static pod statuses.
+type StaticPodTable struct {
+	widgets.List
+}
+
+// NewStaticPodTable initializes StaticPodTable.
+func NewStaticPodTable() *StaticPodTable {
+	widget := &StaticPodTable{
+		List: *widgets.NewList(),
+	}
+
+	widget.Border = false
+	widget.Title = fmt.Sprintf("%-60s  %-10s  %-5s  %-10s  %8s",
+		"STATIC POD",
+		"PHASE",
+		"READY",
+		"CONTAINERS",
+		"RESTARTS",
+	)
+	widget.Rows = []string{
+		noData,
+	}
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *StaticPodTable) Update(node string, data *data.Data) {
+	nodeData := data.Nodes[node]
+
+	if nodeData == nil || nodeData.Resources == nil || len(nodeData.Resources.StaticPods) == 0 {
+		widget.Rows = []string{
+			noData,
+		}
+
+		return
+	}
+
+	ids := make([]string, 0, len(nodeData.Resources.StaticPods))
+
+	for id := range nodeData.Resources.StaticPods {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	widget.Rows = widget.Rows[:0]
+
+	for _, id := range ids {
+		podStatus := nodeData.Resources.StaticPods[id].PodStatus
+
+		phase, _ := podStatus["phase"].(string) //nolint:errcheck
+
+		ready := "no"
+
+		conditions, _ := podStatus["conditions"].([]interface{}) //nolint:errcheck
+
+		for _, item := range conditions {
+			condition, _ := item.(map[string]interface{}) //nolint:errcheck
+
+			if condition["type"] == "Ready" && condition["status"] == "True" {
+				ready = "yes"
+			}
+		}
+
+		var containersReady, containersTotal, restarts int
+
+		containerStatuses, _ := podStatus["containerStatuses"].([]interface{}) //nolint:errcheck
+
+		for _, item := range containerStatuses {
+			containerStatus, _ := item.(map[string]interface{}) //nolint:errcheck
+
+			containersTotal++
+
+			if containerStatus["ready"] == true {
+				containersReady++
+			}
+
+			if count, ok := containerStatus["restartCount"].(int); ok {
+				restarts += count
+			}
+		}
+
+		widget.Rows = append(widget.Rows, fmt.Sprintf("%-60s  %-10s  %-5s  %-10s  %8d",
+			id,
+			phase,
+			ready,
+			fmt.Sprintf("%d/%d", containersReady, containersTotal),
+			restarts,
+		))
+	}
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "[yes](fg:green)"
+	}
+
+	return "[no](fg:red)"
+}
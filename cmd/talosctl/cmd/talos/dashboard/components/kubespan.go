@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gizak/termui/v3/widgets"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/dashboard/data"
+	"github.com/talos-systems/talos/pkg/machinery/resources/kubespan"
+)
+
+// KubeSpanPeerTable represents the widget with KubeSpan peer statuses.
+type KubeSpanPeerTable struct {
+	widgets.List
+}
+
+// NewKubeSpanPeerTable initializes KubeSpanPeerTable.
+func NewKubeSpanPeerTable() *KubeSpanPeerTable {
+	widget := &KubeSpanPeerTable{
+		List: *widgets.NewList(),
+	}
+
+	widget.Border = false
+	widget.Title = fmt.Sprintf("%-30s  %-45s  %-7s  %8s  %8s  %s",
+		"PEER",
+		"ENDPOINT",
+		"STATE",
+		"RX",
+		"TX",
+		"LAST HANDSHAKE",
+	)
+	widget.Rows = []string{
+		noData,
+	}
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *KubeSpanPeerTable) Update(node string, data *data.Data) {
+	nodeData := data.Nodes[node]
+
+	if nodeData == nil || nodeData.Resources == nil || len(nodeData.Resources.KubeSpanPeers) == 0 {
+		widget.Rows = []string{
+			noData,
+		}
+
+		return
+	}
+
+	peers := make([]*kubespan.PeerStatusSpec, 0, len(nodeData.Resources.KubeSpanPeers))
+
+	for _, peer := range nodeData.Resources.KubeSpanPeers {
+		peers = append(peers, peer)
+	}
+
+	sort.Slice(peers, func(i, j int) bool { return peers[i].Label < peers[j].Label })
+
+	widget.Rows = widget.Rows[:0]
+
+	for _, peer := range peers {
+		lastHandshake := "never"
+
+		if !peer.LastHandshakeTime.IsZero() {
+			lastHandshake = fmt.Sprintf("%s ago", time.Since(peer.LastHandshakeTime).Round(time.Second))
+		}
+
+		endpoint := "-"
+
+		if !peer.Endpoint.IsZero() {
+			endpoint = peer.Endpoint.String()
+		}
+
+		state := peer.State.String()
+
+		switch peer.State {
+		case kubespan.PeerStateUp:
+			state = fmt.Sprintf("[%-7s](fg:green)", state)
+		case kubespan.PeerStateDown:
+			state = fmt.Sprintf("[%-7s](fg:red)", state)
+		case kubespan.PeerStateUnknown:
+			state = fmt.Sprintf("%-7s", state)
+		}
+
+		widget.Rows = append(widget.Rows, fmt.Sprintf("%-30s  %-45s  %s  %8s  %8s  %s",
+			peer.Label,
+			endpoint,
+			state,
+			humanize.Bytes(uint64(peer.ReceiveBytes)),
+			humanize.Bytes(uint64(peer.TransmitBytes)),
+			lastHandshake,
+		))
+	}
+}
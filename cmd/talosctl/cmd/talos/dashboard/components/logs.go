@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/dashboard/data"
+)
+
+// maxLogLines is the number of log lines kept in the LogView.
+const maxLogLines = 1000
+
+// ServiceList represents the widget with the list of services, one of which is selected.
+type ServiceList struct {
+	widgets.List
+
+	services []string
+	selected string
+}
+
+// NewServiceList initializes ServiceList.
+func NewServiceList() *ServiceList {
+	widget := &ServiceList{
+		List: *widgets.NewList(),
+	}
+
+	widget.Border = false
+	widget.Title = "SERVICES"
+	widget.Rows = []string{
+		noData,
+	}
+	widget.SelectedRowStyle = ui.NewStyle(ui.Theme.List.Text.Fg, ui.Theme.List.Text.Bg, ui.ModifierReverse)
+
+	return widget
+}
+
+// Update implements the DataWidget interface.
+func (widget *ServiceList) Update(node string, data *data.Data) {
+	nodeData := data.Nodes[node]
+
+	widget.services = widget.services[:0]
+	widget.Rows = widget.Rows[:0]
+
+	if nodeData != nil && nodeData.Resources != nil {
+		for id := range nodeData.Resources.Services {
+			widget.services = append(widget.services, id)
+		}
+	}
+
+	sort.Strings(widget.services)
+
+	for i, id := range widget.services {
+		service := nodeData.Resources.Services[id]
+
+		state := "[Stopped](fg:red)"
+
+		switch {
+		case service.Running && service.Healthy:
+			state = "[Healthy](fg:green)"
+		case service.Running && service.Unknown:
+			state = "[Running](fg:green)"
+		case service.Running:
+			state = "[Unhealthy](fg:yellow)"
+		}
+
+		widget.Rows = append(widget.Rows, fmt.Sprintf("%-16s  %s", id, state))
+
+		if id == widget.selected {
+			widget.SelectedRow = i
+		}
+	}
+
+	if len(widget.services) == 0 {
+		widget.Rows = append(widget.Rows, noData)
+
+		return
+	}
+
+	if widget.Selected() == "" {
+		widget.SelectedRow = 0
+		widget.selected = widget.services[0]
+	}
+}
+
+// Selected returns the selected service, or empty string if there's no services.
+func (widget *ServiceList) Selected() string {
+	for _, id := range widget.services {
+		if id == widget.selected {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// SelectNext selects the next service in the list.
+func (widget *ServiceList) SelectNext() {
+	widget.selectOffset(1)
+}
+
+// SelectPrev selects the previous service in the list.
+func (widget *ServiceList) SelectPrev() {
+	widget.selectOffset(-1)
+}
+
+func (widget *ServiceList) selectOffset(offset int) {
+	if len(widget.services) == 0 {
+		return
+	}
+
+	idx := (widget.SelectedRow + offset + len(widget.services)) % len(widget.services)
+
+	widget.SelectedRow = idx
+	widget.selected = widget.services[idx]
+}
+
+// LogView represents the widget with the log lines.
+type LogView struct {
+	widgets.List
+}
+
+// NewLogView initializes LogView.
+func NewLogView() *LogView {
+	widget := &LogView{
+		List: *widgets.NewList(),
+	}
+
+	widget.Border = false
+	widget.Title = "LOGS"
+	widget.Rows = []string{}
+
+	return widget
+}
+
+// Reset clears the log lines and sets the title.
+func (widget *LogView) Reset(title string) {
+	widget.Title = title
+	widget.Rows = widget.Rows[:0]
+	widget.SelectedRow = 0
+}
+
+// Append a log line, the view follows the last line unless scrolled up.
+func (widget *LogView) Append(line string) {
+	follow := widget.SelectedRow >= len(widget.Rows)-1
+
+	// log lines might contain sequences which look like termui style markup, so replace the brackets
+	line = strings.NewReplacer("[", "(", "]", ")").Replace(line)
+
+	widget.Rows = append(widget.Rows, line)
+
+	if len(widget.Rows) > maxLogLines {
+		widget.Rows = widget.Rows[len(widget.Rows)-maxLogLines:]
+
+		if widget.SelectedRow > 0 {
+			widget.SelectedRow--
+		}
+	}
+
+	if follow {
+		widget.SelectedRow = len(widget.Rows) - 1
+	}
+}
@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+
+	"github.com/gizak/termui/v3/widgets"
+)
+
+// ScreenTabs represents the bar with the list of dashboard screens.
+type ScreenTabs struct {
+	widgets.TabPane
+}
+
+// NewScreenTabs initializes ScreenTabs.
+func NewScreenTabs(screens ...string) *ScreenTabs {
+	names := make([]string, len(screens))
+
+	for i, screen := range screens {
+		names[i] = fmt.Sprintf("%d: %s", i+1, screen)
+	}
+
+	tabs := &ScreenTabs{
+		TabPane: *widgets.NewTabPane(names...),
+	}
+
+	tabs.Border = false
+
+	return tabs
+}
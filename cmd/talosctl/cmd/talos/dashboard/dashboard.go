@@ -14,7 +14,11 @@ import (
 
 // Main is the entrypoint into talosctl dashboard command.
 func Main(ctx context.Context, c *client.Client, interval time.Duration) error {
-	ui := &UI{}
+	ui := &UI{
+		LogSource: &LogSource{
+			Client: c,
+		},
+	}
 
 	source := &APISource{
 		Client:   c,
@@ -27,6 +27,9 @@ type Node struct {
 
 	// Time-series data.
 	Series map[string][]float64
+
+	// Resources are updated via resource watches.
+	Resources *Resources
 }
 
 // MemUsage as used/total.
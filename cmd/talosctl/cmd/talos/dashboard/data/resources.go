@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package data
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/kubespan"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// Resources represents the node state received via Talos resource watches.
+//
+// Specs are never modified in place, updated specs replace the previous ones.
+type Resources struct {
+	Services      map[resource.ID]*v1alpha1.ServiceSpec
+	EtcdMember    *etcd.MemberStatusSpec
+	NodeStatus    *k8s.NodeStatusSpec
+	StaticPods    map[resource.ID]*k8s.StaticPodStatusSpec
+	KubeSpanPeers map[resource.ID]*kubespan.PeerStatusSpec
+}
+
+// NewResources initializes empty Resources.
+func NewResources() *Resources {
+	return &Resources{
+		Services:      map[resource.ID]*v1alpha1.ServiceSpec{},
+		StaticPods:    map[resource.ID]*k8s.StaticPodStatusSpec{},
+		KubeSpanPeers: map[resource.ID]*kubespan.PeerStatusSpec{},
+	}
+}
+
+// Clone returns a snapshot of the Resources.
+func (resources *Resources) Clone() *Resources {
+	return &Resources{
+		Services:      cloneMap(resources.Services),
+		EtcdMember:    resources.EtcdMember,
+		NodeStatus:    resources.NodeStatus,
+		StaticPods:    cloneMap(resources.StaticPods),
+		KubeSpanPeers: cloneMap(resources.KubeSpanPeers),
+	}
+}
+
+func cloneMap[T any](m map[resource.ID]*T) map[resource.ID]*T {
+	result := make(map[resource.ID]*T, len(m))
+
+	for k, v := range m {
+		result[k] = v
+	}
+
+	return result
+}
@@ -0,0 +1,121 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/client"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// logTailLines is the number of the log lines to fetch when the log stream is started.
+const logTailLines = 100
+
+// LogSource provides the service log stream via Talos API.
+type LogSource struct {
+	*client.Client
+
+	ctxCancel context.CancelFunc
+
+	wg sync.WaitGroup
+}
+
+// Run the service log stream for the node, the previous stream is stopped.
+//
+// Empty node means the default node of the client.
+func (source *LogSource) Run(ctx context.Context, node, service string) <-chan string {
+	source.Stop()
+
+	lineCh := make(chan string)
+
+	ctx, source.ctxCancel = context.WithCancel(ctx)
+
+	if node != "" {
+		ctx = client.WithNodes(ctx, node)
+	}
+
+	source.wg.Add(1)
+
+	go source.run(ctx, service, lineCh)
+
+	return lineCh
+}
+
+func (source *LogSource) run(ctx context.Context, service string, lineCh chan<- string) {
+	defer source.wg.Done()
+	defer close(lineCh)
+
+	send := func(line string) bool {
+		select {
+		case lineCh <- line:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	stream, err := source.Logs(ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD, service, true, logTailLines)
+	if err != nil {
+		send(fmt.Sprintf("error fetching logs: %s", err))
+
+		return
+	}
+
+	var buf []byte
+
+	for {
+		msg, recvErr := stream.Recv()
+		if recvErr != nil {
+			if recvErr != io.EOF && client.StatusCode(recvErr) != codes.Canceled {
+				send(fmt.Sprintf("error getting logs: %s", recvErr))
+			}
+
+			return
+		}
+
+		if msg.Metadata != nil && msg.Metadata.Error != "" {
+			if !send(fmt.Sprintf("error getting logs: %s", msg.Metadata.Error)) {
+				return
+			}
+
+			continue
+		}
+
+		buf = append(buf, msg.Bytes...)
+
+		for {
+			idx := bytes.IndexByte(buf, '\n')
+			if idx < 0 {
+				break
+			}
+
+			if !send(string(buf[:idx])) {
+				return
+			}
+
+			buf = buf[idx+1:]
+		}
+	}
+}
+
+// Stop the log stream.
+func (source *LogSource) Stop() {
+	if source.ctxCancel == nil {
+		return
+	}
+
+	source.ctxCancel()
+	source.ctxCancel = nil
+
+	source.wg.Wait()
+}
@@ -0,0 +1,248 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dashboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/dashboard/data"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/kubespan"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// watchRetryInterval is the delay before re-establishing a broken watch, e.g. when the node reboots.
+const watchRetryInterval = 5 * time.Second
+
+// resourceWatch describes a resource kind watched by the dashboard.
+type resourceWatch struct {
+	namespace resource.Namespace
+	typ       resource.Type
+
+	// reset the watched data, as the watch sends the current state of all resources on (re)connect.
+	reset func(resources *data.Resources)
+	// apply the resource event, spec is nil if the resource was destroyed.
+	apply func(resources *data.Resources, id resource.ID, spec []byte) error
+}
+
+var resourceWatches = []resourceWatch{
+	{
+		namespace: v1alpha1.NamespaceName,
+		typ:       v1alpha1.ServiceType,
+		reset: func(resources *data.Resources) {
+			resources.Services = map[resource.ID]*v1alpha1.ServiceSpec{}
+		},
+		apply: func(resources *data.Resources, id resource.ID, spec []byte) error {
+			if spec == nil {
+				delete(resources.Services, id)
+
+				return nil
+			}
+
+			var serviceSpec v1alpha1.ServiceSpec
+
+			if err := yaml.Unmarshal(spec, &serviceSpec); err != nil {
+				return err
+			}
+
+			resources.Services[id] = &serviceSpec
+
+			return nil
+		},
+	},
+	{
+		namespace: etcd.NamespaceName,
+		typ:       etcd.MemberStatusType,
+		reset: func(resources *data.Resources) {
+			resources.EtcdMember = nil
+		},
+		apply: func(resources *data.Resources, id resource.ID, spec []byte) error {
+			if spec == nil {
+				resources.EtcdMember = nil
+
+				return nil
+			}
+
+			var memberSpec etcd.MemberStatusSpec
+
+			if err := yaml.Unmarshal(spec, &memberSpec); err != nil {
+				return err
+			}
+
+			resources.EtcdMember = &memberSpec
+
+			return nil
+		},
+	},
+	{
+		namespace: k8s.NamespaceName,
+		typ:       k8s.NodeStatusType,
+		reset: func(resources *data.Resources) {
+			resources.NodeStatus = nil
+		},
+		apply: func(resources *data.Resources, id resource.ID, spec []byte) error {
+			if spec == nil {
+				resources.NodeStatus = nil
+
+				return nil
+			}
+
+			var nodeStatusSpec k8s.NodeStatusSpec
+
+			if err := yaml.Unmarshal(spec, &nodeStatusSpec); err != nil {
+				return err
+			}
+
+			resources.NodeStatus = &nodeStatusSpec
+
+			return nil
+		},
+	},
+	{
+		namespace: k8s.NamespaceName,
+		typ:       k8s.StaticPodStatusType,
+		reset: func(resources *data.Resources) {
+			resources.StaticPods = map[resource.ID]*k8s.StaticPodStatusSpec{}
+		},
+		apply: func(resources *data.Resources, id resource.ID, spec []byte) error {
+			if spec == nil {
+				delete(resources.StaticPods, id)
+
+				return nil
+			}
+
+			var podStatusSpec k8s.StaticPodStatusSpec
+
+			if err := yaml.Unmarshal(spec, &podStatusSpec.PodStatus); err != nil {
+				return err
+			}
+
+			resources.StaticPods[id] = &podStatusSpec
+
+			return nil
+		},
+	},
+	{
+		namespace: kubespan.NamespaceName,
+		typ:       kubespan.PeerStatusType,
+		reset: func(resources *data.Resources) {
+			resources.KubeSpanPeers = map[resource.ID]*kubespan.PeerStatusSpec{}
+		},
+		apply: func(resources *data.Resources, id resource.ID, spec []byte) error {
+			if spec == nil {
+				delete(resources.KubeSpanPeers, id)
+
+				return nil
+			}
+
+			var peerStatusSpec kubespan.PeerStatusSpec
+
+			if err := yaml.Unmarshal(spec, &peerStatusSpec); err != nil {
+				return err
+			}
+
+			resources.KubeSpanPeers[id] = &peerStatusSpec
+
+			return nil
+		},
+	},
+}
+
+func (source *APISource) watch(w resourceWatch) {
+	defer source.wg.Done()
+
+	for {
+		//nolint:errcheck
+		source.watchKind(w)
+
+		select {
+		case <-source.ctx.Done():
+			return
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+func (source *APISource) watchKind(w resourceWatch) error {
+	ctx, cancel := context.WithCancel(source.ctx)
+	defer cancel()
+
+	watchClient, err := source.Resources.Watch(ctx, w.namespace, w.typ, "")
+	if err != nil {
+		return err
+	}
+
+	source.resourcesMu.Lock()
+
+	for _, resources := range source.resources {
+		w.reset(resources)
+	}
+
+	source.resourcesMu.Unlock()
+
+	for {
+		msg, recvErr := watchClient.Recv()
+		if recvErr != nil {
+			if msg.Metadata != nil {
+				// the error is reported for a single node (e.g. resource type is not supported),
+				// the watch is still running for the other nodes
+				continue
+			}
+
+			return recvErr
+		}
+
+		if msg.Resource == nil {
+			continue
+		}
+
+		var spec []byte
+
+		if msg.EventType != state.Destroyed {
+			spec, err = yaml.Marshal(msg.Resource.Spec())
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = source.applyEvent(w, msg.Metadata.GetHostname(), msg.Resource.Metadata().ID(), spec); err != nil {
+			return err
+		}
+	}
+}
+
+func (source *APISource) applyEvent(w resourceWatch, node string, id resource.ID, spec []byte) error {
+	source.resourcesMu.Lock()
+	defer source.resourcesMu.Unlock()
+
+	resources, ok := source.resources[node]
+	if !ok {
+		resources = data.NewResources()
+
+		source.resources[node] = resources
+	}
+
+	return w.apply(resources, id, spec)
+}
+
+// resourcesSnapshot returns a copy of the current resource state per node.
+func (source *APISource) resourcesSnapshot() map[string]*data.Resources {
+	source.resourcesMu.Lock()
+	defer source.resourcesMu.Unlock()
+
+	result := make(map[string]*data.Resources, len(source.resources))
+
+	for node, resources := range source.resources {
+		result[node] = resources.Clone()
+	}
+
+	return result
+}
@@ -17,6 +17,8 @@ import (
 )
 
 // APISource provides monitoring data via Talos API.
+//
+// Metrics are polled on interval, while resources are kept up to date via resource watches.
 type APISource struct {
 	*client.Client
 
@@ -26,6 +28,9 @@ type APISource struct {
 	ctxCancel context.CancelFunc
 
 	wg sync.WaitGroup
+
+	resourcesMu sync.Mutex
+	resources   map[string]*data.Resources
 }
 
 // Run the data poll on interval.
@@ -33,6 +38,13 @@ func (source *APISource) Run(ctx context.Context) <-chan *data.Data {
 	dataCh := make(chan *data.Data)
 
 	source.ctx, source.ctxCancel = context.WithCancel(ctx)
+	source.resources = map[string]*data.Resources{}
+
+	for _, w := range resourceWatches {
+		source.wg.Add(1)
+
+		go source.watch(w)
+	}
 
 	source.wg.Add(1)
 
@@ -287,6 +299,12 @@ func (source *APISource) gather() *data.Data {
 		_ = err
 	}
 
+	for node, resources := range source.resourcesSnapshot() {
+		if nodeData, ok := result.Nodes[node]; ok {
+			nodeData.Resources = resources
+		}
+	}
+
 	return result
 }
 
@@ -6,6 +6,7 @@ package dashboard
 
 import (
 	"context"
+	"fmt"
 
 	ui "github.com/gizak/termui/v3"
 
@@ -18,8 +19,37 @@ type DataWidget interface {
 	Update(node string, data *data.Data)
 }
 
+// ScrollableWidget is a widget which can be scrolled with the keyboard.
+type ScrollableWidget interface {
+	ui.Drawable
+
+	ScrollUp()
+	ScrollDown()
+	ScrollHalfPageUp()
+	ScrollHalfPageDown()
+	ScrollPageUp()
+	ScrollPageDown()
+	ScrollTop()
+}
+
+// Screen is a dashboard screen.
+type Screen int
+
+// Dashboard screens.
+const (
+	ScreenSummary Screen = iota
+	ScreenEtcd
+	ScreenKubernetes
+	ScreenKubeSpan
+	ScreenLogs
+)
+
+var screenNames = []string{"Summary", "etcd", "Kubernetes", "KubeSpan", "Logs"}
+
 // UI represents the grid, widgets and main loop.
 type UI struct {
+	LogSource *LogSource
+
 	infoGrid *ui.Grid
 	grid     *ui.Grid
 
@@ -37,27 +67,54 @@ type UI struct {
 	diskSparkline *components.BaseSparklineGroup
 	procTable     *components.ProcessTable
 
-	topLine *components.TopLine
-	tabs    *components.NodeTabs
+	etcdGrid         *ui.Grid
+	etcdInfo         *components.EtcdInfo
+	etcdMembersTable *components.EtcdMembersTable
+
+	kubernetesGrid *ui.Grid
+	nodeStatusInfo *components.NodeStatusInfo
+	staticPodTable *components.StaticPodTable
+
+	kubeSpanPeerTable *components.KubeSpanPeerTable
 
-	drawable    []ui.Drawable
+	logsGrid    *ui.Grid
+	serviceList *components.ServiceList
+	logView     *components.LogView
+
+	topLine    *components.TopLine
+	screenTabs *components.ScreenTabs
+	tabs       *components.NodeTabs
+
+	screen      Screen
 	dataWidgets []DataWidget
 
 	data *data.Data
+
+	ctx        context.Context //nolint:containedctx
+	logCh      <-chan string
+	logNode    string
+	logService string
 }
 
 // Main is the UI entrypoint.
 //
-//nolint:gocyclo
+//nolint:gocyclo,cyclop
 func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 	if err := ui.Init(); err != nil {
 		return err
 	}
 	defer ui.Close()
 
+	u.ctx = ctx
+
+	if u.LogSource != nil {
+		defer u.LogSource.Stop()
+	}
+
 	ui.Theme.Block.Title.Modifier = ui.ModifierBold
 
 	u.topLine = components.NewTopLine()
+	u.screenTabs = components.NewScreenTabs(screenNames...)
 	u.tabs = components.NewNodeTabs()
 
 	u.sysGauges = components.NewSystemGauges()
@@ -74,6 +131,17 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 	u.diskSparkline = components.NewDiskSparkline()
 	u.procTable = components.NewProcessTable()
 
+	u.etcdInfo = components.NewEtcdInfo()
+	u.etcdMembersTable = components.NewEtcdMembersTable()
+
+	u.nodeStatusInfo = components.NewNodeStatusInfo()
+	u.staticPodTable = components.NewStaticPodTable()
+
+	u.kubeSpanPeerTable = components.NewKubeSpanPeerTable()
+
+	u.serviceList = components.NewServiceList()
+	u.logView = components.NewLogView()
+
 	u.infoGrid = ui.NewGrid()
 	u.infoGrid.Set(
 		ui.NewRow(1,
@@ -101,6 +169,26 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 		),
 	)
 
+	u.etcdGrid = ui.NewGrid()
+	u.etcdGrid.Set(
+		ui.NewRow(1.0/4, u.etcdInfo),
+		ui.NewRow(3.0/4, u.etcdMembersTable),
+	)
+
+	u.kubernetesGrid = ui.NewGrid()
+	u.kubernetesGrid.Set(
+		ui.NewRow(1.0/4, u.nodeStatusInfo),
+		ui.NewRow(3.0/4, u.staticPodTable),
+	)
+
+	u.logsGrid = ui.NewGrid()
+	u.logsGrid.Set(
+		ui.NewRow(1,
+			ui.NewCol(1.0/5, u.serviceList),
+			ui.NewCol(4.0/5, u.logView),
+		),
+	)
+
 	termWidth, termHeight := ui.TerminalDimensions()
 	u.Resize(termWidth, termHeight)
 
@@ -117,10 +205,15 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 		u.netSparkline,
 		u.diskSparkline,
 		u.procTable,
+		u.etcdInfo,
+		u.etcdMembersTable,
+		u.nodeStatusInfo,
+		u.staticPodTable,
+		u.kubeSpanPeerTable,
+		u.serviceList,
 	}
 
-	u.drawable = []ui.Drawable{u.topLine, u.infoGrid, u.grid, u.tabs}
-	ui.Render(u.drawable...)
+	u.Render()
 
 	uiEvents := ui.PollEvents()
 
@@ -137,33 +230,41 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 
 				u.Resize(payload.Width, payload.Height)
 				ui.Clear()
-				ui.Render(u.drawable...)
+				u.Render()
+			case "1", "2", "3", "4", "5":
+				u.SwitchScreen(Screen(e.ID[0] - '1'))
 			case "h", "<Left>":
 				u.tabs.FocusLeft()
-				u.procTable.ScrollTop()
+				u.scrollable().ScrollTop()
 				u.UpdateData()
 			case "l", "<Right>":
 				u.tabs.FocusRight()
-				u.procTable.ScrollTop()
+				u.scrollable().ScrollTop()
+				u.UpdateData()
+			case "[":
+				u.serviceList.SelectPrev()
+				u.UpdateData()
+			case "]":
+				u.serviceList.SelectNext()
 				u.UpdateData()
 			case "j", "<Down>":
-				u.procTable.ScrollDown()
-				ui.Render(u.procTable)
+				u.scrollable().ScrollDown()
+				ui.Render(u.scrollable())
 			case "k", "<Up>":
-				u.procTable.ScrollUp()
-				ui.Render(u.procTable)
+				u.scrollable().ScrollUp()
+				ui.Render(u.scrollable())
 			case "<C-d>":
-				u.procTable.ScrollHalfPageDown()
-				ui.Render(u.procTable)
+				u.scrollable().ScrollHalfPageDown()
+				ui.Render(u.scrollable())
 			case "<C-u>":
-				u.procTable.ScrollHalfPageUp()
-				ui.Render(u.procTable)
+				u.scrollable().ScrollHalfPageUp()
+				ui.Render(u.scrollable())
 			case "<C-f>":
-				u.procTable.ScrollPageDown()
-				ui.Render(u.procTable)
+				u.scrollable().ScrollPageDown()
+				ui.Render(u.scrollable())
 			case "<C-b>":
-				u.procTable.ScrollPageUp()
-				ui.Render(u.procTable)
+				u.scrollable().ScrollPageUp()
+				ui.Render(u.scrollable())
 			}
 		case u.data, ok = <-dataCh:
 			if !ok {
@@ -171,6 +272,18 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 			}
 
 			u.UpdateData()
+		case line, more := <-u.logCh:
+			if !more {
+				u.logCh = nil
+
+				continue
+			}
+
+			u.logView.Append(line)
+
+			if u.screen == ScreenLogs {
+				ui.Render(u.logView)
+			}
 		case <-ctx.Done():
 			return ctx.Err()
 		}
@@ -180,14 +293,73 @@ func (u *UI) Main(ctx context.Context, dataCh <-chan *data.Data) error {
 // Resize handles the resize events.
 func (u *UI) Resize(width, height int) {
 	u.topLine.SetRect(0, 0, width, 1)
+	u.screenTabs.SetRect(0, 1, width, 2)
 	u.infoGrid.SetRect(0, 2, width, 9)
 	u.grid.SetRect(0, 9, width, height-1)
+	u.etcdGrid.SetRect(0, 2, width, height-1)
+	u.kubernetesGrid.SetRect(0, 2, width, height-1)
+	u.kubeSpanPeerTable.SetRect(0, 2, width, height-1)
+	u.logsGrid.SetRect(0, 2, width, height-1)
 	u.tabs.SetRect(0, height-1, width, height)
 }
 
+// SwitchScreen makes the screen active.
+func (u *UI) SwitchScreen(screen Screen) {
+	if int(screen) >= len(screenNames) {
+		return
+	}
+
+	u.screen = screen
+	u.screenTabs.ActiveTabIndex = int(screen)
+
+	ui.Clear()
+	u.UpdateData()
+}
+
+// Render draws the active screen.
+func (u *UI) Render() {
+	drawable := []ui.Drawable{u.topLine, u.screenTabs}
+
+	switch u.screen {
+	case ScreenSummary:
+		drawable = append(drawable, u.infoGrid, u.grid)
+	case ScreenEtcd:
+		drawable = append(drawable, u.etcdGrid)
+	case ScreenKubernetes:
+		drawable = append(drawable, u.kubernetesGrid)
+	case ScreenKubeSpan:
+		drawable = append(drawable, u.kubeSpanPeerTable)
+	case ScreenLogs:
+		drawable = append(drawable, u.logsGrid)
+	}
+
+	drawable = append(drawable, u.tabs)
+
+	ui.Render(drawable...)
+}
+
+// scrollable returns the widget which is scrolled on the active screen.
+func (u *UI) scrollable() ScrollableWidget {
+	switch u.screen {
+	case ScreenEtcd:
+		return u.etcdMembersTable
+	case ScreenKubernetes:
+		return u.staticPodTable
+	case ScreenKubeSpan:
+		return u.kubeSpanPeerTable
+	case ScreenLogs:
+		return u.logView
+	case ScreenSummary:
+	}
+
+	return u.procTable
+}
+
 // UpdateData re-renders the widgets with new data.
 func (u *UI) UpdateData() {
 	if u.data == nil {
+		u.Render()
+
 		return
 	}
 
@@ -202,5 +374,35 @@ func (u *UI) UpdateData() {
 		widget.Update(node, u.data)
 	}
 
-	ui.Render(u.drawable...)
+	u.updateLogStream(node)
+
+	u.Render()
+}
+
+// updateLogStream (re)starts the log stream for the selected node and service while the logs screen is active.
+func (u *UI) updateLogStream(node string) {
+	if u.LogSource == nil {
+		return
+	}
+
+	service := u.serviceList.Selected()
+
+	if u.screen != ScreenLogs || service == "" {
+		u.LogSource.Stop()
+
+		u.logCh = nil
+		u.logNode, u.logService = "", ""
+
+		return
+	}
+
+	// the stream is not restarted if it's finished, e.g. if the logs are not available
+	if u.logService == service && u.logNode == node {
+		return
+	}
+
+	u.logView.Reset(fmt.Sprintf("LOGS: %s", service))
+
+	u.logCh = u.LogSource.Run(u.ctx, node, service)
+	u.logNode, u.logService = node, service
 }
@@ -14,6 +14,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/output"
+	"github.com/talos-systems/talos/pkg/cli"
 	"github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
@@ -22,6 +24,7 @@ var eventsCmdFlags struct {
 	tailEvents   int32
 	tailDuration time.Duration
 	tailID       string
+	output       string
 }
 
 // eventsCmd represents the events command.
@@ -31,9 +34,6 @@ var eventsCmd = &cobra.Command{
 	Long:  ``,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-			fmt.Fprintln(w, "NODE\tID\tEVENT\tSOURCE\tMESSAGE")
-
 			opts := []client.EventsOptionFunc{}
 
 			if eventsCmdFlags.tailEvents != 0 {
@@ -48,6 +48,13 @@ var eventsCmd = &cobra.Command{
 				opts = append(opts, client.WithTailID(eventsCmdFlags.tailID))
 			}
 
+			if eventsCmdFlags.output != "table" {
+				return eventsData(ctx, c, eventsCmdFlags.output, opts)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tID\tEVENT\tSOURCE\tMESSAGE")
+
 			return c.EventsWatch(ctx, func(ch <-chan client.Event) {
 				for {
 					var (
@@ -88,6 +95,10 @@ var eventsCmd = &cobra.Command{
 						args = []interface{}{"error", msg.GetError()}
 					case *machine.AddressEvent:
 						args = []interface{}{msg.GetHostname(), fmt.Sprintf("ADDRESSES: %s", strings.Join(msg.GetAddresses(), ","))}
+					case *machine.RAIDArrayEvent:
+						args = []interface{}{msg.GetDevice(), fmt.Sprintf("%s: %s (%d/%d active)", msg.GetName(), msg.GetState(), msg.GetActiveDevices(), msg.GetTotalDevices())}
+					case *machine.UdevEvent:
+						args = []interface{}{msg.GetDevpath(), fmt.Sprintf("%s %s %s", strings.ToUpper(msg.GetAction()), msg.GetSubsystem(), msg.GetDevname())}
 					default:
 						// We haven't implemented the handling of this event yet.
 						continue
@@ -104,9 +115,62 @@ var eventsCmd = &cobra.Command{
 	},
 }
 
+func eventsData(ctx context.Context, c *client.Client, format string, opts []client.EventsOptionFunc) error {
+	w, err := output.NewDataWriter(format)
+	if err != nil {
+		return err
+	}
+
+	return c.EventsWatch(ctx, func(ch <-chan client.Event) {
+		for {
+			var (
+				event client.Event
+				ok    bool
+			)
+
+			select {
+			case event, ok = <-ch:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+
+			if event.Payload == nil {
+				continue
+			}
+
+			payload, marshalErr := output.ProtoData(event.Node, event.Payload)
+			if marshalErr != nil {
+				cli.Warning("error marshaling event %s: %s", event.ID, marshalErr)
+
+				continue
+			}
+
+			delete(payload, "node")
+
+			if writeErr := w.WriteData(map[string]interface{}{
+				"node":    event.Node,
+				"id":      event.ID,
+				"type":    event.TypeURL,
+				"payload": payload,
+			}); writeErr != nil {
+				cli.Warning("error writing event %s: %s", event.ID, writeErr)
+			}
+
+			//nolint:errcheck
+			w.Flush()
+		}
+	}, opts...)
+}
+
 func init() {
 	addCommand(eventsCmd)
 	eventsCmd.Flags().Int32Var(&eventsCmdFlags.tailEvents, "tail", 0, "show specified number of past events (use -1 to show full history, default is to show no history)")
 	eventsCmd.Flags().DurationVar(&eventsCmdFlags.tailDuration, "duration", 0, "show events for the past duration interval (one second resolution, default is to show no history)")
 	eventsCmd.Flags().StringVar(&eventsCmdFlags.tailID, "since", "", "show events after the specified event ID (default is to show no history)")
+	eventsCmd.Flags().StringVarP(&eventsCmdFlags.output, "output", "o", "table",
+		"output mode (json, table, yaml, jsonpath=<expr>, go-template=<template>, custom-columns=<spec>)")
+	cli.Should(eventsCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
 }
@@ -36,6 +36,7 @@ var imagesCmd = &cobra.Command{
 		fmt.Printf("%s\n", images.Flannel)
 		fmt.Printf("%s\n", images.FlannelCNI)
 		fmt.Printf("%s\n", images.CoreDNS)
+		fmt.Printf("%s\n", images.NodeLocalDNS)
 		fmt.Printf("%s\n", images.Etcd)
 		fmt.Printf("%s\n", images.KubeAPIServer)
 		fmt.Printf("%s\n", images.KubeControllerManager)
@@ -8,11 +8,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/output"
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/pkg/cli"
+	"github.com/talos-systems/talos/pkg/machinery/api/inspect"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
@@ -59,9 +66,123 @@ to render the graph:
 	},
 }
 
+var inspectControllersCmdFlags struct {
+	output string
+}
+
+// inspectControllersCmd represents the inspect controllers command.
+var inspectControllersCmd = &cobra.Command{
+	Use:   "controllers",
+	Short: "Inspect controller runtime reconcile loop statistics.",
+	Long: `Inspect controller runtime reconcile loop statistics.
+
+For each controller, the number of the processed reconcile events, the duration of the last reconcile loop,
+the number of the restarts caused by the errors and the last error are displayed.
+Controllers which are processing the reconcile event show the duration of the reconcile loop so far.
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.Inspect.ControllerRuntimeStats(ctx, grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting controller runtime stats: %s", err)
+				}
+
+				cli.Warning("%s", err)
+			}
+
+			if inspectControllersCmdFlags.output != "table" {
+				return controllerStatsData(&remotePeer, resp, inspectControllersCmdFlags.output)
+			}
+
+			return controllerStatsRender(&remotePeer, resp)
+		})
+	},
+}
+
+func controllerStatsRender(remotePeer *peer.Peer, resp *inspect.ControllerRuntimeStatsResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tCONTROLLER\tRECONCILES\tLAST DURATION\tRECONCILING\tRESTARTS\tBACKOFF\tLAST ERROR")
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, st := range msg.Controllers {
+			reconciling := ""
+
+			if st.Reconciling {
+				reconciling = time.Since(st.ReconcileStarted.AsTime()).Round(time.Millisecond).String()
+			}
+
+			lastError := ""
+
+			if st.LastError != "" {
+				lastError = fmt.Sprintf("%s ago: %s", time.Since(st.LastErrorTime.AsTime()).Round(time.Second), strings.SplitN(st.LastError, "\n", 2)[0])
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%d\t%s\t%s\n",
+				node,
+				st.ControllerName,
+				st.ReconcileCount,
+				st.LastReconcileDuration.AsDuration().Round(time.Microsecond),
+				reconciling,
+				st.RestartCount,
+				st.RestartBackoff.AsDuration().Round(time.Millisecond),
+				lastError,
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+func controllerStatsData(remotePeer *peer.Peer, resp *inspect.ControllerRuntimeStatsResponse, format string) error {
+	w, err := output.NewDataWriter(format)
+	if err != nil {
+		return err
+	}
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, st := range msg.Controllers {
+			data, dataErr := output.ProtoData(node, st)
+			if dataErr != nil {
+				return dataErr
+			}
+
+			if err = w.WriteData(data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
 func init() {
 	addCommand(inspectCmd)
 
 	inspectCmd.AddCommand(inspectDependenciesCmd)
 	inspectDependenciesCmd.Flags().BoolVar(&inspectDependenciesCmdFlags.withResources, "with-resources", false, "display live resource information with dependencies")
+
+	inspectCmd.AddCommand(inspectControllersCmd)
+	inspectControllersCmd.Flags().StringVarP(&inspectControllersCmdFlags.output, "output", "o", "table",
+		"output mode (json, table, yaml, jsonpath=<expr>, go-template=<template>, custom-columns=<spec>)")
+	cli.Should(inspectControllersCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
 }
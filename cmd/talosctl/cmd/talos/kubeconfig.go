@@ -13,13 +13,16 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/talos-systems/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
@@ -29,15 +32,34 @@ var (
 	merge            bool
 )
 
+var kubeconfigCmdFlags struct {
+	username string
+	groups   []string
+	crtTTL   time.Duration
+
+	oidcIssuerURL    string
+	oidcClientID     string
+	oidcClientSecret string
+	oidcExtraScopes  []string
+}
+
 // kubeconfigCmd represents the kubeconfig command.
 var kubeconfigCmd = &cobra.Command{
 	Use:   "kubeconfig [local-path]",
 	Short: "Download the admin kubeconfig from the node",
 	Long: `Download the admin kubeconfig from the node.
 If merge flag is defined, config will be merged with ~/.kube/config or [local-path] if specified.
-Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
+Otherwise kubeconfig will be written to PWD or [local-path] if specified.
+
+If --user flag is defined, kubeconfig is issued for the specified Kubernetes user and groups
+(requires os:kubeconfig:issuer role), users and groups with the "system:" prefix can't be requested.
+If --oidc-issuer-url flag is defined, kubeconfig uses OIDC login helper (kubectl oidc-login) instead of the client certificate.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if kubeconfigCmdFlags.username != "" && kubeconfigCmdFlags.oidcIssuerURL != "" {
+			return fmt.Errorf("--user and --oidc-issuer-url flags are mutually exclusive")
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			if err := helpers.FailIfMultiNodes(ctx, "kubeconfig"); err != nil {
 				return err
@@ -93,25 +115,14 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 				}
 			}
 
-			r, errCh, err := c.KubeconfigRaw(ctx)
-			if err != nil {
-				return fmt.Errorf("error copying: %w", err)
-			}
-
-			var wg sync.WaitGroup
+			var data []byte
 
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				for err := range errCh {
-					fmt.Fprintln(os.Stderr, err.Error())
-				}
-			}()
-
-			defer wg.Wait()
-			defer r.Close() //nolint:errcheck
+			if kubeconfigCmdFlags.username != "" || kubeconfigCmdFlags.oidcIssuerURL != "" {
+				data, err = generateKubeconfig(ctx, c)
+			} else {
+				data, err = downloadAdminKubeconfig(ctx, c)
+			}
 
-			data, err := helpers.ExtractFileFromTarGz("kubeconfig", r)
 			if err != nil {
 				return err
 			}
@@ -125,6 +136,56 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 	},
 }
 
+func downloadAdminKubeconfig(ctx context.Context, c *client.Client) ([]byte, error) {
+	r, errCh, err := c.KubeconfigRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error copying: %w", err)
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for err := range errCh {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+	}()
+
+	defer wg.Wait()
+	defer r.Close() //nolint:errcheck
+
+	return helpers.ExtractFileFromTarGz("kubeconfig", r)
+}
+
+func generateKubeconfig(ctx context.Context, c *client.Client) ([]byte, error) {
+	req := &machineapi.GenerateKubeconfigRequest{
+		Username: kubeconfigCmdFlags.username,
+		Groups:   kubeconfigCmdFlags.groups,
+		CrtTtl:   durationpb.New(kubeconfigCmdFlags.crtTTL),
+	}
+
+	if kubeconfigCmdFlags.oidcIssuerURL != "" {
+		req.Oidc = &machineapi.GenerateKubeconfigOIDC{
+			IssuerUrl:    kubeconfigCmdFlags.oidcIssuerURL,
+			ClientId:     kubeconfigCmdFlags.oidcClientID,
+			ClientSecret: kubeconfigCmdFlags.oidcClientSecret,
+			ExtraScopes:  kubeconfigCmdFlags.oidcExtraScopes,
+		}
+	}
+
+	resp, err := c.GenerateKubeconfig(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error generating kubeconfig: %w", err)
+	}
+
+	if l := len(resp.Messages); l != 1 {
+		return nil, fmt.Errorf("expected 1 message, got %d", l)
+	}
+
+	return resp.Messages[0].Kubeconfig, nil
+}
+
 func extractAndMerge(data []byte, localPath string) error {
 	config, err := clientcmd.Load(data)
 	if err != nil {
@@ -186,5 +247,12 @@ func init() {
 	kubeconfigCmd.Flags().BoolVarP(&force, "force", "f", false, "Force overwrite of kubeconfig if already present, force overwrite on kubeconfig merge")
 	kubeconfigCmd.Flags().StringVar(&forceContextName, "force-context-name", "", "Force context name for kubeconfig merge")
 	kubeconfigCmd.Flags().BoolVarP(&merge, "merge", "m", true, "Merge with existing kubeconfig")
+	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.username, "user", "", "issue kubeconfig for the specified Kubernetes user instead of the admin")
+	kubeconfigCmd.Flags().StringSliceVar(&kubeconfigCmdFlags.groups, "groups", nil, "Kubernetes groups of the user (used with --user)")
+	kubeconfigCmd.Flags().DurationVar(&kubeconfigCmdFlags.crtTTL, "crt-ttl", 24*time.Hour, "client certificate TTL, at most 168h (used with --user)")
+	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.oidcIssuerURL, "oidc-issuer-url", "", "generate kubeconfig which uses OIDC login helper with the specified issuer URL")
+	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.oidcClientID, "oidc-client-id", "", "OIDC client ID (used with --oidc-issuer-url)")
+	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.oidcClientSecret, "oidc-client-secret", "", "OIDC client secret (used with --oidc-issuer-url)")
+	kubeconfigCmd.Flags().StringSliceVar(&kubeconfigCmdFlags.oidcExtraScopes, "oidc-extra-scope", nil, "extra OIDC scopes to request (used with --oidc-issuer-url)")
 	addCommand(kubeconfigCmd)
 }
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Output format prefixes for the formats which accept an argument.
+const (
+	JSONPathPrefix      = "jsonpath="
+	GoTemplatePrefix    = "go-template="
+	CustomColumnsPrefix = "custom-columns="
+)
+
+// DataWriter outputs generic data records.
+//
+// DataWriter is used by the commands which don't operate on resources, each record is a single item
+// of the API response with the `node` field set.
+type DataWriter interface {
+	WriteData(data map[string]interface{}) error
+	Flush() error
+}
+
+// NewDataWriter builds data writer from the format.
+func NewDataWriter(format string) (DataWriter, error) {
+	return newDataWriter(os.Stdout, format)
+}
+
+func newDataWriter(w io.Writer, format string) (DataWriter, error) {
+	switch {
+	case format == "json":
+		return &jsonData{w: w}, nil
+	case format == "yaml":
+		return &yamlData{w: w}, nil
+	case strings.HasPrefix(format, JSONPathPrefix):
+		return newJSONPath(w, strings.TrimPrefix(format, JSONPathPrefix))
+	case strings.HasPrefix(format, GoTemplatePrefix):
+		return newGoTemplate(w, strings.TrimPrefix(format, GoTemplatePrefix))
+	case strings.HasPrefix(format, CustomColumnsPrefix):
+		return newCustomColumns(w, strings.TrimPrefix(format, CustomColumnsPrefix))
+	default:
+		return nil, fmt.Errorf("output format %q is not supported", format)
+	}
+}
+
+// ProtoData converts the protobuf message to the data record for the node.
+func ProtoData(node string, msg proto.Message) (map[string]interface{}, error) {
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+
+	if err = json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+
+	data["node"] = node
+
+	return data, nil
+}
+
+// resourceData converts the resource to the data record for the node.
+func resourceData(node string, r resource.Resource, withEvents bool, event state.EventType) (map[string]interface{}, error) {
+	out, err := resource.MarshalYAML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	yamlBytes, err := yaml.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+
+	if err = yaml.Unmarshal(yamlBytes, &data); err != nil {
+		return nil, err
+	}
+
+	data["node"] = node
+
+	if withEvents {
+		data["event"] = strings.ToLower(event.String())
+	}
+
+	return data, nil
+}
+
+// resourceDataWriter adapts DataWriter to the Writer interface.
+type resourceDataWriter struct {
+	DataWriter
+
+	withEvents bool
+}
+
+// WriteHeader implements output.Writer interface.
+func (d *resourceDataWriter) WriteHeader(definition resource.Resource, withEvents bool) error {
+	d.withEvents = withEvents
+
+	return nil
+}
+
+// WriteResource implements output.Writer interface.
+func (d *resourceDataWriter) WriteResource(node string, r resource.Resource, event state.EventType) error {
+	data, err := resourceData(node, r, d.withEvents, event)
+	if err != nil {
+		return err
+	}
+
+	return d.WriteData(data)
+}
+
+type jsonData struct {
+	w io.Writer
+}
+
+func (j *jsonData) WriteData(data map[string]interface{}) error {
+	enc := json.NewEncoder(j.w)
+	enc.SetIndent("", "    ")
+
+	return enc.Encode(data)
+}
+
+func (j *jsonData) Flush() error {
+	return nil
+}
+
+type yamlData struct {
+	w          io.Writer
+	needDashes bool
+}
+
+func (y *yamlData) WriteData(data map[string]interface{}) error {
+	if y.needDashes {
+		fmt.Fprintln(y.w, "---")
+	}
+
+	y.needDashes = true
+
+	return yaml.NewEncoder(y.w).Encode(data)
+}
+
+func (y *yamlData) Flush() error {
+	return nil
+}
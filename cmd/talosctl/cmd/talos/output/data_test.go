@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output //nolint:testpackage // to test unexported functions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/common"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+func TestNewDataWriter(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{
+		"json",
+		"yaml",
+		"jsonpath={.node}",
+		"go-template={{ .node }}",
+		"custom-columns=NODE:.node",
+	} {
+		_, err := newDataWriter(&bytes.Buffer{}, format)
+		assert.NoError(t, err, format)
+	}
+
+	for _, format := range []string{
+		"table",
+		"jsonpath",
+		"jsonpath={.node",
+		"go-template={{ .node",
+		"custom-columns=NODE",
+	} {
+		_, err := newDataWriter(&bytes.Buffer{}, format)
+		assert.Error(t, err, format)
+	}
+}
+
+func TestJSONData(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	w, err := newDataWriter(&buf, "json")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteData(map[string]interface{}{"node": "10.5.0.2", "spec": map[string]interface{}{"id": "apid"}}))
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, `{
+    "node": "10.5.0.2",
+    "spec": {
+        "id": "apid"
+    }
+}
+`, buf.String())
+}
+
+func TestYAMLData(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	w, err := newDataWriter(&buf, "yaml")
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteData(map[string]interface{}{"node": "10.5.0.2", "id": "apid"}))
+	require.NoError(t, w.WriteData(map[string]interface{}{"node": "10.5.0.3", "id": "etcd"}))
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, `id: apid
+node: 10.5.0.2
+---
+id: etcd
+node: 10.5.0.3
+`, buf.String())
+}
+
+func TestProtoData(t *testing.T) {
+	t.Parallel()
+
+	data, err := ProtoData("10.5.0.2", &common.Metadata{Hostname: "talos-default-master-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"node":     "10.5.0.2",
+		"hostname": "talos-default-master-1",
+	}, data)
+}
+
+func TestResourceDataWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	dataWriter, err := newDataWriter(&buf, "custom-columns=NODE:.node,EVENT:.event,ID:.metadata.id,NODENAME:.spec.nodename")
+	require.NoError(t, err)
+
+	w := &resourceDataWriter{DataWriter: dataWriter}
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "talos-default-master-1"
+
+	require.NoError(t, w.WriteHeader(nodename, true))
+	require.NoError(t, w.WriteResource("10.5.0.2", nodename, state.Created))
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, `NODE       EVENT     ID         NODENAME
+10.5.0.2   created   nodename   talos-default-master-1
+`, buf.String())
+}
@@ -7,11 +7,9 @@ package output
 import (
 	"encoding/json"
 	"os"
-	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
-	yaml "gopkg.in/yaml.v3"
 )
 
 // JSON outputs resources in JSON format.
@@ -33,29 +31,11 @@ func (j *JSON) WriteHeader(definition resource.Resource, withEvents bool) error
 
 // WriteResource implements output.Writer interface.
 func (j *JSON) WriteResource(node string, r resource.Resource, event state.EventType) error {
-	out, err := resource.MarshalYAML(r)
+	data, err := resourceData(node, r, j.withEvents, event)
 	if err != nil {
 		return err
 	}
 
-	yamlBytes, err := yaml.Marshal(out)
-	if err != nil {
-		return err
-	}
-
-	var data map[string]interface{}
-
-	err = yaml.Unmarshal(yamlBytes, &data)
-	if err != nil {
-		return err
-	}
-
-	data["node"] = node
-
-	if j.withEvents {
-		data["event"] = strings.ToLower(event.String())
-	}
-
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "    ")
 
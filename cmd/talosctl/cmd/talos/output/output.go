@@ -7,6 +7,8 @@ package output
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -22,13 +24,22 @@ type Writer interface {
 
 // NewWriter builds writer from type.
 func NewWriter(format string) (Writer, error) {
-	switch format {
-	case "table":
+	switch {
+	case format == "table":
 		return NewTable(), nil
-	case "yaml":
+	case format == "yaml":
 		return NewYAML(), nil
-	case "json":
+	case format == "json":
 		return NewJSON(), nil
+	case strings.HasPrefix(format, JSONPathPrefix),
+		strings.HasPrefix(format, GoTemplatePrefix),
+		strings.HasPrefix(format, CustomColumnsPrefix):
+		w, err := newDataWriter(os.Stdout, format)
+		if err != nil {
+			return nil, err
+		}
+
+		return &resourceDataWriter{DataWriter: w}, nil
 	default:
 		return nil, fmt.Errorf("output format %q is not supported", format)
 	}
@@ -36,5 +47,5 @@ func NewWriter(format string) (Writer, error) {
 
 // CompleteOutputArg represents tab completion for `--output` argument.
 func CompleteOutputArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return []string{"json", "table", "yaml"}, cobra.ShellCompDirectiveNoFileComp
+	return []string{"json", "table", "yaml", JSONPathPrefix, GoTemplatePrefix, CustomColumnsPrefix}, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
 }
@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	yaml "gopkg.in/yaml.v3"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// JSONPath outputs each data record using the JSONPath template.
+type JSONPath struct {
+	w    io.Writer
+	expr *jsonpath.JSONPath
+}
+
+func newJSONPath(w io.Writer, expr string) (*JSONPath, error) {
+	parsed, err := parseJSONPath("jsonpath", expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONPath{
+		w:    w,
+		expr: parsed,
+	}, nil
+}
+
+// WriteData implements output.DataWriter interface.
+func (j *JSONPath) WriteData(data map[string]interface{}) error {
+	var buf bytes.Buffer
+
+	if err := j.expr.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	return writeLine(j.w, buf.Bytes())
+}
+
+// Flush implements output.DataWriter interface.
+func (j *JSONPath) Flush() error {
+	return nil
+}
+
+// GoTemplate outputs each data record using the Go template.
+type GoTemplate struct {
+	w    io.Writer
+	tmpl *template.Template
+}
+
+func newGoTemplate(w io.Writer, text string) (*GoTemplate, error) {
+	tmpl, err := template.New("output").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+
+			return string(b), err
+		},
+		"yaml": func(v interface{}) (string, error) {
+			b, err := yaml.Marshal(v)
+
+			return string(b), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	return &GoTemplate{
+		w:    w,
+		tmpl: tmpl,
+	}, nil
+}
+
+// WriteData implements output.DataWriter interface.
+func (g *GoTemplate) WriteData(data map[string]interface{}) error {
+	var buf bytes.Buffer
+
+	if err := g.tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	return writeLine(g.w, buf.Bytes())
+}
+
+// Flush implements output.DataWriter interface.
+func (g *GoTemplate) Flush() error {
+	return nil
+}
+
+// CustomColumns outputs data records as a table with the columns defined by JSONPath expressions.
+//
+// Columns are specified as `NAME:.path.to.field,OTHER:.other.field`.
+type CustomColumns struct {
+	w       tabwriter.Writer
+	columns []*jsonpath.JSONPath
+
+	needHeader bool
+	header     []string
+}
+
+func newCustomColumns(w io.Writer, spec string) (*CustomColumns, error) {
+	output := &CustomColumns{
+		needHeader: true,
+	}
+	output.w.Init(w, 0, 0, 3, ' ', 0)
+
+	for _, column := range strings.Split(spec, ",") {
+		name, expr, ok := strings.Cut(column, ":")
+		if !ok || name == "" || expr == "" {
+			return nil, fmt.Errorf("invalid custom column %q, expected NAME:EXPRESSION", column)
+		}
+
+		parsed, err := parseJSONPath(name, expr)
+		if err != nil {
+			return nil, err
+		}
+
+		output.header = append(output.header, name)
+		output.columns = append(output.columns, parsed)
+	}
+
+	return output, nil
+}
+
+// WriteData implements output.DataWriter interface.
+func (c *CustomColumns) WriteData(data map[string]interface{}) error {
+	if c.needHeader {
+		c.needHeader = false
+
+		if _, err := fmt.Fprintln(&c.w, strings.Join(c.header, "\t")); err != nil {
+			return err
+		}
+	}
+
+	values := make([]string, 0, len(c.columns))
+
+	for _, column := range c.columns {
+		var buf bytes.Buffer
+
+		if err := column.Execute(&buf, data); err != nil {
+			return err
+		}
+
+		value := buf.String()
+		if value == "" {
+			value = "<none>"
+		}
+
+		values = append(values, value)
+	}
+
+	_, err := fmt.Fprintln(&c.w, strings.Join(values, "\t"))
+
+	return err
+}
+
+// Flush implements output.DataWriter interface.
+func (c *CustomColumns) Flush() error {
+	return c.w.Flush()
+}
+
+// parseJSONPath parses JSONPath expression, curly braces might be omitted (as in `.spec.field`).
+func parseJSONPath(name, expr string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+
+	parsed := jsonpath.New(name).AllowMissingKeys(true)
+
+	if err := parsed.Parse(expr); err != nil {
+		return nil, fmt.Errorf("error parsing %q jsonpath: %w", name, err)
+	}
+
+	return parsed, nil
+}
+
+func writeLine(w io.Writer, b []byte) error {
+	if len(b) == 0 || b[len(b)-1] != '\n' {
+		b = append(b, '\n')
+	}
+
+	_, err := w.Write(b)
+
+	return err
+}
@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output //nolint:testpackage // to test unexported functions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testData() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"node": "10.5.0.2",
+			"spec": map[string]interface{}{
+				"id":    "apid",
+				"state": "Running",
+				"ports": []interface{}{"50000", "50001"},
+			},
+		},
+		{
+			"node": "10.5.0.3",
+			"spec": map[string]interface{}{
+				"id": "etcd",
+			},
+		},
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		expr     string
+		expected string
+	}{
+		{
+			name:     "no braces",
+			expr:     ".spec.id",
+			expected: "apid",
+		},
+		{
+			name:     "braces",
+			expr:     "{.spec.id}",
+			expected: "apid",
+		},
+		{
+			name:     "multiple expressions",
+			expr:     "{.node} {.spec.id}",
+			expected: "10.5.0.2 apid",
+		},
+		{
+			name:     "range",
+			expr:     "{range .spec.ports[*]}{@};{end}",
+			expected: "50000;50001;",
+		},
+		{
+			name: "missing key",
+			expr: ".spec.missing",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parseJSONPath(tt.name, tt.expr)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+
+			require.NoError(t, parsed.Execute(&buf, testData()[0]))
+
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+
+	for _, expr := range []string{
+		"{.spec.id",
+		".spec[id",
+	} {
+		_, err := parseJSONPath("invalid", expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCustomColumns(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	w, err := newCustomColumns(&buf, "NODE:.node,ID:{.spec.id},STATE:.spec.state")
+	require.NoError(t, err)
+
+	for _, data := range testData() {
+		require.NoError(t, w.WriteData(data))
+	}
+
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, `NODE       ID     STATE
+10.5.0.2   apid   Running
+10.5.0.3   etcd   <none>
+`, buf.String())
+}
+
+func TestCustomColumnsInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{
+		"",
+		"NODE",
+		"NODE:.node,ID",
+		":.node",
+		"NODE:",
+		"NODE:{.node",
+	} {
+		_, err := newCustomColumns(&bytes.Buffer{}, spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	w, err := newJSONPath(&buf, "{.node}: {.spec.id}")
+	require.NoError(t, err)
+
+	for _, data := range testData() {
+		require.NoError(t, w.WriteData(data))
+	}
+
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, "10.5.0.2: apid\n10.5.0.3: etcd\n", buf.String())
+}
+
+func TestGoTemplate(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	w, err := newGoTemplate(&buf, `{{ .node }} {{ json .spec }}`)
+	require.NoError(t, err)
+
+	for _, data := range testData() {
+		require.NoError(t, w.WriteData(data))
+	}
+
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, "10.5.0.2 {\"id\":\"apid\",\"ports\":[\"50000\",\"50001\"],\"state\":\"Running\"}\n10.5.0.3 {\"id\":\"etcd\"}\n", buf.String())
+
+	_, err = newGoTemplate(&buf, `{{ .node `)
+	assert.Error(t, err)
+}
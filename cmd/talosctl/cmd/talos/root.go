@@ -9,8 +9,10 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"os"
 	"sort"
 	"strings"
+	"time"
 
 	criconstants "github.com/containerd/containerd/pkg/cri/constants"
 	"github.com/spf13/cobra"
@@ -71,11 +73,38 @@ func WithClientNoNodes(action func(context.Context, *client.Client) error) error
 			//nolint:errcheck
 			defer c.Close()
 
+			if len(Endpoints) == 0 {
+				refreshEndpoints(ctx, c, cfg)
+			}
+
 			return action(ctx, c)
 		},
 	)
 }
 
+// refreshEndpoints updates the discovered endpoints in the config context if endpoint discovery is enabled.
+//
+// Errors are not fatal, as the command might still succeed with the cached or static endpoints.
+func refreshEndpoints(ctx context.Context, c *client.Client, cfg *clientconfig.Config) {
+	configContext := c.GetConfigContext()
+	if configContext == nil || !configContext.EndpointDiscovery.NeedsRefresh(time.Now()) {
+		return
+	}
+
+	endpoints, err := c.DiscoverEndpoints(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to discover endpoints: %s\n", err)
+
+		return
+	}
+
+	configContext.EndpointDiscovery.Update(endpoints, time.Now())
+
+	if err = cfg.Save(Talosconfig); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to save discovered endpoints: %s\n", err)
+	}
+}
+
 // WithClient builds upon WithClientNoNodes to provide set of nodes on request context based on config & flags.
 func WithClient(action func(context.Context, *client.Client) error) error {
 	return WithClientNoNodes(
@@ -14,10 +14,16 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
+	"github.com/talos-systems/talos/cmd/talosctl/cmd/talos/output"
 	"github.com/talos-systems/talos/pkg/cli"
+	machineapi "github.com/talos-systems/talos/pkg/machinery/api/machine"
 	"github.com/talos-systems/talos/pkg/machinery/client"
 )
 
+var serviceCmdFlags struct {
+	output string
+}
+
 // serviceCmd represents the service command.
 var serviceCmd = &cobra.Command{
 	Use:     "service [<id> [start|stop|restart|status]]",
@@ -82,6 +88,10 @@ func serviceList(ctx context.Context, c *client.Client) error {
 		cli.Warning("%s", err)
 	}
 
+	if serviceCmdFlags.output != "table" {
+		return serviceListData(&remotePeer, resp, serviceCmdFlags.output)
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
 
@@ -104,6 +114,36 @@ func serviceList(ctx context.Context, c *client.Client) error {
 	return w.Flush()
 }
 
+func serviceListData(remotePeer *peer.Peer, resp *machineapi.ServiceListResponse, format string) error {
+	w, err := output.NewDataWriter(format)
+	if err != nil {
+		return err
+	}
+
+	defaultNode := client.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		for _, s := range msg.Services {
+			data, dataErr := output.ProtoData(node, s)
+			if dataErr != nil {
+				return dataErr
+			}
+
+			if err = w.WriteData(data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
 func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -216,5 +256,9 @@ func serviceRestart(ctx context.Context, c *client.Client, id string) error {
 }
 
 func init() {
+	serviceCmd.Flags().StringVarP(&serviceCmdFlags.output, "output", "o", "table",
+		"output mode for the service list (json, table, yaml, jsonpath=<expr>, go-template=<template>, custom-columns=<spec>)")
+	cli.Should(serviceCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
+
 	addCommand(serviceCmd)
 }
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pxe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/server4"
+	"github.com/insomniacslk/dhcp/iana"
+
+	"github.com/talos-systems/talos/pkg/provision/providers/vm"
+)
+
+// iPXE binaries served over TFTP.
+const (
+	IPXEBIOS  = "undionly.kpxe"
+	IPXEAMD64 = "ipxe.efi"
+	IPXEARM64 = "ipxe-arm64.efi"
+)
+
+const pxeClientClass = "PXEClient"
+
+// pxeBootServerPort is the port of the PXE boot server, clients send DHCPREQUEST to it after the proxy offer.
+const pxeBootServerPort = 4011
+
+// pxeVendorOptions is the PXE vendor option 43 with discovery control (sub-option 6) set to
+// "download the boot file from the offer", so that the clients skip the boot server discovery.
+var pxeVendorOptions = []byte{6, 1, 0x08, 255}
+
+// BootFilename returns the boot filename for the PXE client.
+//
+// Firmware PXE clients get the iPXE binary for their architecture, while iPXE gets the boot script URL.
+func BootFilename(m *dhcpv4.DHCPv4, httpAddr string) (string, error) {
+	for _, userClass := range m.UserClass() {
+		if userClass == "iPXE" {
+			return fmt.Sprintf("http://%s/boot.ipxe", httpAddr), nil
+		}
+	}
+
+	arches := m.ClientArch()
+	if len(arches) == 0 {
+		return IPXEBIOS, nil
+	}
+
+	switch arches[0] { //nolint:exhaustive
+	case iana.INTEL_X86PC:
+		return IPXEBIOS, nil
+	case iana.EFI_X86_64, iana.EFI_BC:
+		return IPXEAMD64, nil
+	case iana.EFI_ARM64:
+		return IPXEARM64, nil
+	default:
+		return "", fmt.Errorf("unsupported client architecture %s", arches[0])
+	}
+}
+
+func (s *Server) serveDHCP(ctx context.Context, port int) error {
+	server, err := server4.NewServer(s.options.Interface, &net.UDPAddr{IP: net.IPv4zero, Port: port}, s.handlerDHCP4, server4.WithSummaryLogger())
+	if err != nil {
+		return fmt.Errorf("error on dhcp4 startup: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		server.Close() //nolint:errcheck
+	}()
+
+	log.Printf("serving DHCP proxy on %s:%d", s.options.Interface, port)
+
+	if err = server.Serve(); err != nil && ctx.Err() == nil {
+		return err
+	}
+
+	return nil
+}
+
+// handlerDHCP4 implements DHCP proxy: the response doesn't contain the IP address,
+// only the boot information, so that it can be used alongside the existing DHCP server.
+func (s *Server) handlerDHCP4(conn net.PacketConn, peer net.Addr, m *dhcpv4.DHCPv4) {
+	if m.OpCode != dhcpv4.OpcodeBootRequest {
+		return
+	}
+
+	if !strings.HasPrefix(m.ClassIdentifier(), pxeClientClass) {
+		return
+	}
+
+	var messageType dhcpv4.MessageType
+
+	switch mt := m.MessageType(); mt { //nolint:exhaustive
+	case dhcpv4.MessageTypeDiscover:
+		messageType = dhcpv4.MessageTypeOffer
+	case dhcpv4.MessageTypeRequest:
+		// only answer the requests addressed to this server, requests to other DHCP servers are ignored
+		if !m.ServerIdentifier().Equal(s.options.ServerIP) && !isBootServerConn(conn) {
+			return
+		}
+
+		messageType = dhcpv4.MessageTypeAck
+	default:
+		return
+	}
+
+	bootFilename, err := BootFilename(m, s.HTTPAddr())
+	if err != nil {
+		log.Printf("not replying to %s: %s", m.ClientHWAddr, err)
+
+		return
+	}
+
+	log.Printf("sending PXE response to %s: %s", m.ClientHWAddr, bootFilename)
+
+	resp, err := dhcpv4.NewReplyFromRequest(m,
+		dhcpv4.WithMessageType(messageType),
+		dhcpv4.WithOption(dhcpv4.OptServerIdentifier(s.options.ServerIP)),
+		dhcpv4.WithOption(dhcpv4.OptClassIdentifier(pxeClientClass)),
+		dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionVendorSpecificInformation, pxeVendorOptions)),
+		vm.WithPXEBoot(s.options.ServerIP.String(), bootFilename),
+	)
+	if err != nil {
+		log.Printf("failure building response: %s", err)
+
+		return
+	}
+
+	// client doesn't have an address yet
+	if udpAddr, ok := peer.(*net.UDPAddr); ok && udpAddr.IP.IsUnspecified() {
+		peer = &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4.ClientPort}
+	}
+
+	if _, err = conn.WriteTo(resp.ToBytes(), peer); err != nil {
+		log.Printf("failure sending response: %s", err)
+	}
+}
+
+func isBootServerConn(conn net.PacketConn) bool {
+	addr, ok := conn.LocalAddr().(*net.UDPAddr)
+
+	return ok && addr.Port == pxeBootServerPort
+}
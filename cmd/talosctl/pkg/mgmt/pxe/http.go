@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pxe
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/kernel"
+)
+
+// DefaultConfig is the name of the machine config used for the nodes without the MAC-specific config,
+// if enabled with Options.ServeDefaultConfig.
+const DefaultConfig = "default.yaml"
+
+// bootScript chainloads the node-specific script passing the MAC address and the architecture.
+const bootScript = `#!ipxe
+chain http://{{ .HTTPAddr }}/ipxe?mac=${mac:hexhyp}&arch=${buildarch}
+`
+
+const nodeScript = `#!ipxe
+kernel http://{{ .HTTPAddr }}/assets/vmlinuz-{{ .Arch }} {{ .KernelArgs }}
+initrd http://{{ .HTTPAddr }}/assets/initramfs-{{ .Arch }}.xz
+boot
+`
+
+var (
+	bootScriptTemplate = template.Must(template.New("boot").Parse(bootScript))
+	nodeScriptTemplate = template.Must(template.New("node").Parse(nodeScript))
+)
+
+// Handler returns the HTTP handler serving iPXE scripts, assets and machine configs.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/boot.ipxe", s.handleBootScript)
+	mux.HandleFunc("/ipxe", s.handleNodeScript)
+	mux.HandleFunc("/config", s.handleConfig)
+	mux.Handle("/assets/", http.StripPrefix("/assets/", http.FileServer(http.Dir(s.options.AssetsDir))))
+
+	return logRequests(mux)
+}
+
+func (s *Server) handleBootScript(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+
+	if err := bootScriptTemplate.Execute(&buf, struct{ HTTPAddr string }{s.HTTPAddr()}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Write(buf.Bytes()) //nolint:errcheck
+}
+
+func (s *Server) handleNodeScript(w http.ResponseWriter, r *http.Request) {
+	arch, err := NormalizeArch(r.URL.Query().Get("arch"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	mac, err := net.ParseMAC(strings.ReplaceAll(r.URL.Query().Get("mac"), "-", ":"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid MAC address: %s", err), http.StatusBadRequest)
+
+		return
+	}
+
+	_, configFound, err := s.lookupConfig(mac)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	script, err := s.NodeScript(arch, mac, configFound)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Write(script) //nolint:errcheck
+}
+
+func (s *Server) handleConfig(w http.ResponseWriter, r *http.Request) {
+	mac, err := net.ParseMAC(strings.ReplaceAll(r.URL.Query().Get("mac"), "-", ":"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid MAC address: %s", err), http.StatusBadRequest)
+
+		return
+	}
+
+	path, found, err := s.lookupConfig(mac)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	if !found {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	log.Printf("serving machine config %q to %s", filepath.Base(path), mac)
+
+	http.ServeFile(w, r, path)
+}
+
+// NodeScript renders the iPXE script which boots Talos.
+//
+// If there is no machine config for the node, Talos boots into the maintenance mode.
+func (s *Server) NodeScript(arch string, mac net.HardwareAddr, withConfig bool) ([]byte, error) {
+	cmdline := procfs.NewCmdline("")
+
+	cmdline.SetAll(kernel.DefaultArgs)
+	cmdline.Append(constants.KernelParamPlatform, "metal")
+
+	if err := cmdline.AppendAll(s.options.ExtraKernelArgs); err != nil {
+		return nil, err
+	}
+
+	if withConfig {
+		cmdline.Append(constants.KernelParamConfig, fmt.Sprintf("http://%s/config?mac=%s", s.HTTPAddr(), strings.ReplaceAll(mac.String(), ":", "-")))
+	}
+
+	var buf bytes.Buffer
+
+	if err := nodeScriptTemplate.Execute(&buf, struct {
+		HTTPAddr   string
+		Arch       string
+		KernelArgs string
+	}{
+		HTTPAddr:   s.HTTPAddr(),
+		Arch:       arch,
+		KernelArgs: cmdline.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// NormalizeArch converts iPXE build architecture to the Talos architecture.
+func NormalizeArch(arch string) (string, error) {
+	switch arch {
+	case "x86_64", "i386", "amd64":
+		return "amd64", nil
+	case "arm64", "aarch64":
+		return "arm64", nil
+	default:
+		return "", fmt.Errorf("unsupported architecture %q", arch)
+	}
+}
+
+// lookupConfig finds the machine config for the MAC address.
+//
+// Configs are looked up as <mac>.yaml with the MAC address either colon- or dash-separated,
+// default.yaml is used as a fallback only if enabled.
+func (s *Server) lookupConfig(mac net.HardwareAddr) (string, bool, error) {
+	if s.options.ConfigsDir == "" {
+		return "", false, nil
+	}
+
+	names := []string{
+		mac.String() + ".yaml",
+		strings.ReplaceAll(mac.String(), ":", "-") + ".yaml",
+	}
+
+	if s.options.ServeDefaultConfig {
+		names = append(names, DefaultConfig)
+	}
+
+	for _, name := range names {
+		path := filepath.Join(s.options.ConfigsDir, name)
+
+		_, err := os.Stat(path)
+		if err == nil {
+			return path, true, nil
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", false, err
+		}
+	}
+
+	return "", false, nil
+}
+
+// tftpReadHandler serves iPXE binaries from the assets directory.
+func (s *Server) tftpReadHandler(filename string, rf io.ReaderFrom) error {
+	path := filepath.Join(s.options.AssetsDir, filepath.Base(filepath.Clean("/"+filename)))
+
+	f, err := os.Open(path)
+	if err != nil {
+		log.Printf("TFTP: error serving %q: %s", filename, err)
+
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	log.Printf("TFTP: serving %q", filename)
+
+	_, err = rf.ReadFrom(f)
+
+	return err
+}
+
+func logRequests(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("HTTP: %s %s from %s", r.Method, r.URL, r.RemoteAddr)
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package pxe implements PXE boot server for bare metal Talos nodes.
+//
+// Server runs DHCP proxy which points PXE clients to the iPXE binaries served over TFTP,
+// iPXE then fetches the boot script, kernel, initramfs and machine config over HTTP.
+package pxe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/pin/tftp"
+	"golang.org/x/sync/errgroup"
+)
+
+// Options configures the PXE server.
+type Options struct {
+	// Interface to run DHCP proxy on, DHCP proxy is disabled if empty.
+	Interface string
+	// ServerIP is the address of the server advertised to the clients.
+	ServerIP net.IP
+
+	HTTPPort int
+	TFTPPort int
+
+	// AssetsDir contains kernel, initramfs (vmlinuz-<arch>, initramfs-<arch>.xz) and iPXE binaries.
+	AssetsDir string
+	// ConfigsDir contains machine configs named after the MAC address (<mac>.yaml).
+	ConfigsDir string
+	// ServeDefaultConfig enables serving default.yaml from ConfigsDir to any machine without the MAC-specific config.
+	//
+	// Machine configs contain cluster secrets and are served over plain HTTP, so with the default config enabled
+	// anyone who can reach the HTTP server can fetch it.
+	ServeDefaultConfig bool
+
+	ExtraKernelArgs []string
+}
+
+// Server is the PXE boot server.
+type Server struct {
+	options Options
+}
+
+// NewServer initializes the PXE server.
+func NewServer(options Options) (*Server, error) {
+	if options.ServerIP == nil {
+		if options.Interface == "" {
+			return nil, errors.New("either server address or interface should be specified")
+		}
+
+		ip, err := interfaceIPv4(options.Interface)
+		if err != nil {
+			return nil, err
+		}
+
+		options.ServerIP = ip
+	}
+
+	if options.ServerIP.To4() == nil {
+		return nil, fmt.Errorf("server address %s is not an IPv4 address", options.ServerIP)
+	}
+
+	return &Server{
+		options: options,
+	}, nil
+}
+
+// HTTPAddr returns the address of the HTTP server advertised to the clients.
+func (s *Server) HTTPAddr() string {
+	return net.JoinHostPort(s.options.ServerIP.String(), strconv.Itoa(s.options.HTTPPort))
+}
+
+// Serve runs the DHCP proxy, TFTP and HTTP servers until the context is canceled.
+func (s *Server) Serve(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+
+	httpServer := &http.Server{
+		Addr:    net.JoinHostPort("", strconv.Itoa(s.options.HTTPPort)),
+		Handler: s.Handler(),
+	}
+
+	tftpServer := tftp.NewServer(s.tftpReadHandler, nil)
+
+	eg.Go(func() error {
+		log.Printf("serving HTTP on %s", httpServer.Addr)
+
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	})
+
+	eg.Go(func() error {
+		log.Printf("serving TFTP on :%d", s.options.TFTPPort)
+
+		return tftpServer.ListenAndServe(net.JoinHostPort("", strconv.Itoa(s.options.TFTPPort)))
+	})
+
+	if s.options.Interface != "" {
+		eg.Go(func() error {
+			return s.serveDHCP(ctx, dhcpv4.ServerPort)
+		})
+
+		eg.Go(func() error {
+			return s.serveDHCP(ctx, pxeBootServerPort)
+		})
+	}
+
+	eg.Go(func() error {
+		<-ctx.Done()
+
+		tftpServer.Shutdown()
+
+		return httpServer.Close()
+	})
+
+	return eg.Wait()
+}
+
+func interfaceIPv4(ifName string) (net.IP, error) {
+	iface, err := net.InterfaceByName(ifName)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up interface: %w", err)
+	}
+
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, fmt.Errorf("error listing interface addresses: %w", err)
+	}
+
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+			return ipNet.IP.To4(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("no IPv4 address found on interface %q", ifName)
+}
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pxe_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/cmd/talosctl/pkg/mgmt/pxe"
+)
+
+func TestBootFilename(t *testing.T) {
+	mac := net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}
+
+	for _, tt := range []struct {
+		name      string
+		modifiers []dhcpv4.Modifier
+		expected  string
+	}{
+		{
+			name:     "no arch",
+			expected: pxe.IPXEBIOS,
+		},
+		{
+			name:      "bios",
+			modifiers: []dhcpv4.Modifier{dhcpv4.WithOption(dhcpv4.OptClientArch(iana.INTEL_X86PC))},
+			expected:  pxe.IPXEBIOS,
+		},
+		{
+			name:      "efi amd64",
+			modifiers: []dhcpv4.Modifier{dhcpv4.WithOption(dhcpv4.OptClientArch(iana.EFI_X86_64))},
+			expected:  pxe.IPXEAMD64,
+		},
+		{
+			name:      "efi arm64",
+			modifiers: []dhcpv4.Modifier{dhcpv4.WithOption(dhcpv4.OptClientArch(iana.EFI_ARM64))},
+			expected:  pxe.IPXEARM64,
+		},
+		{
+			name: "ipxe",
+			modifiers: []dhcpv4.Modifier{
+				dhcpv4.WithOption(dhcpv4.OptClientArch(iana.EFI_X86_64)),
+				dhcpv4.WithOption(dhcpv4.OptUserClass([]byte("iPXE"))),
+			},
+			expected: "http://10.5.0.1:8081/boot.ipxe",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := dhcpv4.NewDiscovery(mac, tt.modifiers...)
+			require.NoError(t, err)
+
+			filename, err := pxe.BootFilename(m, "10.5.0.1:8081")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, filename)
+		})
+	}
+}
+
+func TestNormalizeArch(t *testing.T) {
+	arch, err := pxe.NormalizeArch("x86_64")
+	require.NoError(t, err)
+	assert.Equal(t, "amd64", arch)
+
+	arch, err = pxe.NormalizeArch("arm64")
+	require.NoError(t, err)
+	assert.Equal(t, "arm64", arch)
+
+	_, err = pxe.NormalizeArch("riscv64")
+	assert.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	configsDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(configsDir, "52-54-00-12-34-56.yaml"), []byte("node config"), 0o644))
+
+	server, err := pxe.NewServer(pxe.Options{
+		ServerIP:        net.ParseIP("10.5.0.1"),
+		HTTPPort:        8081,
+		ConfigsDir:      configsDir,
+		ExtraKernelArgs: []string{"console=ttyS0"},
+	})
+	require.NoError(t, err)
+
+	get := func(url string) (int, string) {
+		w := httptest.NewRecorder()
+
+		server.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+
+		body, readErr := io.ReadAll(w.Result().Body)
+		require.NoError(t, readErr)
+
+		return w.Code, string(body)
+	}
+
+	code, body := get("/boot.ipxe")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "chain http://10.5.0.1:8081/ipxe?mac=${mac:hexhyp}&arch=${buildarch}")
+
+	code, body = get("/ipxe?mac=52-54-00-12-34-56&arch=x86_64")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "kernel http://10.5.0.1:8081/assets/vmlinuz-amd64 ")
+	assert.Contains(t, body, "talos.platform=metal")
+	assert.Contains(t, body, "console=ttyS0")
+	assert.Contains(t, body, "talos.config=http://10.5.0.1:8081/config?mac=52-54-00-12-34-56")
+	assert.Contains(t, body, "initrd http://10.5.0.1:8081/assets/initramfs-amd64.xz")
+
+	// no config, maintenance mode
+	code, body = get("/ipxe?mac=52-54-00-00-00-01&arch=arm64")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "vmlinuz-arm64")
+	assert.NotContains(t, body, "talos.config")
+
+	code, body = get("/config?mac=52:54:00:12:34:56")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "node config", body)
+
+	code, _ = get("/config?mac=52-54-00-00-00-01")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// default config is not served unless enabled
+	require.NoError(t, os.WriteFile(filepath.Join(configsDir, pxe.DefaultConfig), []byte("default config"), 0o644))
+
+	code, _ = get("/config?mac=52-54-00-00-00-01")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, body = get("/ipxe?mac=52-54-00-00-00-01&arch=arm64")
+	assert.Equal(t, http.StatusOK, code)
+	assert.NotContains(t, body, "talos.config")
+
+	code, _ = get("/ipxe?mac=52-54-00-00-00-01&arch=mips")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// fallback to the default config
+	server, err = pxe.NewServer(pxe.Options{
+		ServerIP:           net.ParseIP("10.5.0.1"),
+		HTTPPort:           8081,
+		ConfigsDir:         configsDir,
+		ServeDefaultConfig: true,
+	})
+	require.NoError(t, err)
+
+	code, body = get("/ipxe?mac=52-54-00-00-00-01&arch=arm64")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "talos.config=http://10.5.0.1:8081/config?mac=52-54-00-00-00-01")
+
+	code, body = get("/config?mac=52-54-00-00-00-01")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "default config", body)
+
+	code, body = get("/config?mac=52-54-00-12-34-56")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "node config", body)
+}
@@ -0,0 +1,459 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	serverpb "github.com/talos-systems/discovery-api/api/v1alpha1/server/pb"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"inet.af/netaddr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/talos-systems/talos/internal/pkg/discovery/blocklist"
+	"github.com/talos-systems/talos/internal/pkg/discovery/registry"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	taloscluster "github.com/talos-systems/talos/pkg/cluster"
+	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	clusterapi "github.com/talos-systems/talos/pkg/machinery/api/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+)
+
+// RemoveMember implements the cluster.ClusterServer interface.
+//
+// The member is blocked from (re)joining the cluster with the same node ID on this node.
+// Control plane nodes additionally publish the block to the Kubernetes registry, so that it applies to the whole cluster,
+// and remove the member from the discovery service, Kubernetes and etcd.
+func (s *Server) RemoveMember(ctx context.Context, in *clusterapi.RemoveMemberRequest) (*clusterapi.RemoveMemberResponse, error) {
+	nodeID, actions, err := s.memberRemover().remove(ctx, in.GetMember(), in.GetForce())
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterapi.RemoveMemberResponse{
+		Messages: []*clusterapi.RemoveMember{
+			{
+				NodeId:  nodeID,
+				Actions: actions,
+			},
+		},
+	}, nil
+}
+
+// ClearRemovedMember implements the cluster.ClusterServer interface.
+//
+// Control plane nodes additionally clear the block in the Kubernetes registry.
+func (s *Server) ClearRemovedMember(ctx context.Context, in *clusterapi.ClearRemovedMemberRequest) (*clusterapi.ClearRemovedMemberResponse, error) {
+	if err := s.memberRemover().clear(ctx, in.GetNodeId()); err != nil {
+		return nil, err
+	}
+
+	return &clusterapi.ClearRemovedMemberResponse{
+		Messages: []*clusterapi.ClearRemovedMember{
+			{},
+		},
+	}, nil
+}
+
+func (s *Server) memberRemover() *memberRemover {
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	return &memberRemover{
+		resources:       resources,
+		blocklistPath:   filepath.Join(constants.StateMountPoint, constants.RemovedMembersFilename),
+		controlPlane:    s.checkControlplane("remove member") == nil,
+		deleteAffiliate: deleteDiscoveryAffiliate,
+		withKubernetes: func(ctx context.Context, f func(kubernetesMembers) error) error {
+			return withKubernetesClient(ctx, func(client *kubernetes.Client) error {
+				return f(&kubernetesMemberClient{
+					Kubernetes: registry.NewKubernetes(client),
+					client:     client,
+				})
+			})
+		},
+		etcdClient: func(ctx context.Context) (etcdMembers, error) {
+			client, err := etcd.NewClientFromControlPlaneIPs(ctx, resources)
+			if err != nil {
+				return nil, err
+			}
+
+			return &etcdMemberClient{Client: client}, nil
+		},
+	}
+}
+
+// kubernetesMembers is the set of Kubernetes operations used to remove the member.
+type kubernetesMembers interface {
+	BlockMember(ctx context.Context, nodeID string) error
+	UnblockMember(ctx context.Context, nodeID string) error
+	// DeleteNode returns false if the node doesn't exist.
+	DeleteNode(ctx context.Context, nodename string) (bool, error)
+}
+
+type kubernetesMemberClient struct {
+	*registry.Kubernetes
+
+	client *kubernetes.Client
+}
+
+func (c *kubernetesMemberClient) DeleteNode(ctx context.Context, nodename string) (bool, error) {
+	err := c.client.CoreV1().Nodes().Delete(ctx, nodename, metav1.DeleteOptions{})
+
+	switch {
+	case err == nil:
+		return true, nil
+	case apierrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// etcdMembers is the set of etcd operations used to remove the member.
+type etcdMembers interface {
+	MemberList(ctx context.Context) (*clientv3.MemberListResponse, error)
+	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
+	MemberHealth(ctx context.Context, member *etcdserverpb.Member) error
+	Close() error
+}
+
+type etcdMemberClient struct {
+	*etcd.Client
+}
+
+func (c *etcdMemberClient) MemberHealth(ctx context.Context, member *etcdserverpb.Member) error {
+	return etcd.ValidateMemberHealth(ctx, member.GetClientURLs())
+}
+
+// memberRemover implements the member removal steps.
+type memberRemover struct {
+	resources     state.State
+	blocklistPath string
+	controlPlane  bool
+
+	deleteAffiliate func(ctx context.Context, discoveryConfig *cluster.ConfigSpec, nodeID string) error
+	withKubernetes  func(ctx context.Context, f func(kubernetesMembers) error) error
+	etcdClient      func(ctx context.Context) (etcdMembers, error)
+}
+
+// remove blocks the member and evicts it from the cluster on control plane nodes.
+//
+// The node ID of the member and the steps which were performed are returned.
+func (m *memberRemover) remove(ctx context.Context, name string, force bool) (string, []string, error) {
+	member, err := findMember(ctx, m.resources, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	identity, err := m.resources.Get(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.IdentityType, cluster.LocalIdentity, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return "", nil, fmt.Errorf("error getting local identity: %w", err)
+	}
+
+	if identity != nil && identity.(*cluster.Identity).TypedSpec().NodeID == member.NodeID {
+		return "", nil, status.Errorf(codes.FailedPrecondition, "member %q is the local node, it can't be removed from itself", name)
+	}
+
+	if err = blocklist.Add(ctx, m.resources, m.blocklistPath, cluster.RemovedMemberSpec{
+		NodeID:   member.NodeID,
+		Nodename: member.Nodename,
+		Hostname: member.Hostname,
+	}); err != nil {
+		return "", nil, fmt.Errorf("error blocking member: %w", err)
+	}
+
+	actions := []string{"blocked node ID"}
+
+	if m.controlPlane {
+		evicted, err := m.evict(ctx, member, force)
+
+		actions = append(actions, evicted...)
+
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return member.NodeID, actions, nil
+}
+
+// clear removes the block of the member.
+func (m *memberRemover) clear(ctx context.Context, nodeID string) error {
+	removed, err := blocklist.Remove(ctx, m.resources, m.blocklistPath, nodeID)
+	if err != nil {
+		return fmt.Errorf("error clearing removed member: %w", err)
+	}
+
+	if m.controlPlane {
+		if err = m.withKubernetes(ctx, func(client kubernetesMembers) error {
+			return client.UnblockMember(ctx, nodeID)
+		}); err != nil {
+			return fmt.Errorf("error clearing removed member in Kubernetes: %w", err)
+		}
+
+		removed = true
+	}
+
+	if !removed {
+		return status.Errorf(codes.NotFound, "node ID %q is not blocked", nodeID)
+	}
+
+	return nil
+}
+
+type removedMember struct {
+	NodeID      string
+	Nodename    string
+	Hostname    string
+	MachineType machine.Type
+	Addresses   []netaddr.IP
+}
+
+// findMember looks up the member by node ID, node name or hostname.
+//
+// If no member matches, the name is used as a node ID, so that members which are already gone can be blocked.
+func findMember(ctx context.Context, resources state.State, name string) (removedMember, error) {
+	members, err := resources.List(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.MemberType, "", resource.VersionUndefined))
+	if err != nil {
+		return removedMember{}, fmt.Errorf("error listing members: %w", err)
+	}
+
+	var found []removedMember
+
+	for _, res := range members.Items {
+		spec := res.(*cluster.Member).TypedSpec()
+
+		if res.Metadata().ID() == name || spec.NodeID == name || spec.Hostname == name {
+			found = append(found, removedMember{
+				NodeID:      spec.NodeID,
+				Nodename:    res.Metadata().ID(),
+				Hostname:    spec.Hostname,
+				MachineType: spec.MachineType,
+				Addresses:   spec.Addresses,
+			})
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return removedMember{NodeID: name}, nil
+	case 1:
+		return found[0], nil
+	default:
+		return removedMember{}, status.Errorf(codes.InvalidArgument, "member %q is ambiguous, use node ID instead", name)
+	}
+}
+
+// evict removes the member from the discovery service, Kubernetes and etcd.
+//
+// Steps which were performed or skipped are returned.
+//
+//nolint:gocyclo
+func (m *memberRemover) evict(ctx context.Context, member removedMember, force bool) ([]string, error) {
+	var actions []string
+
+	discoveryConfig, err := m.resources.Get(ctx, resource.NewMetadata(config.NamespaceName, cluster.ConfigType, cluster.ConfigID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return actions, fmt.Errorf("error getting discovery config: %w", err)
+	}
+
+	if discoveryConfig != nil && discoveryConfig.(*cluster.Config).TypedSpec().RegistryServiceEnabled {
+		if err = m.deleteAffiliate(ctx, discoveryConfig.(*cluster.Config).TypedSpec(), member.NodeID); err != nil {
+			return actions, fmt.Errorf("error deleting affiliate from discovery service: %w", err)
+		}
+
+		actions = append(actions, "deleted affiliate from discovery service")
+	}
+
+	if err = m.withKubernetes(ctx, func(client kubernetesMembers) error {
+		if blockErr := client.BlockMember(ctx, member.NodeID); blockErr != nil {
+			return fmt.Errorf("error blocking member in Kubernetes: %w", blockErr)
+		}
+
+		actions = append(actions, "blocked node ID in Kubernetes")
+
+		if member.Nodename == "" {
+			actions = append(actions, "skipped Kubernetes node deletion: member not found")
+
+			return nil
+		}
+
+		deleted, deleteErr := client.DeleteNode(ctx, member.Nodename)
+		if deleteErr != nil {
+			return fmt.Errorf("error deleting Kubernetes node %q: %w", member.Nodename, deleteErr)
+		}
+
+		if deleted {
+			actions = append(actions, fmt.Sprintf("deleted Kubernetes node %q", member.Nodename))
+		}
+
+		return nil
+	}); err != nil {
+		return actions, err
+	}
+
+	switch {
+	case member.Nodename == "":
+		return append(actions, "skipped etcd member removal: member not found"), nil
+	case member.MachineType != machine.TypeControlPlane && member.MachineType != machine.TypeInit:
+		return actions, nil
+	}
+
+	etcdClient, err := m.etcdClient(ctx)
+	if err != nil {
+		return actions, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer etcdClient.Close()
+
+	ctx = clientv3.WithRequireLeader(ctx)
+
+	resp, err := etcdClient.MemberList(ctx)
+	if err != nil {
+		return actions, fmt.Errorf("error listing etcd members: %w", err)
+	}
+
+	etcdMember := findEtcdMember(resp.Members, member.Addresses)
+	if etcdMember == nil {
+		return append(actions, "skipped etcd member removal: no etcd member with the member addresses"), nil
+	}
+
+	if !force {
+		if err = checkEtcdQuorum(resp.Members, etcdMember.ID, func(candidate *etcdserverpb.Member) bool {
+			return etcdClient.MemberHealth(ctx, candidate) == nil
+		}); err != nil {
+			return actions, status.Errorf(codes.FailedPrecondition, "etcd member %016x can't be removed safely (use --force to remove it anyway): %s", etcdMember.ID, err)
+		}
+	}
+
+	if _, err = etcdClient.MemberRemove(ctx, etcdMember.ID); err != nil {
+		return actions, fmt.Errorf("error removing etcd member %016x: %w", etcdMember.ID, err)
+	}
+
+	return append(actions, fmt.Sprintf("removed etcd member %016x", etcdMember.ID)), nil
+}
+
+// findEtcdMember finds the etcd member with peer URLs pointing to one of the addresses.
+func findEtcdMember(members []*etcdserverpb.Member, addresses []netaddr.IP) *etcdserverpb.Member {
+	for _, etcdMember := range members {
+		for _, peerURL := range etcdMember.PeerURLs {
+			u, err := url.Parse(peerURL)
+			if err != nil {
+				continue
+			}
+
+			ip, err := netaddr.ParseIP(u.Hostname())
+			if err != nil {
+				continue
+			}
+
+			for _, addr := range addresses {
+				if addr == ip {
+					return etcdMember
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkEtcdQuorum verifies that healthy voting etcd members keep the quorum once the member is removed.
+func checkEtcdQuorum(members []*etcdserverpb.Member, id uint64, healthy func(*etcdserverpb.Member) bool) error {
+	var remaining, healthyRemaining int
+
+	for _, etcdMember := range members {
+		if etcdMember.IsLearner {
+			// learners don't participate in the quorum
+			if etcdMember.ID == id {
+				return nil
+			}
+
+			continue
+		}
+
+		if etcdMember.ID == id {
+			continue
+		}
+
+		remaining++
+
+		if healthy(etcdMember) {
+			healthyRemaining++
+		}
+	}
+
+	if remaining == 0 {
+		return fmt.Errorf("refusing to remove the last etcd member")
+	}
+
+	if quorum := remaining/2 + 1; healthyRemaining < quorum {
+		return fmt.Errorf("only %d of %d remaining voting members are healthy, quorum requires %d", healthyRemaining, remaining, quorum)
+	}
+
+	return nil
+}
+
+// withKubernetesClient runs the function with the Kubernetes client which uses the roles of the API request.
+func withKubernetesClient(ctx context.Context, f func(client *kubernetes.Client) error) error {
+	clientProvider := &taloscluster.LocalClientProvider{}
+	defer clientProvider.Close() //nolint:errcheck
+
+	k8sProvider := &taloscluster.KubernetesClient{
+		ClientProvider: clientProvider,
+	}
+	defer k8sProvider.K8sClose() //nolint:errcheck
+
+	md := metadata.New(nil)
+	authz.SetMetadata(md, authz.GetRoles(ctx))
+
+	clientset, err := k8sProvider.K8sClient(metadata.NewOutgoingContext(ctx, md))
+	if err != nil {
+		return fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	return f(&kubernetes.Client{Clientset: clientset})
+}
+
+func deleteDiscoveryAffiliate(ctx context.Context, discoveryConfig *cluster.ConfigSpec, nodeID string) error {
+	transportCredentials := credentials.NewTLS(&tls.Config{})
+
+	if discoveryConfig.ServiceEndpointInsecure {
+		transportCredentials = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.DialContext(ctx, discoveryConfig.ServiceEndpoint, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	_, err = serverpb.NewClusterClient(conn).AffiliateDelete(ctx, &serverpb.AffiliateDeleteRequest{
+		ClusterId:   discoveryConfig.ServiceClusterID,
+		AffiliateId: nodeID,
+	})
+
+	return err
+}
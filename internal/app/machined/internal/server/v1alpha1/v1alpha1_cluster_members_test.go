@@ -0,0 +1,410 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime //nolint:testpackage // to test unexported types
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"inet.af/netaddr"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+)
+
+const (
+	localNodeID  = "local-node-id"
+	cpNodeID     = "cp-node-id"
+	workerNodeID = "worker-node-id"
+)
+
+type mockKubernetesMembers struct {
+	blocked map[string]struct{}
+	nodes   map[string]struct{}
+}
+
+func (k *mockKubernetesMembers) BlockMember(ctx context.Context, nodeID string) error {
+	k.blocked[nodeID] = struct{}{}
+
+	return nil
+}
+
+func (k *mockKubernetesMembers) UnblockMember(ctx context.Context, nodeID string) error {
+	delete(k.blocked, nodeID)
+
+	return nil
+}
+
+func (k *mockKubernetesMembers) DeleteNode(ctx context.Context, nodename string) (bool, error) {
+	if _, ok := k.nodes[nodename]; !ok {
+		return false, nil
+	}
+
+	delete(k.nodes, nodename)
+
+	return true, nil
+}
+
+type mockEtcdMembers struct {
+	members   []*etcdserverpb.Member
+	unhealthy map[uint64]struct{}
+	removed   []uint64
+}
+
+func (e *mockEtcdMembers) MemberList(ctx context.Context) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Members: e.members}, nil
+}
+
+func (e *mockEtcdMembers) MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error) {
+	e.removed = append(e.removed, id)
+
+	return &clientv3.MemberRemoveResponse{}, nil
+}
+
+func (e *mockEtcdMembers) MemberHealth(ctx context.Context, member *etcdserverpb.Member) error {
+	if _, ok := e.unhealthy[member.ID]; ok {
+		return errors.New("unhealthy")
+	}
+
+	return nil
+}
+
+func (e *mockEtcdMembers) Close() error {
+	return nil
+}
+
+type ClusterMembersSuite struct {
+	suite.Suite
+
+	state state.State
+
+	kubernetes        *mockKubernetesMembers
+	etcd              *mockEtcdMembers
+	deletedAffiliates []string
+	blocklistPath     string
+	controlPlane      bool
+}
+
+func (suite *ClusterMembersSuite) SetupTest() {
+	ctx := context.Background()
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+	suite.blocklistPath = filepath.Join(suite.T().TempDir(), "removed-members.yaml")
+	suite.deletedAffiliates = nil
+	suite.controlPlane = true
+
+	identity := cluster.NewIdentity(cluster.NamespaceName, cluster.LocalIdentity)
+	identity.TypedSpec().NodeID = localNodeID
+	suite.Require().NoError(suite.state.Create(ctx, identity))
+
+	discoveryConfig := cluster.NewConfig(config.NamespaceName, cluster.ConfigID)
+	discoveryConfig.TypedSpec().RegistryServiceEnabled = true
+	suite.Require().NoError(suite.state.Create(ctx, discoveryConfig))
+
+	for _, member := range []struct {
+		nodename    string
+		nodeID      string
+		machineType machine.Type
+		address     string
+	}{
+		{"cp-1", localNodeID, machine.TypeInit, "172.20.0.2"},
+		{"cp-2", cpNodeID, machine.TypeControlPlane, "172.20.0.3"},
+		{"worker-1", workerNodeID, machine.TypeWorker, "172.20.0.5"},
+	} {
+		res := cluster.NewMember(cluster.NamespaceName, member.nodename)
+		*res.TypedSpec() = cluster.MemberSpec{
+			NodeID:      member.nodeID,
+			Hostname:    member.nodename + ".example.com",
+			MachineType: member.machineType,
+			Addresses:   []netaddr.IP{netaddr.MustParseIP(member.address)},
+		}
+
+		suite.Require().NoError(suite.state.Create(ctx, res))
+	}
+
+	suite.kubernetes = &mockKubernetesMembers{
+		blocked: map[string]struct{}{},
+		nodes: map[string]struct{}{
+			"cp-1":     {},
+			"cp-2":     {},
+			"worker-1": {},
+		},
+	}
+
+	suite.etcd = &mockEtcdMembers{
+		members: []*etcdserverpb.Member{
+			{ID: 0x1, Name: "cp-1.example.com", PeerURLs: []string{"https://172.20.0.2:2380"}},
+			{ID: 0x2, Name: "cp-2.example.com", PeerURLs: []string{"https://172.20.0.3:2380"}},
+			{ID: 0x3, Name: "cp-3.example.com", PeerURLs: []string{"https://172.20.0.4:2380"}},
+		},
+		unhealthy: map[uint64]struct{}{},
+	}
+}
+
+func (suite *ClusterMembersSuite) remover() *memberRemover {
+	return &memberRemover{
+		resources:     suite.state,
+		blocklistPath: suite.blocklistPath,
+		controlPlane:  suite.controlPlane,
+		deleteAffiliate: func(ctx context.Context, discoveryConfig *cluster.ConfigSpec, nodeID string) error {
+			suite.deletedAffiliates = append(suite.deletedAffiliates, nodeID)
+
+			return nil
+		},
+		withKubernetes: func(ctx context.Context, f func(kubernetesMembers) error) error {
+			return f(suite.kubernetes)
+		},
+		etcdClient: func(ctx context.Context) (etcdMembers, error) {
+			return suite.etcd, nil
+		},
+	}
+}
+
+func (suite *ClusterMembersSuite) assertBlocked(nodeID string) {
+	_, err := suite.state.Get(context.Background(), resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, nodeID, resource.VersionUndefined))
+	suite.Assert().NoError(err)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveControlPlaneMember() {
+	nodeID, actions, err := suite.remover().remove(context.Background(), "cp-2.example.com", false)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(cpNodeID, nodeID)
+	suite.Assert().Equal([]string{
+		"blocked node ID",
+		"deleted affiliate from discovery service",
+		"blocked node ID in Kubernetes",
+		`deleted Kubernetes node "cp-2"`,
+		"removed etcd member 0000000000000002",
+	}, actions)
+
+	suite.assertBlocked(cpNodeID)
+	suite.Assert().Equal([]string{cpNodeID}, suite.deletedAffiliates)
+	suite.Assert().Contains(suite.kubernetes.blocked, cpNodeID)
+	suite.Assert().NotContains(suite.kubernetes.nodes, "cp-2")
+	suite.Assert().Equal([]uint64{0x2}, suite.etcd.removed)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveWorkerMember() {
+	nodeID, actions, err := suite.remover().remove(context.Background(), "worker-1", false)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(workerNodeID, nodeID)
+	suite.Assert().Equal([]string{
+		"blocked node ID",
+		"deleted affiliate from discovery service",
+		"blocked node ID in Kubernetes",
+		`deleted Kubernetes node "worker-1"`,
+	}, actions)
+
+	suite.Assert().Empty(suite.etcd.removed)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveRawNodeID() {
+	nodeID, actions, err := suite.remover().remove(context.Background(), "gone-node-id", false)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("gone-node-id", nodeID)
+	suite.Assert().Equal([]string{
+		"blocked node ID",
+		"deleted affiliate from discovery service",
+		"blocked node ID in Kubernetes",
+		"skipped Kubernetes node deletion: member not found",
+		"skipped etcd member removal: member not found",
+	}, actions)
+
+	suite.assertBlocked("gone-node-id")
+	suite.Assert().Len(suite.kubernetes.nodes, 3)
+	suite.Assert().Empty(suite.etcd.removed)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveLocalNode() {
+	_, _, err := suite.remover().remove(context.Background(), "cp-1", false)
+	suite.Require().Error(err)
+
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+	suite.Assert().Empty(suite.deletedAffiliates)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveOnWorker() {
+	suite.controlPlane = false
+
+	nodeID, actions, err := suite.remover().remove(context.Background(), "cp-2", false)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(cpNodeID, nodeID)
+	suite.Assert().Equal([]string{"blocked node ID"}, actions)
+
+	suite.assertBlocked(cpNodeID)
+	suite.Assert().Empty(suite.deletedAffiliates)
+	suite.Assert().Empty(suite.kubernetes.blocked)
+	suite.Assert().Empty(suite.etcd.removed)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveWithoutQuorum() {
+	suite.etcd.unhealthy[0x3] = struct{}{}
+
+	_, _, err := suite.remover().remove(context.Background(), "cp-2", false)
+	suite.Require().Error(err)
+
+	suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+	suite.Assert().Empty(suite.etcd.removed)
+
+	_, actions, err := suite.remover().remove(context.Background(), "cp-2", true)
+	suite.Require().NoError(err)
+
+	suite.Assert().Contains(actions, "removed etcd member 0000000000000002")
+	suite.Assert().Equal([]uint64{0x2}, suite.etcd.removed)
+}
+
+func (suite *ClusterMembersSuite) TestRemoveNoEtcdMember() {
+	suite.etcd.members = suite.etcd.members[:1]
+
+	_, actions, err := suite.remover().remove(context.Background(), "cp-2", false)
+	suite.Require().NoError(err)
+
+	suite.Assert().Contains(actions, "skipped etcd member removal: no etcd member with the member addresses")
+	suite.Assert().Empty(suite.etcd.removed)
+}
+
+func (suite *ClusterMembersSuite) TestClearRemovedMember() {
+	ctx := context.Background()
+
+	_, _, err := suite.remover().remove(ctx, "worker-1", false)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.remover().clear(ctx, workerNodeID))
+
+	_, err = suite.state.Get(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, workerNodeID, resource.VersionUndefined))
+	suite.Assert().True(state.IsNotFoundError(err))
+	suite.Assert().NotContains(suite.kubernetes.blocked, workerNodeID)
+
+	// control plane nodes always clear the block in Kubernetes
+	suite.Require().NoError(suite.remover().clear(ctx, workerNodeID))
+
+	// worker nodes only know the local block
+	suite.controlPlane = false
+
+	err = suite.remover().clear(ctx, workerNodeID)
+	suite.Require().Error(err)
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+func TestClusterMembersSuite(t *testing.T) {
+	suite.Run(t, new(ClusterMembersSuite))
+}
+
+func TestCheckEtcdQuorum(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name      string
+		members   []*etcdserverpb.Member
+		unhealthy []uint64
+		remove    uint64
+		expectErr string
+	}{
+		{
+			name:    "healthy",
+			members: []*etcdserverpb.Member{{ID: 1}, {ID: 2}, {ID: 3}},
+			remove:  3,
+		},
+		{
+			name:      "removed member is unhealthy",
+			members:   []*etcdserverpb.Member{{ID: 1}, {ID: 2}, {ID: 3}},
+			unhealthy: []uint64{3},
+			remove:    3,
+		},
+		{
+			name:      "remaining member is unhealthy",
+			members:   []*etcdserverpb.Member{{ID: 1}, {ID: 2}, {ID: 3}},
+			unhealthy: []uint64{2},
+			remove:    3,
+			expectErr: "only 1 of 2 remaining voting members are healthy, quorum requires 2",
+		},
+		{
+			name:      "five members, one unhealthy",
+			members:   []*etcdserverpb.Member{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}},
+			unhealthy: []uint64{2},
+			remove:    5,
+		},
+		{
+			name:      "learners are not counted",
+			members:   []*etcdserverpb.Member{{ID: 1}, {ID: 2}, {ID: 3, IsLearner: true}},
+			unhealthy: []uint64{2},
+			remove:    1,
+			expectErr: "only 0 of 1 remaining voting members are healthy, quorum requires 1",
+		},
+		{
+			name:      "learner removal",
+			members:   []*etcdserverpb.Member{{ID: 1}, {ID: 2, IsLearner: true}},
+			unhealthy: []uint64{1},
+			remove:    2,
+		},
+		{
+			name:      "last member",
+			members:   []*etcdserverpb.Member{{ID: 1}},
+			remove:    1,
+			expectErr: "refusing to remove the last etcd member",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkEtcdQuorum(tt.members, tt.remove, func(member *etcdserverpb.Member) bool {
+				for _, id := range tt.unhealthy {
+					if id == member.ID {
+						return false
+					}
+				}
+
+				return true
+			})
+
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindEtcdMember(t *testing.T) {
+	t.Parallel()
+
+	members := []*etcdserverpb.Member{
+		{ID: 1, Name: "cp-1", PeerURLs: []string{"https://172.20.0.2:2380"}},
+		{ID: 2, Name: "cp-2", PeerURLs: []string{"https://[fd00::3]:2380", "https://172.20.0.3:2380"}},
+		{ID: 3, Name: "cp-3"},
+	}
+
+	member := findEtcdMember(members, []netaddr.IP{netaddr.MustParseIP("10.5.0.3"), netaddr.MustParseIP("fd00::3")})
+	require.NotNil(t, member)
+	assert.EqualValues(t, 2, member.ID)
+
+	member = findEtcdMember(members, []netaddr.IP{netaddr.MustParseIP("172.20.0.2")})
+	require.NotNil(t, member)
+	assert.EqualValues(t, 1, member.ID)
+
+	assert.Nil(t, findEtcdMember(members, []netaddr.IP{netaddr.MustParseIP("172.20.0.4")}))
+	assert.Nil(t, findEtcdMember(members, nil))
+}
@@ -9,7 +9,9 @@ import (
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	inspectapi "github.com/talos-systems/talos/pkg/machinery/api/inspect"
 )
@@ -65,3 +67,42 @@ func (s *InspectServer) ControllerRuntimeDependencies(ctx context.Context, in *e
 		},
 	}, nil
 }
+
+// ControllerRuntimeStats implements inspect.InspectService interface.
+func (s *InspectServer) ControllerRuntimeStats(ctx context.Context, in *emptypb.Empty) (*inspectapi.ControllerRuntimeStatsResponse, error) {
+	stats := s.server.Controller.V1Alpha2().ControllerStats()
+
+	controllers := make([]*inspectapi.ControllerStats, 0, len(stats))
+
+	for _, st := range stats {
+		controllerStats := &inspectapi.ControllerStats{
+			ControllerName: st.Name,
+
+			ReconcileCount:        st.ReconcileCount,
+			Reconciling:           st.Reconciling,
+			LastReconcileDuration: durationpb.New(st.LastReconcileDuration),
+
+			RestartCount:   st.RestartCount,
+			LastError:      st.LastError,
+			RestartBackoff: durationpb.New(st.RestartBackoff),
+		}
+
+		if st.Reconciling {
+			controllerStats.ReconcileStarted = timestamppb.New(st.ReconcileStarted)
+		}
+
+		if !st.LastErrorTime.IsZero() {
+			controllerStats.LastErrorTime = timestamppb.New(st.LastErrorTime)
+		}
+
+		controllers = append(controllers, controllerStats)
+	}
+
+	return &inspectapi.ControllerRuntimeStatsResponse{
+		Messages: []*inspectapi.ControllerRuntimeStats{
+			{
+				Controllers: controllers,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+
+	krnl "github.com/talos-systems/talos/pkg/kernel"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/kernel"
+)
+
+// validateKernelParams checks machine sysctls and sysfs settings against the running kernel.
+//
+// Keys missing in the running kernel are reported as warnings, as they might appear
+// later on (e.g. when the kernel module is loaded), values not matching the type of
+// the current value are reported as errors.
+func validateKernelParams(cfg config.Provider) ([]string, error) {
+	var (
+		warnings []string
+		result   *multierror.Error
+	)
+
+	check := func(kind, prefix string, params map[string]string) {
+		keys := make([]string, 0, len(params))
+
+		for key := range params {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			err := krnl.ValidateParam(&kernel.Param{
+				Key:   prefix + "." + key,
+				Value: params[key],
+			})
+
+			switch {
+			case err == nil:
+			case errors.Is(err, os.ErrNotExist):
+				warnings = append(warnings, fmt.Sprintf("%s %q is not supported by the running kernel", kind, key))
+			default:
+				result = multierror.Append(result, fmt.Errorf("%s %q: %w", kind, key, err))
+			}
+		}
+	}
+
+	check("sysctl", kernel.Sysctl, cfg.Machine().Sysctls())
+	check("sysfs", kernel.Sysfs, cfg.Machine().Sysfs())
+
+	return warnings, result.ErrorOrNil()
+}
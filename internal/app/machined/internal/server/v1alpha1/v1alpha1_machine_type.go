@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	machinetype "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// machineTypeChange describes the change of the machine type requested via ApplyConfiguration.
+type machineTypeChange int
+
+const (
+	machineTypeUnchanged machineTypeChange = iota
+	machineTypePromote
+	machineTypeDemote
+)
+
+// detectMachineTypeChange compares machine type of the current and the new config.
+//
+// Switching between init and controlplane types is not considered a change.
+func detectMachineTypeChange(current, next config.Provider) (machineTypeChange, error) {
+	if current == nil {
+		return machineTypeUnchanged, nil
+	}
+
+	currentType, nextType := current.Machine().Type(), next.Machine().Type()
+
+	switch {
+	case currentType == nextType:
+		return machineTypeUnchanged, nil
+	case nextType == machinetype.TypeInit:
+		return machineTypeUnchanged, fmt.Errorf("machine type can't be changed to %q", nextType)
+	case currentType == machinetype.TypeWorker:
+		return machineTypePromote, nil
+	case nextType == machinetype.TypeWorker:
+		return machineTypeDemote, nil
+	default:
+		return machineTypeUnchanged, nil
+	}
+}
+
+// validateMachineTypeChange verifies that the machine type change can be performed with the apply mode
+// and the new config.
+func validateMachineTypeChange(change machineTypeChange, mode machine.ApplyConfigurationRequest_Mode, cfg config.Provider) error {
+	if change == machineTypeUnchanged {
+		return nil
+	}
+
+	if mode != machine.ApplyConfigurationRequest_REBOOT {
+		return status.Errorf(codes.InvalidArgument, "machine type change requires a reboot, got mode %s", mode)
+	}
+
+	if change == machineTypeDemote {
+		return nil
+	}
+
+	missing := []string{}
+
+	if cfg.Machine().Security().CA() == nil || len(cfg.Machine().Security().CA().Key) == 0 {
+		missing = append(missing, "machine.ca.key")
+	}
+
+	if cfg.Cluster().CA() == nil || len(cfg.Cluster().CA().Key) == 0 {
+		missing = append(missing, "cluster.ca.key")
+	}
+
+	if cfg.Cluster().Etcd().CA() == nil || len(cfg.Cluster().Etcd().CA().Key) == 0 {
+		missing = append(missing, "cluster.etcd.ca.key")
+	}
+
+	if cfg.Cluster().ServiceAccount() == nil {
+		missing = append(missing, "cluster.serviceAccount")
+	}
+
+	if len(missing) > 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"control plane secrets are missing in the config: %v (use 'talosctl gen machine-type' to generate the config)", missing)
+	}
+
+	return nil
+}
+
+// prepareMachineTypeChange performs the etcd membership changes required before the new config is written.
+//
+// On promotion, it's only verified that the etcd cluster can accept a new member, etcd data directory
+// is cleaned up in the reboot sequence once the new config is written, so that etcd joins the existing
+// cluster as a learner after the reboot.
+// On demotion, it's only verified that the node can leave the etcd cluster, the node leaves etcd
+// after the new config is written (see completeMachineTypeChange).
+func (s *Server) prepareMachineTypeChange(ctx context.Context, change machineTypeChange, cfg config.Provider) error {
+	switch change { //nolint:exhaustive
+	case machineTypePromote:
+		return s.preparePromotion(ctx, cfg)
+	case machineTypeDemote:
+		return s.validateDemotion(ctx)
+	}
+
+	return nil
+}
+
+// completeMachineTypeChange performs the etcd membership changes once the new config is written.
+//
+// On demotion, the node leaves the etcd cluster; control plane static pods are not rendered for the worker,
+// so they are removed on the reboot.
+func (s *Server) completeMachineTypeChange(ctx context.Context, change machineTypeChange) error {
+	if change == machineTypeDemote {
+		return s.leaveEtcd(ctx)
+	}
+
+	return nil
+}
+
+func (s *Server) preparePromotion(ctx context.Context, cfg config.Provider) error {
+	endpoints, err := etcd.ControlPlaneEndpoints(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return fmt.Errorf("failed to get etcd endpoints: %w", err)
+	}
+
+	cert, err := etcd.GenerateClientCert(cfg.Cluster().Etcd().CA(), "talos")
+	if err != nil {
+		return fmt.Errorf("failed to generate etcd client certificate: %w", err)
+	}
+
+	client, err := etcd.NewClientWithCertificate(endpoints, cfg.Cluster().Etcd().CA().Crt, cert)
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if err = client.ValidateForMembershipChange(ctx, false); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "etcd cluster is not ready for a new member: %s", err)
+	}
+
+	log.Printf("machine type change: node will join etcd cluster after the reboot")
+
+	return nil
+}
+
+func (s *Server) validateDemotion(ctx context.Context) error {
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if err = client.ValidateForMembershipChange(ctx, true); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "etcd member can't be removed safely: %s", err)
+	}
+
+	return nil
+}
+
+func (s *Server) leaveEtcd(ctx context.Context) error {
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, s.Controller.Runtime().State().V1Alpha2().Resources())
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	ctx = clientv3.WithRequireLeader(ctx)
+
+	if _, err = client.ForfeitLeadership(ctx); err != nil {
+		return fmt.Errorf("failed to forfeit leadership: %w", err)
+	}
+
+	if err = client.LeaveCluster(ctx); err != nil {
+		return fmt.Errorf("failed to leave etcd cluster: %w", err)
+	}
+
+	log.Printf("machine type change: node left etcd cluster")
+
+	return nil
+}
@@ -161,6 +161,11 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		return nil, err
 	}
 
+	warnings, err := validateKernelParams(cfgProvider)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "kernel parameters validation failed: %s", err)
+	}
+
 	//nolint:exhaustive
 	switch in.Mode {
 	// --mode=try
@@ -169,7 +174,7 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 	// --mode=no-reboot
 	case machine.ApplyConfigurationRequest_NO_REBOOT:
 		if err = s.Controller.Runtime().CanApplyImmediate(cfgProvider); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
 		modeDetails = "Applied configuration without a reboot"
@@ -190,6 +195,15 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		mode = fmt.Sprintf("%s(%s)", mode, in.Mode)
 	}
 
+	typeChange, err := detectMachineTypeChange(s.Controller.Runtime().Config(), cfgProvider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = validateMachineTypeChange(typeChange, in.Mode, cfgProvider); err != nil {
+		return nil, err
+	}
+
 	if in.DryRun {
 		var config interface{}
 		if s.Controller.Runtime().Config() != nil {
@@ -204,7 +218,8 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		return &machine.ApplyConfigurationResponse{
 			Messages: []*machine.ApplyConfiguration{
 				{
-					Mode: in.Mode,
+					Mode:     in.Mode,
+					Warnings: warnings,
 					ModeDetails: fmt.Sprintf(`Dry run summary:
 %s (skipped in dry-run).
 Config diff:
@@ -216,17 +231,39 @@ Config diff:
 
 	log.Printf("apply config request: mode %s", strings.ToLower(mode))
 
+	if err = s.prepareMachineTypeChange(ctx, typeChange, cfgProvider); err != nil {
+		return nil, err
+	}
+
 	cfg, err := cfgProvider.Bytes()
 	if err != nil {
 		return nil, err
 	}
 
+	var previousCfg []byte
+
+	if typeChange != machineTypeUnchanged {
+		if previousCfg, err = ioutil.ReadFile(constants.ConfigPath); err != nil {
+			return nil, err
+		}
+	}
+
 	if in.Mode != machine.ApplyConfigurationRequest_TRY {
 		if err := ioutil.WriteFile(constants.ConfigPath, cfg, 0o600); err != nil {
 			return nil, err
 		}
 	}
 
+	// etcd membership is changed only once the new config is persisted, so that the node never
+	// reboots with the config which doesn't match its etcd membership
+	if err = s.completeMachineTypeChange(ctx, typeChange); err != nil {
+		if restoreErr := ioutil.WriteFile(constants.ConfigPath, previousCfg, 0o600); restoreErr != nil {
+			log.Printf("failed to restore previous config: %s", restoreErr)
+		}
+
+		return nil, err
+	}
+
 	//nolint:exhaustive
 	switch in.Mode {
 	// --mode=try
@@ -276,6 +313,7 @@ Config diff:
 		Messages: []*machine.ApplyConfiguration{
 			{
 				Mode:        in.Mode,
+				Warnings:    warnings,
 				ModeDetails: modeDetails + modeErr,
 			},
 		},
@@ -2067,6 +2105,103 @@ func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.Ge
 	return reply, nil
 }
 
+// GenerateKubeconfig implements the machine.MachineServer interface.
+func (s *Server) GenerateKubeconfig(ctx context.Context, in *machine.GenerateKubeconfigRequest) (*machine.GenerateKubeconfigResponse, error) {
+	if err := s.checkControlplane("kubeconfig"); err != nil {
+		return nil, err
+	}
+
+	if err := validateKubeconfigRequest(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cluster := s.Controller.Runtime().Config().Cluster()
+
+	var b bytes.Buffer
+
+	if in.Oidc != nil {
+		if err := kubeconfig.GenerateOIDC(&kubeconfig.GenerateOIDCInput{
+			ClusterName: cluster.Name(),
+			CACert:      string(cluster.CA().Crt),
+			Endpoint:    cluster.Endpoint().String(),
+
+			IssuerURL:    in.Oidc.IssuerUrl,
+			ClientID:     in.Oidc.ClientId,
+			ClientSecret: in.Oidc.ClientSecret,
+			ExtraScopes:  in.Oidc.ExtraScopes,
+		}, &b); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := kubeconfig.Generate(&kubeconfig.GenerateInput{
+			ClusterName: cluster.Name(),
+
+			CA:                  cluster.CA(),
+			CertificateLifetime: in.CrtTtl.AsDuration(),
+
+			CommonName: in.Username,
+			Groups:     in.Groups,
+
+			Endpoint:    cluster.Endpoint().String(),
+			Username:    in.Username,
+			ContextName: in.Username,
+		}, &b); err != nil {
+			return nil, err
+		}
+	}
+
+	return &machine.GenerateKubeconfigResponse{
+		Messages: []*machine.GenerateKubeconfig{
+			{
+				Kubeconfig: b.Bytes(),
+			},
+		},
+	}, nil
+}
+
+// validateKubeconfigRequest checks that the issued kubeconfig doesn't grant more than a regular user access.
+//
+// Users and groups reserved for the Kubernetes components (e.g. `system:masters`) can't be requested,
+// as they bypass or escalate the Kubernetes RBAC.
+func validateKubeconfigRequest(in *machine.GenerateKubeconfigRequest) error {
+	if in.Oidc != nil {
+		if in.Username != "" || len(in.Groups) > 0 {
+			return fmt.Errorf("username and groups can't be used with OIDC")
+		}
+
+		if in.Oidc.IssuerUrl == "" || in.Oidc.ClientId == "" {
+			return fmt.Errorf("OIDC issuer URL and client ID should be set")
+		}
+
+		return nil
+	}
+
+	if in.Username == "" {
+		return fmt.Errorf("username should be set")
+	}
+
+	if strings.HasPrefix(in.Username, constants.KubernetesSystemPrefix) {
+		return fmt.Errorf("username %q is reserved for Kubernetes components", in.Username)
+	}
+
+	for _, group := range in.Groups {
+		if strings.HasPrefix(group, constants.KubernetesSystemPrefix) {
+			return fmt.Errorf("group %q is reserved for Kubernetes components", group)
+		}
+	}
+
+	crtTTL := in.CrtTtl.AsDuration()
+
+	switch {
+	case crtTTL <= 0:
+		return fmt.Errorf("crt_ttl should be positive")
+	case crtTTL > constants.KubeconfigIssuerMaxCertLifetime:
+		return fmt.Errorf("crt_ttl should not exceed %s", constants.KubeconfigIssuerMaxCertLifetime)
+	}
+
+	return nil
+}
+
 func upgradeMutex(c *etcd.Client) (*concurrency.Mutex, error) {
 	sess, err := concurrency.NewSession(c.Client,
 		concurrency.WithTTL(MinimumEtcdUpgradeLeaseLockSeconds),
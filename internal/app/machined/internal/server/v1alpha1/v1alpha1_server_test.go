@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime //nolint:testpackage // to test unexported functions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+)
+
+func TestValidateKubeconfigRequest(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name          string
+		request       *machine.GenerateKubeconfigRequest
+		expectedError string
+	}{
+		{
+			name: "user",
+			request: &machine.GenerateKubeconfigRequest{
+				Username: "jane",
+				Groups:   []string{"developers"},
+				CrtTtl:   durationpb.New(8 * time.Hour),
+			},
+		},
+		{
+			name: "no username",
+			request: &machine.GenerateKubeconfigRequest{
+				CrtTtl: durationpb.New(8 * time.Hour),
+			},
+			expectedError: "username should be set",
+		},
+		{
+			name: "system user",
+			request: &machine.GenerateKubeconfigRequest{
+				Username: "system:kube-controller-manager",
+				CrtTtl:   durationpb.New(8 * time.Hour),
+			},
+			expectedError: `username "system:kube-controller-manager" is reserved for Kubernetes components`,
+		},
+		{
+			name: "system group",
+			request: &machine.GenerateKubeconfigRequest{
+				Username: "jane",
+				Groups:   []string{"developers", "system:masters"},
+				CrtTtl:   durationpb.New(8 * time.Hour),
+			},
+			expectedError: `group "system:masters" is reserved for Kubernetes components`,
+		},
+		{
+			name: "no TTL",
+			request: &machine.GenerateKubeconfigRequest{
+				Username: "jane",
+			},
+			expectedError: "crt_ttl should be positive",
+		},
+		{
+			name: "TTL too long",
+			request: &machine.GenerateKubeconfigRequest{
+				Username: "jane",
+				CrtTtl:   durationpb.New(365 * 24 * time.Hour),
+			},
+			expectedError: "crt_ttl should not exceed 168h0m0s",
+		},
+		{
+			name: "OIDC",
+			request: &machine.GenerateKubeconfigRequest{
+				Oidc: &machine.GenerateKubeconfigOIDC{
+					IssuerUrl: "https://accounts.example.com",
+					ClientId:  "kubernetes",
+				},
+			},
+		},
+		{
+			name: "OIDC with user",
+			request: &machine.GenerateKubeconfigRequest{
+				Username: "jane",
+				Oidc: &machine.GenerateKubeconfigOIDC{
+					IssuerUrl: "https://accounts.example.com",
+					ClientId:  "kubernetes",
+				},
+			},
+			expectedError: "username and groups can't be used with OIDC",
+		},
+		{
+			name: "OIDC without client ID",
+			request: &machine.GenerateKubeconfigRequest{
+				Oidc: &machine.GenerateKubeconfigOIDC{
+					IssuerUrl: "https://accounts.example.com",
+				},
+			},
+			expectedError: "OIDC issuer URL and client ID should be set",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateKubeconfigRequest(tt.request)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
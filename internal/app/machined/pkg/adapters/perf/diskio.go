@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package perf
+
+import (
+	"github.com/prometheus/procfs/blockdevice"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/perf"
+)
+
+// DiskIO adapter provides conversion from procfs.
+//
+//nolint:revive,golint
+func DiskIO(r *perf.DiskIO) diskIO {
+	return diskIO{
+		DiskIO: r,
+	}
+}
+
+type diskIO struct {
+	*perf.DiskIO
+}
+
+// Update current DiskIO snapshot.
+//
+// Total is the sum of the stats of the physicalDisks only: partitions and virtual devices (dm, md, loop, etc.)
+// are excluded, as their I/O is already accounted for in the stats of the underlying disks.
+func (a diskIO) Update(stats []blockdevice.Diskstats, physicalDisks map[string]struct{}) {
+	spec := perf.DiskIOSpec{
+		Devices: make([]perf.DiskIOStat, 0, len(stats)),
+	}
+
+	for _, in := range stats {
+		stat := perf.DiskIOStat{
+			Name:             in.DeviceName,
+			ReadCompleted:    in.ReadIOs,
+			ReadMerged:       in.ReadMerges,
+			ReadSectors:      in.ReadSectors,
+			ReadTimeMs:       in.ReadTicks,
+			WriteCompleted:   in.WriteIOs,
+			WriteMerged:      in.WriteMerges,
+			WriteSectors:     in.WriteSectors,
+			WriteTimeMs:      in.WriteTicks,
+			IOInProgress:     in.IOsInProgress,
+			IOTimeMs:         in.IOsTotalTicks,
+			IOTimeWeightedMs: in.WeightedIOTicks,
+			DiscardCompleted: in.DiscardIOs,
+			DiscardMerged:    in.DiscardMerges,
+			DiscardSectors:   in.DiscardSectors,
+			DiscardTimeMs:    in.DiscardTicks,
+		}
+
+		spec.Devices = append(spec.Devices, stat)
+
+		if _, ok := physicalDisks[stat.Name]; !ok {
+			continue
+		}
+
+		spec.Total.ReadCompleted += stat.ReadCompleted
+		spec.Total.ReadMerged += stat.ReadMerged
+		spec.Total.ReadSectors += stat.ReadSectors
+		spec.Total.ReadTimeMs += stat.ReadTimeMs
+		spec.Total.WriteCompleted += stat.WriteCompleted
+		spec.Total.WriteMerged += stat.WriteMerged
+		spec.Total.WriteSectors += stat.WriteSectors
+		spec.Total.WriteTimeMs += stat.WriteTimeMs
+		spec.Total.IOInProgress += stat.IOInProgress
+		spec.Total.IOTimeMs += stat.IOTimeMs
+		spec.Total.IOTimeWeightedMs += stat.IOTimeWeightedMs
+		spec.Total.DiscardCompleted += stat.DiscardCompleted
+		spec.Total.DiscardMerged += stat.DiscardMerged
+		spec.Total.DiscardSectors += stat.DiscardSectors
+		spec.Total.DiscardTimeMs += stat.DiscardTimeMs
+	}
+
+	*a.DiskIO.TypedSpec() = spec
+}
@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package perf
+
+import (
+	"sort"
+
+	"github.com/prometheus/procfs"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/perf"
+)
+
+// NetIO adapter provides conversion from procfs.
+//
+//nolint:revive,golint
+func NetIO(r *perf.NetIO) netIO {
+	return netIO{
+		NetIO: r,
+	}
+}
+
+type netIO struct {
+	*perf.NetIO
+}
+
+// Update current NetIO snapshot.
+func (a netIO) Update(netDev procfs.NetDev) {
+	translateNetDevLine := func(in procfs.NetDevLine) perf.NetIOStat {
+		return perf.NetIOStat{
+			Name:         in.Name,
+			RxBytes:      in.RxBytes,
+			RxPackets:    in.RxPackets,
+			RxErrors:     in.RxErrors,
+			RxDropped:    in.RxDropped,
+			RxFIFO:       in.RxFIFO,
+			RxFrame:      in.RxFrame,
+			RxCompressed: in.RxCompressed,
+			RxMulticast:  in.RxMulticast,
+			TxBytes:      in.TxBytes,
+			TxPackets:    in.TxPackets,
+			TxErrors:     in.TxErrors,
+			TxDropped:    in.TxDropped,
+			TxFIFO:       in.TxFIFO,
+			TxCollisions: in.TxCollisions,
+			TxCarrier:    in.TxCarrier,
+			TxCompressed: in.TxCompressed,
+		}
+	}
+
+	spec := perf.NetIOSpec{
+		Devices: make([]perf.NetIOStat, 0, len(netDev)),
+		Total:   translateNetDevLine(netDev.Total()),
+	}
+
+	for _, line := range netDev {
+		spec.Devices = append(spec.Devices, translateNetDevLine(line))
+	}
+
+	sort.Slice(spec.Devices, func(i, j int) bool { return spec.Devices[i].Name < spec.Devices[j].Name })
+
+	*a.NetIO.TypedSpec() = spec
+}
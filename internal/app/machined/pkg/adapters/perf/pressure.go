@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package perf
+
+import (
+	"github.com/prometheus/procfs"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/perf"
+)
+
+// Pressure adapter provides conversion from procfs.
+//
+//nolint:revive,golint
+func Pressure(r *perf.Pressure) pressure {
+	return pressure{
+		Pressure: r,
+	}
+}
+
+type pressure struct {
+	*perf.Pressure
+}
+
+// Update current Pressure snapshot.
+func (a pressure) Update(cpu, memory, io *procfs.PSIStats) {
+	*a.Pressure.TypedSpec() = perf.PressureSpec{
+		CPU:    translatePSIStats(cpu),
+		Memory: translatePSIStats(memory),
+		IO:     translatePSIStats(io),
+	}
+}
+
+func translatePSIStats(in *procfs.PSIStats) perf.PressureStat {
+	translatePSILine := func(in *procfs.PSILine) perf.PressureLine {
+		if in == nil {
+			return perf.PressureLine{}
+		}
+
+		return perf.PressureLine{
+			Avg10:  in.Avg10,
+			Avg60:  in.Avg60,
+			Avg300: in.Avg300,
+			Total:  in.Total,
+		}
+	}
+
+	return perf.PressureStat{
+		Some: translatePSILine(in.Some),
+		Full: translatePSILine(in.Full),
+	}
+}
@@ -7,15 +7,26 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
 	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 )
 
 // AffiliateMergeController merges raw Affiliates from the RawNamespaceName into final representation in the NamespaceName.
+//
+// Affiliates with different node IDs claiming the same node name (e.g. a reinstalled node which got a new node ID)
+// are reported as conflicts in the Info resource.
+// The conflict is resolved in favor of the local node, or the node ID stored in the Kubernetes Node annotations.
+//
+// Affiliates of the removed members are skipped.
 type AffiliateMergeController struct{}
 
 // Name implements controller.Controller interface.
@@ -31,6 +42,23 @@ func (ctrl *AffiliateMergeController) Inputs() []controller.Input {
 			Type:      cluster.AffiliateType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.IdentityType,
+			ID:        pointer.To(cluster.LocalIdentity),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.RemovedMemberType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        pointer.To(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -41,6 +69,10 @@ func (ctrl *AffiliateMergeController) Outputs() []controller.Output {
 			Type: cluster.AffiliateType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: cluster.InfoType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -48,6 +80,8 @@ func (ctrl *AffiliateMergeController) Outputs() []controller.Output {
 //
 //nolint:gocyclo
 func (ctrl *AffiliateMergeController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var lastReported string
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -55,8 +89,22 @@ func (ctrl *AffiliateMergeController) Run(ctx context.Context, r controller.Runt
 		case <-r.EventCh():
 		}
 
+		removedMembers, err := r.List(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing removed members: %w", err)
+		}
+
+		removedIDs := make(map[string]struct{}, len(removedMembers.Items))
+
+		for _, removedMember := range removedMembers.Items {
+			removedIDs[removedMember.(*cluster.RemovedMember).TypedSpec().NodeID] = struct{}{}
+		}
+
 		mergedAffiliates := make(map[resource.ID]*cluster.AffiliateSpec)
 
+		// node IDs stored in the Kubernetes Node annotations, indexed by node name
+		kubernetesNodeIDs := make(map[string]string)
+
 		rawAffiliates, err := r.List(ctx, resource.NewMetadata(cluster.RawNamespaceName, cluster.AffiliateType, "", resource.VersionUndefined))
 		if err != nil {
 			return fmt.Errorf("error listing affiliates")
@@ -66,11 +114,46 @@ func (ctrl *AffiliateMergeController) Run(ctx context.Context, r controller.Runt
 			affiliateSpec := rawAffiliate.(*cluster.Affiliate).TypedSpec()
 			id := affiliateSpec.NodeID
 
+			if _, removed := removedIDs[id]; removed {
+				continue
+			}
+
 			if affiliate, ok := mergedAffiliates[id]; ok {
 				affiliate.Merge(affiliateSpec)
 			} else {
 				mergedAffiliates[id] = affiliateSpec
 			}
+
+			if strings.HasPrefix(rawAffiliate.Metadata().ID(), "k8s/") && affiliateSpec.Nodename != "" {
+				kubernetesNodeIDs[affiliateSpec.Nodename] = id
+			}
+		}
+
+		localNodeID, localNodename, err := ctrl.getLocal(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		conflicts := resolveConflicts(mergedAffiliates, localNodeID, localNodename, kubernetesNodeIDs)
+
+		if err = r.Modify(ctx, cluster.NewInfo(cluster.NamespaceName, cluster.InfoID), func(res resource.Resource) error {
+			res.(*cluster.Info).TypedSpec().Conflicts = conflicts
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating cluster info: %w", err)
+		}
+
+		if reported := fmt.Sprint(conflicts); reported != lastReported {
+			for _, conflict := range conflicts {
+				logger.Warn("affiliates with different node IDs claim the same node name",
+					zap.String("nodename", conflict.Nodename),
+					zap.Strings("node_ids", conflict.NodeIDs),
+					zap.String("resolved_node_id", conflict.ResolvedNodeID),
+				)
+			}
+
+			lastReported = reported
 		}
 
 		touchedIDs := make(map[resource.ID]struct{})
@@ -108,3 +191,82 @@ func (ctrl *AffiliateMergeController) Run(ctx context.Context, r controller.Runt
 		}
 	}
 }
+
+func (ctrl *AffiliateMergeController) getLocal(ctx context.Context, r controller.Runtime) (nodeID, nodename string, err error) {
+	identity, err := r.Get(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.IdentityType, cluster.LocalIdentity, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return "", "", nil
+		}
+
+		return "", "", fmt.Errorf("error getting local identity: %w", err)
+	}
+
+	nodenameResource, err := r.Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodenameType, k8s.NodenameID, resource.VersionUndefined))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return "", "", nil
+		}
+
+		return "", "", fmt.Errorf("error getting nodename: %w", err)
+	}
+
+	return identity.(*cluster.Identity).TypedSpec().NodeID, nodenameResource.(*k8s.Nodename).TypedSpec().Nodename, nil
+}
+
+// resolveConflicts finds affiliates with different node IDs claiming the same node name.
+//
+// If the conflict can be resolved, affiliates which lost are removed from the list of affiliates.
+func resolveConflicts(affiliates map[resource.ID]*cluster.AffiliateSpec, localNodeID, localNodename string, kubernetesNodeIDs map[string]string) []cluster.AffiliateConflict {
+	nodeIDs := make(map[string][]string)
+
+	for id, affiliate := range affiliates {
+		if affiliate.Nodename == "" || id == localNodeID {
+			continue
+		}
+
+		nodeIDs[affiliate.Nodename] = append(nodeIDs[affiliate.Nodename], id)
+	}
+
+	if localNodename != "" {
+		nodeIDs[localNodename] = append(nodeIDs[localNodename], localNodeID)
+	}
+
+	var conflicts []cluster.AffiliateConflict
+
+	for nodename, ids := range nodeIDs {
+		if len(ids) < 2 {
+			continue
+		}
+
+		sort.Strings(ids)
+
+		conflict := cluster.AffiliateConflict{
+			Nodename: nodename,
+			NodeIDs:  ids,
+		}
+
+		switch {
+		case nodename == localNodename:
+			// local node always knows its own identity
+			conflict.ResolvedNodeID = localNodeID
+		case kubernetesNodeIDs[nodename] != "":
+			// Node annotations are updated by the node which currently owns the node name
+			conflict.ResolvedNodeID = kubernetesNodeIDs[nodename]
+		}
+
+		if conflict.ResolvedNodeID != "" {
+			for _, id := range ids {
+				if id != conflict.ResolvedNodeID {
+					delete(affiliates, id)
+				}
+			}
+		}
+
+		conflicts = append(conflicts, conflict)
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Nodename < conflicts[j].Nodename })
+
+	return conflicts
+}
@@ -16,6 +16,7 @@ import (
 	clusterctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 )
 
 type AffiliateMergeSuite struct {
@@ -128,6 +129,150 @@ func (suite *AffiliateMergeSuite) TestReconcileDefault() {
 	))
 }
 
+func (suite *AffiliateMergeSuite) TestConflicts() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&clusterctrl.AffiliateMergeController{}))
+
+	localIdentity := cluster.NewIdentity(cluster.NamespaceName, cluster.LocalIdentity)
+	localIdentity.TypedSpec().NodeID = "local"
+	suite.Require().NoError(suite.state.Create(suite.ctx, localIdentity))
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "cp-1"
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodename))
+
+	for _, affiliate := range []struct {
+		id       string
+		nodeID   string
+		nodename string
+	}{
+		// previous identity of the local node
+		{"service/old-local", "old-local", "cp-1"},
+		// reinstalled worker, Node annotations point to the new identity
+		{"service/old-worker", "old-worker", "worker-1"},
+		{"service/new-worker", "new-worker", "worker-1"},
+		{"k8s/new-worker", "new-worker", "worker-1"},
+		// no way to tell which one is the right one
+		{"service/worker-2-a", "worker-2-a", "worker-2"},
+		{"service/worker-2-b", "worker-2-b", "worker-2"},
+	} {
+		res := cluster.NewAffiliate(cluster.RawNamespaceName, affiliate.id)
+		*res.TypedSpec() = cluster.AffiliateSpec{
+			NodeID:      affiliate.nodeID,
+			Hostname:    affiliate.nodename,
+			Nodename:    affiliate.nodename,
+			MachineType: machine.TypeWorker,
+		}
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, res))
+	}
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(*cluster.NewInfo(cluster.NamespaceName, cluster.InfoID).Metadata(), func(r resource.Resource) error {
+			spec := r.(*cluster.Info).TypedSpec()
+
+			if len(spec.Conflicts) != 3 {
+				return retry.ExpectedErrorf("expected 3 conflicts, got %d", len(spec.Conflicts))
+			}
+
+			suite.Assert().Equal([]cluster.AffiliateConflict{
+				{
+					Nodename:       "cp-1",
+					NodeIDs:        []string{"local", "old-local"},
+					ResolvedNodeID: "local",
+				},
+				{
+					Nodename:       "worker-1",
+					NodeIDs:        []string{"new-worker", "old-worker"},
+					ResolvedNodeID: "new-worker",
+				},
+				{
+					Nodename: "worker-2",
+					NodeIDs:  []string{"worker-2-a", "worker-2-b"},
+				},
+			}, spec.Conflicts)
+
+			return nil
+		}),
+	))
+
+	for _, id := range []string{"new-worker", "worker-2-a", "worker-2-b"} {
+		suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(*cluster.NewAffiliate(cluster.NamespaceName, id).Metadata(), func(r resource.Resource) error {
+				return nil
+			}),
+		))
+	}
+
+	for _, id := range []string{"old-local", "old-worker"} {
+		suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertNoResource(*cluster.NewAffiliate(cluster.NamespaceName, id).Metadata()),
+		))
+	}
+
+	// stale affiliate goes away, conflict is resolved
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, cluster.NewAffiliate(cluster.RawNamespaceName, "service/worker-2-a").Metadata()))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(*cluster.NewInfo(cluster.NamespaceName, cluster.InfoID).Metadata(), func(r resource.Resource) error {
+			if len(r.(*cluster.Info).TypedSpec().Conflicts) != 2 {
+				return retry.ExpectedErrorf("conflict not resolved yet")
+			}
+
+			return nil
+		}),
+	))
+}
+
+func (suite *AffiliateMergeSuite) TestRemovedMembers() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&clusterctrl.AffiliateMergeController{}))
+
+	affiliate := cluster.NewAffiliate(cluster.RawNamespaceName, "service/9dwHNUViZlPlIervqX9Qo256RUhrfhgO0xBBnKcKl4F")
+	*affiliate.TypedSpec() = cluster.AffiliateSpec{
+		NodeID:      "9dwHNUViZlPlIervqX9Qo256RUhrfhgO0xBBnKcKl4F",
+		Hostname:    "worker-1",
+		Nodename:    "worker-1",
+		MachineType: machine.TypeWorker,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, affiliate))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(*cluster.NewAffiliate(cluster.NamespaceName, affiliate.TypedSpec().NodeID).Metadata(), func(r resource.Resource) error {
+			return nil
+		}),
+	))
+
+	removedMember := cluster.NewRemovedMember(cluster.NamespaceName, affiliate.TypedSpec().NodeID)
+	removedMember.TypedSpec().NodeID = affiliate.TypedSpec().NodeID
+	suite.Require().NoError(suite.state.Create(suite.ctx, removedMember))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertNoResource(*cluster.NewAffiliate(cluster.NamespaceName, affiliate.TypedSpec().NodeID).Metadata()),
+	))
+
+	// block is cleared, affiliate comes back
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, removedMember.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(*cluster.NewAffiliate(cluster.NamespaceName, affiliate.TypedSpec().NodeID).Metadata(), func(r resource.Resource) error {
+			return nil
+		}),
+	))
+
+	// block pulled from the Kubernetes registry
+	pulledRemovedMember := cluster.NewRemovedMember(cluster.NamespaceName, "k8s/"+affiliate.TypedSpec().NodeID)
+	pulledRemovedMember.TypedSpec().NodeID = affiliate.TypedSpec().NodeID
+	suite.Require().NoError(suite.state.Create(suite.ctx, pulledRemovedMember))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertNoResource(*cluster.NewAffiliate(cluster.NamespaceName, affiliate.TypedSpec().NodeID).Metadata()),
+	))
+}
+
 func TestAffiliateMergeSuite(t *testing.T) {
 	suite.Run(t, new(AffiliateMergeSuite))
 }
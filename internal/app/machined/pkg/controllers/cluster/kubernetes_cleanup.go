@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/talos-systems/talos/internal/pkg/discovery/registry"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
+)
+
+// KubernetesCleanupController garbage collects Kubernetes registry annotations of the Nodes which left the cluster.
+//
+// Kubelet credentials only allow to update the own Node resource, so the controller runs on control plane nodes
+// using the admin kubeconfig.
+type KubernetesCleanupController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *KubernetesCleanupController) Name() string {
+	return "cluster.KubernetesCleanupController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KubernetesCleanupController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      cluster.ConfigType,
+			ID:        pointer.To(cluster.ConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        pointer.To(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        pointer.To(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KubernetesCleanupController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *KubernetesCleanupController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(registry.StaleNodeTimeout / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		discoveryConfig, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, cluster.ConfigType, cluster.ConfigID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting discovery config: %w", err)
+			}
+
+			continue
+		}
+
+		if !discoveryConfig.(*cluster.Config).TypedSpec().RegistryKubernetesEnabled {
+			continue
+		}
+
+		machineType, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineTypeType, config.MachineTypeID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine type: %w", err)
+			}
+
+			continue
+		}
+
+		if machineType.(*config.MachineType).MachineType() == machine.TypeWorker {
+			continue
+		}
+
+		secretsResource, err := r.Get(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesType, secrets.KubernetesID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting Kubernetes secrets: %w", err)
+			}
+
+			continue
+		}
+
+		if err = ctrl.cleanup(ctx, logger, secretsResource.(*secrets.Kubernetes).TypedSpec().LocalhostAdminKubeconfig); err != nil {
+			// Kubernetes API might be not available yet, retry on the next tick
+			logger.Warn("error cleaning up Kubernetes registry", zap.Error(err))
+		}
+	}
+}
+
+func (ctrl *KubernetesCleanupController) cleanup(ctx context.Context, logger *zap.Logger, kubeconfig string) error {
+	restConfig, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load([]byte(kubeconfig))
+	})
+	if err != nil {
+		return fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	cleaned, err := registry.NewKubernetes(client).Cleanup(ctx, registry.StaleNodeTimeout)
+
+	for _, nodename := range cleaned {
+		logger.Info("removed Kubernetes registry annotations of the stale node", zap.String("nodename", nodename))
+	}
+
+	return err
+}
@@ -24,6 +24,8 @@ import (
 )
 
 // KubernetesPullController pulls list of Affiliate resource from the Kubernetes registry.
+//
+// The list of members removed from the cluster is pulled from the registry as well.
 type KubernetesPullController struct{}
 
 // Name implements controller.Controller interface.
@@ -56,6 +58,10 @@ func (ctrl *KubernetesPullController) Outputs() []controller.Output {
 			Type: cluster.AffiliateType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: cluster.RemovedMemberType,
+			Kind: controller.OutputShared,
+		},
 	}
 }
 
@@ -103,6 +109,10 @@ func (ctrl *KubernetesPullController) Run(ctx context.Context, r controller.Runt
 				return err
 			}
 
+			if err = ctrl.cleanupRemovedMembers(ctx, r, nil); err != nil {
+				return err
+			}
+
 			continue
 		}
 
@@ -163,8 +173,56 @@ func (ctrl *KubernetesPullController) Run(ctx context.Context, r controller.Runt
 			touchedIDs[id] = struct{}{}
 		}
 
-		if err := cleanupAffiliates(ctx, ctrl, r, touchedIDs); err != nil {
+		if err = cleanupAffiliates(ctx, ctrl, r, touchedIDs); err != nil {
+			return err
+		}
+
+		removedNodeIDs, err := kubernetesRegistry.RemovedMembers()
+		if err != nil {
+			return fmt.Errorf("error listing removed members: %w", err)
+		}
+
+		touchedIDs = make(map[resource.ID]struct{})
+
+		for _, nodeID := range removedNodeIDs {
+			id := fmt.Sprintf("k8s/%s", nodeID)
+
+			nodeID := nodeID
+
+			if err = r.Modify(ctx, cluster.NewRemovedMember(cluster.NamespaceName, id), func(res resource.Resource) error {
+				res.(*cluster.RemovedMember).TypedSpec().NodeID = nodeID
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			touchedIDs[id] = struct{}{}
+		}
+
+		if err = ctrl.cleanupRemovedMembers(ctx, r, touchedIDs); err != nil {
 			return err
 		}
 	}
 }
+
+func (ctrl *KubernetesPullController) cleanupRemovedMembers(ctx context.Context, r controller.Runtime, touchedIDs map[resource.ID]struct{}) error {
+	list, err := r.List(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if res.Metadata().Owner() != ctrl.Name() {
+			continue
+		}
+
+		if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+			if err = r.Destroy(ctx, res.Metadata()); err != nil {
+				return fmt.Errorf("error cleaning up removed members: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -23,9 +23,15 @@ import (
 )
 
 // KubernetesPushController pushes Affiliate resource to the Kubernetes registry.
+//
+// If the Kubernetes registry gets disabled, annotations pushed by the controller are removed.
 type KubernetesPushController struct {
 	localAffiliateID resource.ID
 	kubernetesClient *kubernetes.Client
+
+	// nodename and node ID of the last pushed affiliate
+	pushedNodename string
+	pushedNodeID   string
 }
 
 // Name implements controller.Controller interface.
@@ -83,6 +89,10 @@ func (ctrl *KubernetesPushController) Run(ctx context.Context, r controller.Runt
 			}
 
 			if !discoveryConfig.(*cluster.Config).TypedSpec().RegistryKubernetesEnabled {
+				if err = ctrl.cleanup(ctx, logger); err != nil {
+					return err
+				}
+
 				continue
 			}
 
@@ -139,6 +149,40 @@ func (ctrl *KubernetesPushController) Run(ctx context.Context, r controller.Runt
 
 				return fmt.Errorf("error pushing to Kubernetes registry: %w", err)
 			}
+
+			ctrl.pushedNodename = affiliate.(*cluster.Affiliate).TypedSpec().Nodename
+			ctrl.pushedNodeID = affiliate.Metadata().ID()
 		}
 	}
 }
+
+// cleanup removes annotations pushed to the Kubernetes registry.
+func (ctrl *KubernetesPushController) cleanup(ctx context.Context, logger *zap.Logger) error {
+	if ctrl.pushedNodename == "" {
+		return nil
+	}
+
+	if ctrl.kubernetesClient == nil {
+		var err error
+
+		ctrl.kubernetesClient, err = kubernetes.NewClientFromKubeletKubeconfig()
+		if err != nil {
+			return fmt.Errorf("error building kubernetes client: %w", err)
+		}
+	}
+
+	if err := registry.NewKubernetes(ctrl.kubernetesClient).Delete(ctx, ctrl.pushedNodename, ctrl.pushedNodeID); err != nil {
+		// reset client connection
+		ctrl.kubernetesClient.Close() //nolint:errcheck
+		ctrl.kubernetesClient = nil
+
+		return fmt.Errorf("error removing annotations from Kubernetes registry: %w", err)
+	}
+
+	logger.Info("removed annotations from Kubernetes registry", zap.String("nodename", ctrl.pushedNodename))
+
+	ctrl.pushedNodename = ""
+	ctrl.pushedNodeID = ""
+
+	return nil
+}
@@ -287,6 +287,51 @@ func (ctrl *K8sControlPlaneController) manageManifestsConfig(ctx context.Context
 			FlannelCNIImage: images.FlannelCNI,
 
 			PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
+
+			ClusterName: cfgProvider.Cluster().Name(),
+
+			TalosAPIServiceAccountCRDEnabled: cfgProvider.Machine().Features().KubernetesTalosAPIAccess().Enabled(),
+		}
+
+		if coreDNS := cfgProvider.Cluster().CoreDNS(); coreDNS.Enabled() {
+			spec := r.(*k8s.BootstrapManifestsConfig).TypedSpec()
+
+			spec.CoreDNSUpstreams = coreDNS.Upstreams()
+			spec.CoreDNSCacheTTL = coreDNS.Cache().TTL()
+			spec.CoreDNSCacheSuccess = coreDNS.Cache().SuccessCapacity()
+			spec.CoreDNSCacheDenial = coreDNS.Cache().DenialCapacity()
+			spec.CoreDNSExtraServerBlocks = coreDNS.ExtraServerBlocks()
+
+			for _, stubDomain := range coreDNS.StubDomains() {
+				spec.CoreDNSStubDomains = append(spec.CoreDNSStubDomains, k8s.CoreDNSStubDomain{
+					Domain:  stubDomain.Domain(),
+					Servers: stubDomain.Servers(),
+				})
+			}
+
+			for _, host := range coreDNS.Hosts() {
+				spec.CoreDNSHosts = append(spec.CoreDNSHosts, k8s.CoreDNSHost{
+					IP:        host.IP(),
+					Hostnames: host.Aliases(),
+				})
+			}
+		}
+
+		if nodeLocalCache := cfgProvider.Cluster().CoreDNS().NodeLocalCache(); nodeLocalCache.Enabled() {
+			spec := r.(*k8s.BootstrapManifestsConfig).TypedSpec()
+
+			spec.NodeLocalDNSEnabled = true
+			spec.NodeLocalDNSImage = images.NodeLocalDNS
+			spec.NodeLocalDNSIP = nodeLocalCache.IP()
+		}
+
+		if cloudProvider := cfgProvider.Cluster().ExternalCloudProvider(); cloudProvider.Enabled() && cloudProvider.Name() != "" {
+			spec := r.(*k8s.BootstrapManifestsConfig).TypedSpec()
+
+			spec.CloudProviderName = cloudProvider.Name()
+			spec.CloudProviderImage = images.CloudControllerManager
+			spec.CloudProviderNodeManagerImage = images.CloudNodeManager
+			spec.CloudProviderConfig = cloudProvider.CloudConfig()
 		}
 
 		return nil
@@ -278,6 +278,48 @@ func (suite *K8sControlPlaneSuite) TestReconcileExternalCloudProvider() {
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileBuiltInCloudProvider() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ClusterName: "test-cluster",
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				APIServerConfig: &v1alpha1.APIServerConfig{
+					ContainerImage: "k8s.gcr.io/kube-apiserver:v1.23.7",
+				},
+				ExternalCloudProviderConfig: &v1alpha1.ExternalCloudProviderConfig{
+					ExternalEnabled:     true,
+					ExternalName:        "azure",
+					ExternalCloudConfig: "{}",
+				},
+			},
+		},
+	)
+
+	apiServerCfg := suite.setupMachine(cfg)
+	suite.Assert().Equal("external", apiServerCfg.CloudProvider)
+
+	r, err := suite.state.Get(suite.ctx, k8s.NewBootstrapManifestsConfig().Metadata())
+	suite.Require().NoError(err)
+
+	spec := r.(*k8s.BootstrapManifestsConfig).TypedSpec()
+
+	suite.Assert().Equal("test-cluster", spec.ClusterName)
+	suite.Assert().Equal("azure", spec.CloudProviderName)
+	suite.Assert().Equal("mcr.microsoft.com/oss/kubernetes/azure-cloud-controller-manager:v1.23.0", spec.CloudProviderImage)
+	suite.Assert().Equal("mcr.microsoft.com/oss/kubernetes/azure-cloud-node-manager:v1.23.0", spec.CloudProviderNodeManagerImage)
+	suite.Assert().Equal("{}", spec.CloudProviderConfig)
+}
+
 func (suite *K8sControlPlaneSuite) TestReconcileInlineManifests() {
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package etcd provides controllers which manage etcd resources.
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	etcdcli "github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+const (
+	memberStatusRefreshInterval = 15 * time.Second
+	memberStatusTimeout         = 10 * time.Second
+)
+
+// MemberStatusController manages etcd.MemberStatus based on the local etcd member status.
+type MemberStatusController struct {
+	// MemberStatus overrides querying the local etcd member status, used in tests.
+	MemberStatus func(ctx context.Context) (etcd.MemberStatusSpec, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MemberStatusController) Name() string {
+	return "etcd.MemberStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MemberStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To("etcd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MemberStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: etcd.MemberStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MemberStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.MemberStatus == nil {
+		ctrl.MemberStatus = ctrl.memberStatus
+	}
+
+	refreshTicker := time.NewTicker(memberStatusRefreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-refreshTicker.C:
+		case <-r.EventCh():
+		}
+
+		etcdResource, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "etcd", resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service: %w", err)
+		}
+
+		if err != nil || !etcdResource.(*v1alpha1.Service).TypedSpec().Running {
+			if err = ctrl.teardown(ctx, r); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		spec, err := ctrl.MemberStatus(ctx)
+		if err != nil {
+			// etcd might be still starting up or temporarily unavailable, so don't fail the controller
+			logger.Debug("failed to query etcd member status", zap.Error(err))
+
+			continue
+		}
+
+		if err = r.Modify(ctx, etcd.NewMemberStatus(etcd.NamespaceName, etcd.LocalMemberStatusID), func(r resource.Resource) error {
+			*r.(*etcd.MemberStatus).TypedSpec() = spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating etcd member status: %w", err)
+		}
+	}
+}
+
+func (ctrl *MemberStatusController) memberStatus(ctx context.Context) (etcd.MemberStatusSpec, error) {
+	ctx, cancel := context.WithTimeout(ctx, memberStatusTimeout)
+	defer cancel()
+
+	client, err := etcdcli.NewLocalClient()
+	if err != nil {
+		return etcd.MemberStatusSpec{}, fmt.Errorf("failed to create local etcd client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	status, err := client.Status(ctx, client.Endpoints()[0])
+	if err != nil {
+		return etcd.MemberStatusSpec{}, fmt.Errorf("failed to get etcd status: %w", err)
+	}
+
+	memberID := status.GetHeader().GetMemberId()
+
+	return etcd.MemberStatusSpec{
+		MemberID:    strconv.FormatUint(memberID, 16),
+		LeaderID:    strconv.FormatUint(status.Leader, 16),
+		IsLeader:    memberID == status.Leader,
+		IsLearner:   status.IsLearner,
+		Version:     status.Version,
+		DBSize:      status.DbSize,
+		DBSizeInUse: status.DbSizeInUse,
+		RaftIndex:   status.RaftIndex,
+		RaftTerm:    status.RaftTerm,
+		Errors:      status.Errors,
+	}, nil
+}
+
+func (ctrl *MemberStatusController) teardown(ctx context.Context, r controller.Runtime) error {
+	err := r.Destroy(ctx, resource.NewMetadata(etcd.NamespaceName, etcd.MemberStatusType, etcd.LocalMemberStatusID, resource.VersionUndefined))
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error destroying etcd member status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	etcdctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/etcd"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockMemberStatus struct {
+	mu   sync.Mutex
+	spec etcd.MemberStatusSpec
+	err  error
+}
+
+func (m *mockMemberStatus) Get(ctx context.Context) (etcd.MemberStatusSpec, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.spec, m.err
+}
+
+func (m *mockMemberStatus) Set(spec etcd.MemberStatusSpec, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.spec, m.err = spec, err
+}
+
+type MemberStatusSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+
+	memberStatus *mockMemberStatus
+}
+
+func (suite *MemberStatusSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.memberStatus = &mockMemberStatus{}
+
+	suite.Require().NoError(suite.runtime.RegisterController(&etcdctrl.MemberStatusController{
+		MemberStatus: suite.memberStatus.Get,
+	}))
+
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *MemberStatusSuite) assertMemberStatus(expected etcd.MemberStatusSpec) {
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			r, err := suite.state.Get(suite.ctx, resource.NewMetadata(etcd.NamespaceName, etcd.MemberStatusType, etcd.LocalMemberStatusID, resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return retry.ExpectedError(err)
+				}
+
+				return err
+			}
+
+			if spec := *r.(*etcd.MemberStatus).TypedSpec(); spec.MemberID != expected.MemberID || spec.RaftIndex != expected.RaftIndex {
+				return retry.ExpectedError(fmt.Errorf("unexpected member status %+v", spec))
+			}
+
+			suite.Assert().Equal(expected, *r.(*etcd.MemberStatus).TypedSpec())
+
+			return nil
+		},
+	))
+}
+
+func (suite *MemberStatusSuite) assertNoMemberStatus() {
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, resource.NewMetadata(etcd.NamespaceName, etcd.MemberStatusType, etcd.LocalMemberStatusID, resource.VersionUndefined))
+			if err == nil {
+				return retry.ExpectedError(fmt.Errorf("member status still exists"))
+			}
+
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		},
+	))
+}
+
+func (suite *MemberStatusSuite) setService(running, healthy bool) {
+	r, err := suite.state.Get(suite.ctx, v1alpha1.NewService("etcd").Metadata())
+	if state.IsNotFoundError(err) {
+		svc := v1alpha1.NewService("etcd")
+		svc.TypedSpec().Running = running
+		svc.TypedSpec().Healthy = healthy
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, svc))
+
+		return
+	}
+
+	suite.Require().NoError(err)
+
+	svc := r.DeepCopy().(*v1alpha1.Service)
+	svc.TypedSpec().Running = running
+	svc.TypedSpec().Healthy = healthy
+
+	old := svc.Metadata().Version()
+
+	svc.Metadata().BumpVersion()
+
+	suite.Require().NoError(suite.state.Update(suite.ctx, old, svc))
+}
+
+func (suite *MemberStatusSuite) TestReconcile() {
+	spec := etcd.MemberStatusSpec{
+		MemberID:  "8e9e05c52164694d",
+		LeaderID:  "8e9e05c52164694d",
+		IsLeader:  true,
+		Version:   "3.5.4",
+		DBSize:    20480,
+		RaftIndex: 4,
+		RaftTerm:  2,
+	}
+
+	suite.memberStatus.Set(spec, nil)
+
+	// etcd is not running yet
+	time.Sleep(500 * time.Millisecond)
+	suite.assertNoMemberStatus()
+
+	suite.setService(true, false)
+	suite.assertMemberStatus(spec)
+
+	// failure to query the status keeps the last known status
+	suite.memberStatus.Set(etcd.MemberStatusSpec{}, errors.New("connection refused"))
+	suite.setService(true, true)
+
+	time.Sleep(500 * time.Millisecond)
+	suite.assertMemberStatus(spec)
+
+	spec.RaftIndex = 10
+	suite.memberStatus.Set(spec, nil)
+	suite.setService(true, false)
+	suite.assertMemberStatus(spec)
+
+	// etcd is stopped
+	suite.setService(false, false)
+	suite.assertNoMemberStatus()
+
+	suite.setService(true, true)
+	suite.assertMemberStatus(spec)
+
+	// etcd service is removed
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, v1alpha1.NewService("etcd").Metadata()))
+	suite.assertNoMemberStatus()
+}
+
+func (suite *MemberStatusSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestMemberStatusSuite(t *testing.T) {
+	suite.Run(t, new(MemberStatusSuite))
+}
@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/talos-systems/go-loadbalancer/loadbalancer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// APIServerLoadBalancerController runs the node-local API server load balancer.
+//
+// Load balancer health checks the upstreams, and the connections are only forwarded to the healthy upstreams.
+type APIServerLoadBalancerController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerController) Name() string {
+	return "k8s.APIServerLoadBalancerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.APIServerLoadBalancerConfigType,
+			ID:        pointer.To(k8s.APIServerLoadBalancerConfigID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		lb     *loadbalancer.TCP
+		lbSpec k8s.APIServerLoadBalancerConfigSpec
+	)
+
+	stopLoadBalancer := func() {
+		if lb == nil {
+			return
+		}
+
+		lb.Close() //nolint:errcheck
+		lb.Wait()  //nolint:errcheck
+		lb = nil
+
+		logger.Info("stopped API server load balancer")
+	}
+
+	defer stopLoadBalancer()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.APIServerLoadBalancerConfigType, k8s.APIServerLoadBalancerConfigID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				stopLoadBalancer()
+
+				continue
+			}
+
+			return fmt.Errorf("error getting API server load balancer config: %w", err)
+		}
+
+		spec := *cfg.(*k8s.APIServerLoadBalancerConfig).TypedSpec()
+
+		if lb != nil && reflect.DeepEqual(spec, lbSpec) {
+			continue
+		}
+
+		addr := net.JoinHostPort(spec.Host, strconv.Itoa(spec.Port))
+
+		// upstreams are updated in place, the load balancer is only restarted if the listen address changes
+		if lb != nil && spec.Host == lbSpec.Host && spec.Port == lbSpec.Port {
+			if err = lb.ReconcileRoute(addr, spec.Upstreams); err != nil {
+				return fmt.Errorf("error updating API server load balancer upstreams: %w", err)
+			}
+
+			lbSpec = spec.DeepCopy()
+
+			logger.Info("updated API server load balancer upstreams", zap.Strings("upstreams", spec.Upstreams))
+
+			continue
+		}
+
+		stopLoadBalancer()
+
+		lb = &loadbalancer.TCP{}
+
+		if err = lb.AddRoute(addr, spec.Upstreams); err != nil {
+			lb = nil
+
+			return fmt.Errorf("error configuring API server load balancer: %w", err)
+		}
+
+		if err = lb.Start(); err != nil {
+			lb = nil
+
+			return fmt.Errorf("error starting API server load balancer: %w", err)
+		}
+
+		lbSpec = spec.DeepCopy()
+
+		logger.Info("started API server load balancer", zap.String("address", addr), zap.Strings("upstreams", spec.Upstreams))
+	}
+}
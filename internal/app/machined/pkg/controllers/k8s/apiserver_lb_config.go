@@ -0,0 +1,178 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+// APIServerLoadBalancerConfigController builds the node-local API server load balancer configuration.
+type APIServerLoadBalancerConfigController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerConfigController) Name() string {
+	return "k8s.APIServerLoadBalancerConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.EndpointType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.MemberType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *APIServerLoadBalancerConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.APIServerLoadBalancerConfigType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *APIServerLoadBalancerConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		if cfg == nil || !cfg.(*config.MachineConfig).Config().Machine().Features().APIServerLoadBalancer().Enabled() {
+			if err = ctrl.teardown(ctx, r); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		cfgProvider := cfg.(*config.MachineConfig).Config()
+
+		endpointResources, err := r.List(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.EndpointType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing endpoints: %w", err)
+		}
+
+		var endpointAddrs k8s.EndpointList
+
+		for _, res := range endpointResources.Items {
+			endpointAddrs = endpointAddrs.Merge(res.(*k8s.Endpoint))
+		}
+
+		// control plane members are used directly, so that the upstreams follow cluster discovery
+		memberResources, err := r.List(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.MemberType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing members: %w", err)
+		}
+
+		for _, res := range memberResources.Items {
+			member := res.(*cluster.Member).TypedSpec()
+
+			if member.MachineType != machine.TypeControlPlane && member.MachineType != machine.TypeInit {
+				continue
+			}
+
+			memberEndpoint := k8s.NewEndpoint(k8s.ControlPlaneNamespaceName, res.Metadata().ID())
+			memberEndpoint.TypedSpec().Addresses = member.Addresses
+
+			endpointAddrs = endpointAddrs.Merge(memberEndpoint)
+		}
+
+		// cluster endpoint is always included, so that the load balancer works before control plane endpoints are discovered
+		clusterEndpoint := cfgProvider.Cluster().Endpoint()
+
+		clusterEndpointPort := clusterEndpoint.Port()
+		if clusterEndpointPort == "" {
+			clusterEndpointPort = "443"
+		}
+
+		upstreams := []string{net.JoinHostPort(clusterEndpoint.Hostname(), clusterEndpointPort)}
+
+		apiServerPort := strconv.Itoa(cfgProvider.Cluster().LocalAPIServerPort())
+
+		for _, addr := range endpointAddrs {
+			upstream := net.JoinHostPort(addr.String(), apiServerPort)
+
+			if upstream != upstreams[0] {
+				upstreams = append(upstreams, upstream)
+			}
+		}
+
+		sort.Strings(upstreams[1:])
+
+		if err = r.Modify(ctx, k8s.NewAPIServerLoadBalancerConfig(k8s.NamespaceName, k8s.APIServerLoadBalancerConfigID), func(r resource.Resource) error {
+			spec := r.(*k8s.APIServerLoadBalancerConfig).TypedSpec()
+
+			spec.Host = constants.APIServerLoadBalancerBindAddress
+			spec.Port = cfgProvider.Machine().Features().APIServerLoadBalancer().Port()
+			spec.Upstreams = upstreams
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating API server load balancer config: %w", err)
+		}
+
+		logger.Debug("updated API server load balancer config", zap.Strings("upstreams", upstreams))
+	}
+}
+
+func (ctrl *APIServerLoadBalancerConfigController) teardown(ctx context.Context, r controller.Runtime) error {
+	list, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.APIServerLoadBalancerConfigType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing resources: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if res.Metadata().Owner() != ctrl.Name() {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return fmt.Errorf("error destroying resource: %w", err)
+		}
+	}
+
+	return nil
+}
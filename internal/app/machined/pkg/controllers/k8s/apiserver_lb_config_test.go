@@ -0,0 +1,196 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//nolint:dupl
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"inet.af/netaddr"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+)
+
+type APIServerLoadBalancerConfigSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+}
+
+func (suite *APIServerLoadBalancerConfigSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.runtime.RegisterController(&k8sctrl.APIServerLoadBalancerConfigController{}))
+
+	suite.startRuntime()
+}
+
+func (suite *APIServerLoadBalancerConfigSuite) startRuntime() {
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *APIServerLoadBalancerConfigSuite) TestReconcile() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	endpoints := k8s.NewEndpoint(k8s.ControlPlaneNamespaceName, k8s.ControlPlaneDiscoveredEndpointsID)
+	endpoints.TypedSpec().Addresses = []netaddr.IP{netaddr.MustParseIP("10.5.0.3"), netaddr.MustParseIP("10.5.0.2")}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, endpoints))
+
+	controlPlaneMember := cluster.NewMember(cluster.NamespaceName, "talos-default-master-3")
+	*controlPlaneMember.TypedSpec() = cluster.MemberSpec{
+		NodeID:      "3",
+		Addresses:   []netaddr.IP{netaddr.MustParseIP("10.5.0.4"), netaddr.MustParseIP("10.5.0.2")},
+		MachineType: machine.TypeControlPlane,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, controlPlaneMember))
+
+	workerMember := cluster.NewMember(cluster.NamespaceName, "talos-default-worker-1")
+	*workerMember.TypedSpec() = cluster.MemberSpec{
+		NodeID:      "4",
+		Addresses:   []netaddr.IP{netaddr.MustParseIP("10.5.0.5")},
+		MachineType: machine.TypeWorker,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, workerMember))
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineFeatures: &v1alpha1.FeaturesConfig{
+					APIServerLoadBalancerConfig: &v1alpha1.APIServerLoadBalancerConfig{
+						LoadBalancerEnabled: true,
+					},
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				lbConfig, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.APIServerLoadBalancerConfigType,
+						k8s.APIServerLoadBalancerConfigID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := lbConfig.(*k8s.APIServerLoadBalancerConfig).TypedSpec()
+
+				suite.Assert().Equal("127.0.0.1", spec.Host)
+				suite.Assert().Equal(7445, spec.Port)
+
+				expectedUpstreams := []string{"foo:6443", "10.5.0.2:6443", "10.5.0.3:6443", "10.5.0.4:6443"}
+
+				if !reflect.DeepEqual(expectedUpstreams, spec.Upstreams) {
+					return retry.ExpectedError(fmt.Errorf("expected upstreams %q, got %q", expectedUpstreams, spec.Upstreams))
+				}
+
+				return nil
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, cfg.Metadata()))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				_, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.APIServerLoadBalancerConfigType,
+						k8s.APIServerLoadBalancerConfigID,
+						resource.VersionUndefined,
+					),
+				)
+				if err == nil {
+					return retry.ExpectedError(fmt.Errorf("load balancer config still exists"))
+				}
+
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			},
+		),
+	)
+}
+
+func (suite *APIServerLoadBalancerConfigSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestAPIServerLoadBalancerConfigSuite(t *testing.T) {
+	suite.Run(t, new(APIServerLoadBalancerConfigSuite))
+}
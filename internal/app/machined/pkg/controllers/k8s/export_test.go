@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+
+	"github.com/talos-systems/crypto/x509"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	k8sclient "k8s.io/client-go/kubernetes"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func (ctrl *TalosServiceAccountController) GrantedRoles(serviceAccount *unstructured.Unstructured, allowedRoles role.Set) (role.Set, string) {
+	return ctrl.grantedRoles(serviceAccount, allowedRoles)
+}
+
+func (ctrl *TalosServiceAccountController) ReconcileServiceAccounts(ctx context.Context, logger *zap.Logger, client k8sclient.Interface, dyn dynamic.Interface,
+	access talosconfig.KubernetesTalosAPIAccess, clusterName string, ca *x509.PEMEncodedCertificateAndKey, endpoints []string,
+) error {
+	return ctrl.reconcileServiceAccounts(ctx, logger, client, dyn, access, clusterName, ca, endpoints)
+}
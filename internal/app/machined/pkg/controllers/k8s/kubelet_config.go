@@ -79,6 +79,10 @@ func (ctrl *KubeletConfigController) Run(ctx context.Context, r controller.Runti
 
 				kubeletConfig.ClusterDNS = cfgProvider.Machine().Kubelet().ClusterDNS()
 
+				if len(kubeletConfig.ClusterDNS) == 0 && cfgProvider.Cluster().CoreDNS().NodeLocalCache().Enabled() {
+					kubeletConfig.ClusterDNS = []string{cfgProvider.Cluster().CoreDNS().NodeLocalCache().IP()}
+				}
+
 				if len(kubeletConfig.ClusterDNS) == 0 {
 					var addrs []net.IP
 
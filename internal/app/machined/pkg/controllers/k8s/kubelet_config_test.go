@@ -232,6 +232,68 @@ func (suite *KubeletConfigSuite) TestReconcileDefaults() {
 	)
 }
 
+func (suite *KubeletConfigSuite) TestReconcileNodeLocalDNS() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletImage: "kubelet",
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+					ServiceSubnet: []string{constants.DefaultIPv4ServiceNet},
+				},
+				CoreDNSConfig: &v1alpha1.CoreDNS{
+					CoreDNSNodeLocalCache: &v1alpha1.CoreDNSNodeLocalCacheConfig{
+						NodeLocalCacheEnabled: true,
+					},
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletConfig, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletConfigType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := kubeletConfig.(*k8s.KubeletConfig).TypedSpec()
+
+				suite.Assert().Equal([]string{constants.DefaultNodeLocalDNSIP}, spec.ClusterDNS)
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *KubeletConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
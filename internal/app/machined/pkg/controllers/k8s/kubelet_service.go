@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -21,6 +22,7 @@ import (
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/tools/clientcmd"
 	kubeletconfig "k8s.io/kubelet/config/v1beta1"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
@@ -162,6 +164,12 @@ func (ctrl *KubeletServiceController) Run(ctx context.Context, r controller.Runt
 			}
 		}
 
+		// kubelet owns its kubeconfig while running (it rewrites the file on certificate rotation),
+		// so the endpoint is updated only while kubelet is stopped
+		if err = ctrl.updateKubeconfig(secretSpec.Endpoint.String()); err != nil {
+			return fmt.Errorf("error updating kubelet kubeconfig: %w", err)
+		}
+
 		if err = ctrl.V1Alpha1Services.Start("kubelet"); err != nil {
 			return fmt.Errorf("error starting kubelet service: %w", err)
 		}
@@ -201,7 +209,60 @@ func (ctrl *KubeletServiceController) writePKI(secretSpec *secrets.KubeletSpec)
 		return err
 	}
 
-	return nil
+	return ctrl.writeKubeProxyKubeconfig(cfg.Server)
+}
+
+// writeKubeProxyKubeconfig writes the kubeconfig for kube-proxy running on this node.
+//
+// kube-proxy DaemonSet mounts the kubeconfig from the host, so that each node can point kube-proxy
+// either to the node-local API server load balancer or to the cluster endpoint.
+func (ctrl *KubeletServiceController) writeKubeProxyKubeconfig(server string) error {
+	templ := template.Must(template.New("tmpl").Parse(string(kubeProxyKubeConfigTemplate)))
+
+	var buf bytes.Buffer
+
+	if err := templ.Execute(&buf, struct {
+		Server string
+	}{
+		Server: server,
+	}); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(constants.KubeProxyKubeconfigDir, 0o755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(constants.KubeProxyKubeconfigDir, "kubeconfig"), buf.Bytes(), 0o644)
+}
+
+// updateKubeconfig updates the API server endpoint in the kubeconfig generated by the kubelet after the bootstrap.
+//
+// The endpoint changes when the node-local API server load balancer is enabled or disabled.
+func (ctrl *KubeletServiceController) updateKubeconfig(server string) error {
+	config, err := clientcmd.LoadFromFile(constants.KubeletKubeconfig)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	changed := false
+
+	for _, cluster := range config.Clusters {
+		if cluster.Server != server {
+			cluster.Server = server
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return clientcmd.WriteToFile(*config, constants.KubeletKubeconfig)
 }
 
 var kubeletKubeConfigTemplate = []byte(`apiVersion: v1
@@ -221,6 +282,24 @@ contexts:
     user: kubelet
 `)
 
+// kubeProxyKubeConfigTemplate uses the service account token of the kube-proxy pod.
+var kubeProxyKubeConfigTemplate = []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: local
+  cluster:
+    server: {{ .Server }}
+    certificate-authority: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+users:
+- name: service-account
+  user:
+    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token
+contexts:
+- context:
+    cluster: local
+    user: service-account
+`)
+
 func (ctrl *KubeletServiceController) writeConfig(cfgSpec *k8s.KubeletSpecSpec) error {
 	var kubeletConfiguration kubeletconfig.KubeletConfiguration
 
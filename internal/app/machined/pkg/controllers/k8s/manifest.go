@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	k8sadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
 )
@@ -154,16 +155,37 @@ func jsonify(input string) (string, error) {
 	return string(out), err
 }
 
+// indent indents every line of the input with the specified number of spaces.
+func indent(spaces int, input string) string {
+	pad := strings.Repeat(" ", spaces)
+
+	return pad + strings.ReplaceAll(strings.TrimRight(input, "\n"), "\n", "\n"+pad)
+}
+
 func (ctrl *ManifestController) render(cfg k8s.BootstrapManifestsConfigSpec, scrt *secrets.KubernetesRootSpec) ([]renderedManifest, error) {
 	templateConfig := struct {
 		k8s.BootstrapManifestsConfigSpec
 
 		Secrets *secrets.KubernetesRootSpec
+
+		TalosServiceAccount struct {
+			Group            string
+			Version          string
+			Kind             string
+			ResourcePlural   string
+			ResourceSingular string
+		}
 	}{
 		BootstrapManifestsConfigSpec: cfg,
 		Secrets:                      scrt,
 	}
 
+	templateConfig.TalosServiceAccount.Group = constants.KubernetesTalosAPIServiceAccountGroup
+	templateConfig.TalosServiceAccount.Version = constants.KubernetesTalosAPIServiceAccountVersion
+	templateConfig.TalosServiceAccount.Kind = constants.KubernetesTalosAPIServiceAccountKind
+	templateConfig.TalosServiceAccount.ResourcePlural = constants.KubernetesTalosAPIServiceAccountResource
+	templateConfig.TalosServiceAccount.ResourceSingular = strings.ToLower(constants.KubernetesTalosAPIServiceAccountKind)
+
 	type manifestDesc struct {
 		name     string
 		template []byte
@@ -187,6 +209,14 @@ func (ctrl *ManifestController) render(cfg k8s.BootstrapManifestsConfigSpec, scr
 		)
 	}
 
+	if cfg.NodeLocalDNSEnabled {
+		defaultManifests = append(defaultManifests,
+			[]manifestDesc{
+				{"12-node-local-dns", nodeLocalDNSTemplate},
+			}...,
+		)
+	}
+
 	if cfg.FlannelEnabled {
 		defaultManifests = append(defaultManifests,
 			[]manifestDesc{
@@ -203,6 +233,23 @@ func (ctrl *ManifestController) render(cfg k8s.BootstrapManifestsConfigSpec, scr
 		)
 	}
 
+	if cfg.CloudProviderName != "" {
+		defaultManifests = append(defaultManifests,
+			[]manifestDesc{
+				{"04-cloud-controller-manager-rbac", cloudControllerManagerRBACTemplate},
+				{"04-cloud-controller-manager", cloudControllerManagerDaemonSetTemplate},
+			}...,
+		)
+	}
+
+	if cfg.TalosAPIServiceAccountCRDEnabled {
+		defaultManifests = append(defaultManifests,
+			[]manifestDesc{
+				{"13-talos-service-account-crd", talosServiceAccountCRDTemplate},
+			}...,
+		)
+	}
+
 	if cfg.PodSecurityPolicyEnabled {
 		defaultManifests = append(defaultManifests,
 			[]manifestDesc{
@@ -216,8 +263,9 @@ func (ctrl *ManifestController) render(cfg k8s.BootstrapManifestsConfigSpec, scr
 	for i := range defaultManifests {
 		tmpl, err := template.New(defaultManifests[i].name).
 			Funcs(template.FuncMap{
-				"json": jsonify,
-				"join": strings.Join,
+				"json":   jsonify,
+				"join":   strings.Join,
+				"indent": indent,
 			}).
 			Parse(string(defaultManifests[i].template))
 		if err != nil {
@@ -268,6 +268,216 @@ func (suite *ManifestSuite) TestReconcileDisablePSP() {
 	)
 }
 
+func (suite *ManifestSuite) TestReconcileCloudControllerManager() {
+	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	manifestConfig := k8s.NewBootstrapManifestsConfig()
+	spec := defaultManifestSpec
+	spec.ClusterName = "test"
+	spec.CloudProviderName = "azure"
+	spec.CloudProviderImage = "foo/ccm"
+	spec.CloudProviderNodeManagerImage = "foo/cnm"
+	spec.CloudProviderConfig = `{"cloud": "AzurePublicCloud"}`
+	*manifestConfig.TypedSpec() = spec
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+	suite.Require().NoError(suite.state.Create(suite.ctx, manifestConfig))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertManifests(
+					[]string{
+						"00-kubelet-bootstrapping-token",
+						"01-csr-approver-role-binding",
+						"01-csr-node-bootstrap",
+						"01-csr-renewal-role-binding",
+						"02-kube-system-sa-role-binding",
+						"03-default-pod-security-policy",
+						"04-cloud-controller-manager",
+						"04-cloud-controller-manager-rbac",
+						"05-flannel",
+						"10-kube-proxy",
+						"11-core-dns",
+						"11-core-dns-svc",
+						"11-kube-config-in-cluster",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(
+			k8s.ControlPlaneNamespaceName,
+			k8s.ManifestType,
+			"04-cloud-controller-manager-rbac",
+			resource.VersionUndefined,
+		),
+	)
+	suite.Require().NoError(err)
+
+	manifest := r.(*k8s.Manifest) //nolint:errcheck,forcetypeassert
+	suite.Assert().Len(k8sadapter.Manifest(manifest).Objects(), 5)
+	suite.Assert().Equal("Secret", k8sadapter.Manifest(manifest).Objects()[4].GetKind())
+
+	r, err = suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(
+			k8s.ControlPlaneNamespaceName,
+			k8s.ManifestType,
+			"04-cloud-controller-manager",
+			resource.VersionUndefined,
+		),
+	)
+	suite.Require().NoError(err)
+
+	manifest = r.(*k8s.Manifest) //nolint:errcheck,forcetypeassert
+	suite.Assert().Len(k8sadapter.Manifest(manifest).Objects(), 5)
+
+	suite.Assert().Equal("DaemonSet", k8sadapter.Manifest(manifest).Objects()[0].GetKind())
+
+	ds := k8sadapter.Manifest(manifest).Objects()[0].Object
+	containerSpec := ds["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0]
+	args := containerSpec.(map[string]interface{})["args"].([]interface{}) //nolint:errcheck,forcetypeassert
+
+	suite.Assert().Contains(args, "--cloud-provider=azure")
+	suite.Assert().Contains(args, "--cluster-name=test")
+	suite.Assert().Contains(args, "--cloud-config=/etc/cloud-config/cloud.conf")
+}
+
+func (suite *ManifestSuite) TestReconcileCoreDNSCustomization() {
+	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	manifestConfig := k8s.NewBootstrapManifestsConfig()
+	spec := defaultManifestSpec
+	spec.CoreDNSUpstreams = []string{"1.1.1.1", "tls://8.8.8.8"}
+	spec.CoreDNSStubDomains = []k8s.CoreDNSStubDomain{
+		{
+			Domain:  "corp.example.com",
+			Servers: []string{"10.0.0.1", "10.0.0.2"},
+		},
+	}
+	spec.CoreDNSCacheTTL = 60
+	spec.CoreDNSCacheSuccess = 1000
+	spec.CoreDNSHosts = []k8s.CoreDNSHost{
+		{
+			IP:        "10.5.0.1",
+			Hostnames: []string{"registry.local"},
+		},
+	}
+	spec.CoreDNSExtraServerBlocks = []string{"consul.local:53 {\n    errors\n    forward . 10.150.0.1\n}\n"}
+	*manifestConfig.TypedSpec() = spec
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+	suite.Require().NoError(suite.state.Create(suite.ctx, manifestConfig))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertManifests(
+					[]string{
+						"00-kubelet-bootstrapping-token",
+						"01-csr-approver-role-binding",
+						"01-csr-node-bootstrap",
+						"01-csr-renewal-role-binding",
+						"02-kube-system-sa-role-binding",
+						"03-default-pod-security-policy",
+						"05-flannel",
+						"10-kube-proxy",
+						"11-core-dns",
+						"11-core-dns-svc",
+						"11-kube-config-in-cluster",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(
+			k8s.ControlPlaneNamespaceName,
+			k8s.ManifestType,
+			"11-core-dns",
+			resource.VersionUndefined,
+		),
+	)
+	suite.Require().NoError(err)
+
+	manifest := r.(*k8s.Manifest) //nolint:errcheck,forcetypeassert
+
+	var corefile string
+
+	for _, obj := range k8sadapter.Manifest(manifest).Objects() {
+		if obj.GetKind() == "ConfigMap" {
+			corefile = obj.Object["data"].(map[string]interface{})["Corefile"].(string) //nolint:errcheck,forcetypeassert
+		}
+	}
+
+	suite.Assert().Contains(corefile, "forward . 1.1.1.1 tls://8.8.8.8\n")
+	suite.Assert().Contains(corefile, "cache 60 {\n        success 1000\n    }\n")
+	suite.Assert().Contains(corefile, "hosts {\n        10.5.0.1 registry.local\n        fallthrough\n    }\n")
+	suite.Assert().Contains(corefile, "corp.example.com:53 {\n    errors\n    forward . 10.0.0.1 10.0.0.2\n")
+	suite.Assert().Contains(corefile, "consul.local:53 {\n    errors\n    forward . 10.150.0.1\n}\n")
+}
+
+func (suite *ManifestSuite) TestReconcileNodeLocalDNS() {
+	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	manifestConfig := k8s.NewBootstrapManifestsConfig()
+	spec := defaultManifestSpec
+	spec.NodeLocalDNSEnabled = true
+	spec.NodeLocalDNSImage = "foo/node-cache"
+	spec.NodeLocalDNSIP = "169.254.20.10"
+	*manifestConfig.TypedSpec() = spec
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+	suite.Require().NoError(suite.state.Create(suite.ctx, manifestConfig))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertManifests(
+					[]string{
+						"00-kubelet-bootstrapping-token",
+						"01-csr-approver-role-binding",
+						"01-csr-node-bootstrap",
+						"01-csr-renewal-role-binding",
+						"02-kube-system-sa-role-binding",
+						"03-default-pod-security-policy",
+						"05-flannel",
+						"10-kube-proxy",
+						"11-core-dns",
+						"11-core-dns-svc",
+						"11-kube-config-in-cluster",
+						"12-node-local-dns",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(
+			k8s.ControlPlaneNamespaceName,
+			k8s.ManifestType,
+			"12-node-local-dns",
+			resource.VersionUndefined,
+		),
+	)
+	suite.Require().NoError(err)
+
+	manifest := r.(*k8s.Manifest) //nolint:errcheck,forcetypeassert
+	suite.Assert().Len(k8sadapter.Manifest(manifest).Objects(), 4)
+	suite.Assert().Equal("DaemonSet", k8sadapter.Manifest(manifest).Objects()[3].GetKind())
+
+	ds := k8sadapter.Manifest(manifest).Objects()[3].Object
+	containerSpec := ds["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0]
+	args := containerSpec.(map[string]interface{})["args"].([]interface{}) //nolint:errcheck,forcetypeassert
+
+	suite.Assert().Contains(args, "-localip=169.254.20.10")
+}
+
 func (suite *ManifestSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
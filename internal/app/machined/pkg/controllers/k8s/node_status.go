@@ -0,0 +1,259 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/talos-systems/talos/pkg/conditions"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// NodeStatusController pulls the status of the Kubernetes Node object for this node.
+type NodeStatusController struct {
+	// NodeWatcher overrides watching the Kubernetes Node object with kubelet credentials, used in tests.
+	//
+	// The watch should be stopped when the context is canceled, nil Node is sent when the Node is deleted.
+	NodeWatcher func(ctx context.Context, logger *zap.Logger, nodename string) (<-chan *corev1.Node, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeStatusController) Name() string {
+	return "k8s.NodeStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        pointer.To(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To("kubelet"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.NodeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *NodeStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.NodeWatcher == nil {
+		ctrl.NodeWatcher = kubeletNodeWatcher
+	}
+
+	var (
+		nodename       string
+		notifyCh       <-chan *corev1.Node
+		watchCtxCancel context.CancelFunc
+	)
+
+	stopWatch := func() {
+		if watchCtxCancel != nil {
+			watchCtxCancel()
+			watchCtxCancel = nil
+		}
+
+		notifyCh = nil
+		nodename = ""
+	}
+
+	defer stopWatch()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case node := <-notifyCh:
+			if err := ctrl.updateNodeStatus(ctx, r, nodename, node); err != nil {
+				return err
+			}
+
+			continue
+		case <-r.EventCh():
+		}
+
+		kubeletResource, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "kubelet", resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting kubelet service: %w", err)
+		}
+
+		if err != nil || !kubeletResource.(*v1alpha1.Service).TypedSpec().Running {
+			stopWatch()
+
+			if err = ctrl.teardownAll(ctx, r); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		nodenameResource, err := r.Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodenameType, k8s.NodenameID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting nodename: %w", err)
+		}
+
+		newNodename := nodenameResource.(*k8s.Nodename).TypedSpec().Nodename
+
+		if newNodename == nodename {
+			continue
+		}
+
+		stopWatch()
+
+		if err = ctrl.teardownAll(ctx, r); err != nil {
+			return err
+		}
+
+		var watchCtx context.Context
+
+		watchCtx, watchCtxCancel = context.WithCancel(ctx)
+
+		notifyCh, err = ctrl.NodeWatcher(watchCtx, logger, newNodename)
+		if err != nil {
+			return err
+		}
+
+		nodename = newNodename
+	}
+}
+
+func (ctrl *NodeStatusController) updateNodeStatus(ctx context.Context, r controller.Runtime, nodename string, node *corev1.Node) error {
+	if node == nil {
+		err := r.Destroy(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeStatusType, nodename, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying node status: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := r.Modify(ctx, k8s.NewNodeStatus(k8s.NamespaceName, nodename), func(r resource.Resource) error {
+		spec := r.(*k8s.NodeStatus).TypedSpec()
+
+		spec.Nodename = node.Name
+		spec.Unschedulable = node.Spec.Unschedulable
+		spec.KubeletVersion = node.Status.NodeInfo.KubeletVersion
+		spec.Labels = node.Labels
+		spec.NodeReady = false
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				spec.NodeReady = condition.Status == corev1.ConditionTrue
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating node status: %w", err)
+	}
+
+	return nil
+}
+
+func (ctrl *NodeStatusController) teardownAll(ctx context.Context, r controller.Runtime) error {
+	list, err := r.List(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return fmt.Errorf("error listing node statuses: %w", err)
+	}
+
+	for _, res := range list.Items {
+		if err = r.Destroy(ctx, res.Metadata()); err != nil {
+			return fmt.Errorf("error destroying node status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// kubeletNodeWatcher watches the Node object using kubelet credentials.
+func kubeletNodeWatcher(ctx context.Context, logger *zap.Logger, nodename string) (<-chan *corev1.Node, error) {
+	logger.Debug("waiting for kubelet client config", zap.String("file", constants.KubeletKubeconfig))
+
+	if err := conditions.WaitForKubeconfigReady(constants.KubeletKubeconfig).Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewClientFromKubeletKubeconfig()
+	if err != nil {
+		return nil, fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		client.Close() //nolint:errcheck
+	}()
+
+	return kubernetesNodeWatcher(ctx, logger, client, nodename), nil
+}
+
+func kubernetesNodeWatcher(ctx context.Context, logger *zap.Logger, client *kubernetes.Client, nodename string) <-chan *corev1.Node {
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(
+		client.Clientset, 30*time.Second,
+		informers.WithTweakListOptions(func(options *v1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", nodename).String()
+		}),
+	)
+
+	notifyCh := make(chan *corev1.Node)
+
+	notify := func(node *corev1.Node) {
+		select {
+		case notifyCh <- node:
+		case <-ctx.Done():
+		}
+	}
+
+	informer := informerFactory.Core().V1().Nodes().Informer()
+	informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) { //nolint:errcheck
+		logger.Error("kubernetes node watch error", zap.Error(err))
+	})
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { notify(obj.(*corev1.Node)) },
+		DeleteFunc: func(_ interface{}) { notify(nil) },
+		UpdateFunc: func(_, obj interface{}) { notify(obj.(*corev1.Node)) },
+	})
+
+	informerFactory.Start(ctx.Done())
+
+	return notifyCh
+}
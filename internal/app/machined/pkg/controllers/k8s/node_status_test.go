@@ -0,0 +1,326 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/talos-systems/talos/pkg/logging"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockNodeWatch struct {
+	ctx      context.Context //nolint:containedctx
+	nodename string
+	notifyCh chan *corev1.Node
+}
+
+type mockNodeWatcher struct {
+	watches chan *mockNodeWatch
+}
+
+func (m *mockNodeWatcher) Watch(ctx context.Context, logger *zap.Logger, nodename string) (<-chan *corev1.Node, error) {
+	watch := &mockNodeWatch{
+		ctx:      ctx,
+		nodename: nodename,
+		notifyCh: make(chan *corev1.Node),
+	}
+
+	select {
+	case m.watches <- watch:
+	case <-ctx.Done():
+	}
+
+	return watch.notifyCh, nil
+}
+
+type NodeStatusSuite struct {
+	suite.Suite
+
+	state state.State
+
+	runtime *runtime.Runtime
+	wg      sync.WaitGroup
+
+	ctx       context.Context //nolint:containedctx
+	ctxCancel context.CancelFunc
+
+	watcher *mockNodeWatcher
+}
+
+func (suite *NodeStatusSuite) SetupTest() {
+	suite.ctx, suite.ctxCancel = context.WithTimeout(context.Background(), 3*time.Minute)
+
+	suite.state = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	var err error
+
+	suite.runtime, err = runtime.NewRuntime(suite.state, logging.Wrap(log.Writer()))
+	suite.Require().NoError(err)
+
+	suite.watcher = &mockNodeWatcher{
+		watches: make(chan *mockNodeWatch),
+	}
+
+	suite.Require().NoError(suite.runtime.RegisterController(&k8sctrl.NodeStatusController{
+		NodeWatcher: suite.watcher.Watch,
+	}))
+
+	suite.wg.Add(1)
+
+	go func() {
+		defer suite.wg.Done()
+
+		suite.Assert().NoError(suite.runtime.Run(suite.ctx))
+	}()
+}
+
+func (suite *NodeStatusSuite) waitWatch(nodename string) *mockNodeWatch {
+	select {
+	case watch := <-suite.watcher.watches:
+		suite.Require().Equal(nodename, watch.nodename)
+
+		return watch
+	case <-time.After(10 * time.Second):
+		suite.FailNow("timed out waiting for the node watch")
+	}
+
+	return nil
+}
+
+func (suite *NodeStatusSuite) notify(watch *mockNodeWatch, node *corev1.Node) {
+	select {
+	case watch.notifyCh <- node:
+	case <-time.After(10 * time.Second):
+		suite.FailNow("timed out sending the node update")
+	}
+}
+
+func (suite *NodeStatusSuite) assertNodeStatus(id resource.ID, expected k8s.NodeStatusSpec) {
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			r, err := suite.state.Get(suite.ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeStatusType, id, resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return retry.ExpectedError(err)
+				}
+
+				return err
+			}
+
+			if spec := *r.(*k8s.NodeStatus).TypedSpec(); spec.NodeReady != expected.NodeReady || spec.Unschedulable != expected.Unschedulable {
+				return retry.ExpectedError(fmt.Errorf("unexpected node status %+v", spec))
+			}
+
+			suite.Assert().Equal(expected, *r.(*k8s.NodeStatus).TypedSpec())
+
+			return nil
+		},
+	))
+}
+
+func (suite *NodeStatusSuite) assertNoNodeStatus() {
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			list, err := suite.state.List(suite.ctx, resource.NewMetadata(k8s.NamespaceName, k8s.NodeStatusType, "", resource.VersionUndefined))
+			if err != nil {
+				return err
+			}
+
+			if len(list.Items) > 0 {
+				return retry.ExpectedError(fmt.Errorf("expected no node statuses, got %d", len(list.Items)))
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *NodeStatusSuite) setKubelet(running bool) {
+	r, err := suite.state.Get(suite.ctx, v1alpha1.NewService("kubelet").Metadata())
+	if state.IsNotFoundError(err) {
+		kubelet := v1alpha1.NewService("kubelet")
+		kubelet.TypedSpec().Running = running
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, kubelet))
+
+		return
+	}
+
+	suite.Require().NoError(err)
+
+	kubelet := r.DeepCopy().(*v1alpha1.Service)
+	kubelet.TypedSpec().Running = running
+
+	old := kubelet.Metadata().Version()
+
+	kubelet.Metadata().BumpVersion()
+
+	suite.Require().NoError(suite.state.Update(suite.ctx, old, kubelet))
+}
+
+func (suite *NodeStatusSuite) setNodename(nodename string) {
+	r, err := suite.state.Get(suite.ctx, k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID).Metadata())
+	if state.IsNotFoundError(err) {
+		res := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+		res.TypedSpec().Nodename = nodename
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, res))
+
+		return
+	}
+
+	suite.Require().NoError(err)
+
+	res := r.DeepCopy().(*k8s.Nodename)
+	res.TypedSpec().Nodename = nodename
+
+	old := res.Metadata().Version()
+
+	res.Metadata().BumpVersion()
+
+	suite.Require().NoError(suite.state.Update(suite.ctx, old, res))
+}
+
+func node(name string, ready, unschedulable bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"node-role.kubernetes.io/control-plane": "",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: unschedulable,
+		},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{
+				KubeletVersion: "v1.24.1",
+			},
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:   corev1.NodeMemoryPressure,
+					Status: corev1.ConditionFalse,
+				},
+				{
+					Type:   corev1.NodeReady,
+					Status: status,
+				},
+			},
+		},
+	}
+}
+
+func nodeStatusSpec(name string, ready, unschedulable bool) k8s.NodeStatusSpec {
+	return k8s.NodeStatusSpec{
+		Nodename:       name,
+		NodeReady:      ready,
+		Unschedulable:  unschedulable,
+		KubeletVersion: "v1.24.1",
+		Labels: map[string]string{
+			"node-role.kubernetes.io/control-plane": "",
+		},
+	}
+}
+
+func (suite *NodeStatusSuite) TestReconcile() {
+	suite.setNodename("talos-master-1")
+
+	// kubelet is not running, so the node is not watched
+	suite.setKubelet(false)
+
+	select {
+	case <-suite.watcher.watches:
+		suite.FailNow("unexpected node watch")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	suite.setKubelet(true)
+
+	watch := suite.waitWatch("talos-master-1")
+
+	suite.notify(watch, node("talos-master-1", false, true))
+	suite.assertNodeStatus("talos-master-1", nodeStatusSpec("talos-master-1", false, true))
+
+	suite.notify(watch, node("talos-master-1", true, false))
+	suite.assertNodeStatus("talos-master-1", nodeStatusSpec("talos-master-1", true, false))
+
+	// node is deleted
+	suite.notify(watch, nil)
+	suite.assertNoNodeStatus()
+
+	suite.notify(watch, node("talos-master-1", true, false))
+	suite.assertNodeStatus("talos-master-1", nodeStatusSpec("talos-master-1", true, false))
+
+	// nodename changes, so the watch is restarted and the old status is removed
+	suite.setNodename("talos-master-2")
+
+	newWatch := suite.waitWatch("talos-master-2")
+
+	suite.Require().Error(watch.ctx.Err())
+	suite.assertNoNodeStatus()
+
+	watch = newWatch
+
+	suite.notify(watch, node("talos-master-2", true, false))
+	suite.assertNodeStatus("talos-master-2", nodeStatusSpec("talos-master-2", true, false))
+
+	// kubelet is stopped
+	suite.setKubelet(false)
+	suite.assertNoNodeStatus()
+
+	select {
+	case <-watch.ctx.Done():
+	case <-time.After(10 * time.Second):
+		suite.FailNow("node watch is not stopped")
+	}
+
+	// kubelet is started again
+	suite.setKubelet(true)
+
+	watch = suite.waitWatch("talos-master-2")
+
+	suite.notify(watch, node("talos-master-2", false, false))
+	suite.assertNodeStatus("talos-master-2", nodeStatusSpec("talos-master-2", false, false))
+
+	// kubelet service is removed
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, v1alpha1.NewService("kubelet").Metadata()))
+	suite.assertNoNodeStatus()
+}
+
+func (suite *NodeStatusSuite) TearDownTest() {
+	suite.T().Log("tear down")
+
+	suite.ctxCancel()
+
+	suite.wg.Wait()
+}
+
+func TestNodeStatusSuite(t *testing.T) {
+	suite.Run(t, new(NodeStatusSuite))
+}
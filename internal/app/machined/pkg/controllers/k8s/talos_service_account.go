@@ -0,0 +1,438 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/talos-systems/crypto/x509"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/talos-systems/talos/pkg/kubernetes"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
+	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+const (
+	// talosServiceAccountExpiryAnnotation stores the expiration time of the certificate issued in the secret.
+	talosServiceAccountExpiryAnnotation = "talos.dev/certificate-expiry"
+
+	// talosServiceAccountRolesAnnotation stores the roles of the certificate issued in the secret.
+	talosServiceAccountRolesAnnotation = "talos.dev/roles"
+)
+
+// TalosServiceAccountController issues Talos API client certificates for Kubernetes pods.
+//
+// For each `talos.dev/v1alpha1` `ServiceAccount` resource in the allowed namespaces, a Kubernetes secret
+// with the same name is created which contains talosconfig with the short-lived certificate
+// for the requested roles. Certificates are reissued before they expire.
+type TalosServiceAccountController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *TalosServiceAccountController) Name() string {
+	return "k8s.TalosServiceAccountController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TalosServiceAccountController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        pointer.To(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.OSRootType,
+			ID:        pointer.To(secrets.OSRootID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.EndpointType,
+			ID:        pointer.To(k8s.ControlPlaneAPIServerEndpointsID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TalosServiceAccountController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *TalosServiceAccountController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		cfgProvider := cfg.(*config.MachineConfig).Config()
+
+		if cfgProvider.Machine().Type() == machine.TypeWorker || !cfgProvider.Machine().Features().KubernetesTalosAPIAccess().Enabled() {
+			continue
+		}
+
+		secretsResource, err := r.Get(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.KubernetesType, secrets.KubernetesID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting Kubernetes secrets: %w", err)
+		}
+
+		osRoot, err := r.Get(ctx, resource.NewMetadata(secrets.NamespaceName, secrets.OSRootType, secrets.OSRootID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting OS root secrets: %w", err)
+		}
+
+		endpointsResource, err := r.Get(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.EndpointType, k8s.ControlPlaneAPIServerEndpointsID, resource.VersionUndefined))
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting control plane endpoints: %w", err)
+		}
+
+		endpoints := k8s.EndpointList{}.Merge(endpointsResource.(*k8s.Endpoint)).Strings()
+
+		if err = ctrl.reconcile(
+			ctx,
+			logger,
+			cfgProvider,
+			secretsResource.(*secrets.Kubernetes).TypedSpec().LocalhostAdminKubeconfig,
+			osRoot.(*secrets.OSRoot).TypedSpec().CA,
+			endpoints,
+		); err != nil {
+			// Kubernetes API might be not available yet, or the CRD is not created yet, retry on the next tick
+			logger.Warn("error issuing Talos API access secrets", zap.Error(err))
+		}
+	}
+}
+
+func (ctrl *TalosServiceAccountController) reconcile(
+	ctx context.Context,
+	logger *zap.Logger,
+	cfgProvider talosconfig.Provider,
+	kubeconfig string,
+	ca *x509.PEMEncodedCertificateAndKey,
+	endpoints []string,
+) error {
+	restConfig, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load([]byte(kubeconfig))
+	})
+	if err != nil {
+		return fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(rest.CopyConfig(restConfig))
+	if err != nil {
+		return fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	dyn, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error building Kubernetes dynamic client: %w", err)
+	}
+
+	return ctrl.reconcileServiceAccounts(ctx, logger, client, dyn, cfgProvider.Machine().Features().KubernetesTalosAPIAccess(), cfgProvider.Cluster().Name(), ca, endpoints)
+}
+
+// reconcileServiceAccounts issues the secrets for the service accounts in the allowed namespaces.
+//
+// Resources might be modified concurrently (e.g. by the controller running on another control plane node),
+// conflicts are not treated as errors, as the service account is processed again on the next reconcile.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *TalosServiceAccountController) reconcileServiceAccounts(
+	ctx context.Context,
+	logger *zap.Logger,
+	client k8sclient.Interface,
+	dyn dynamic.Interface,
+	access talosconfig.KubernetesTalosAPIAccess,
+	clusterName string,
+	ca *x509.PEMEncodedCertificateAndKey,
+	endpoints []string,
+) error {
+	gvr := schema.GroupVersionResource{
+		Group:    constants.KubernetesTalosAPIServiceAccountGroup,
+		Version:  constants.KubernetesTalosAPIServiceAccountVersion,
+		Resource: constants.KubernetesTalosAPIServiceAccountResource,
+	}
+
+	allowedRoles, _ := role.Parse(access.AllowedRoles())
+
+	for _, namespace := range access.AllowedKubernetesNamespaces() {
+		serviceAccounts, err := dyn.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing service accounts in namespace %q: %w", namespace, err)
+		}
+
+		for i := range serviceAccounts.Items {
+			serviceAccount := &serviceAccounts.Items[i]
+
+			secret, getErr := client.CoreV1().Secrets(namespace).Get(ctx, serviceAccount.GetName(), metav1.GetOptions{})
+			if getErr != nil {
+				if !apierrors.IsNotFound(getErr) {
+					return fmt.Errorf("error getting secret %s/%s: %w", namespace, serviceAccount.GetName(), getErr)
+				}
+
+				secret = nil
+			}
+
+			failureReason := ""
+
+			var roles role.Set
+
+			// secrets which were not created for the service account are never touched
+			if secret != nil && !isOwnedBy(secret, serviceAccount) {
+				failureReason = fmt.Sprintf("secret %q already exists and is not owned by the service account", secret.Name)
+			} else {
+				roles, failureReason = ctrl.grantedRoles(serviceAccount, allowedRoles)
+			}
+
+			if err = ctrl.updateStatus(ctx, dyn.Resource(gvr).Namespace(namespace), serviceAccount, failureReason); err != nil {
+				if apierrors.IsConflict(err) {
+					logger.Debug("service account was modified concurrently, skipping",
+						zap.String("namespace", namespace), zap.String("name", serviceAccount.GetName()))
+
+					continue
+				}
+
+				return err
+			}
+
+			if failureReason != "" {
+				logger.Info("Talos API access denied",
+					zap.String("namespace", namespace), zap.String("name", serviceAccount.GetName()), zap.String("reason", failureReason))
+
+				if secret == nil || !isOwnedBy(secret, serviceAccount) {
+					continue
+				}
+
+				// precondition makes sure that the secret wasn't re-created concurrently
+				if err = client.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{UID: &secret.UID},
+				}); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+					return fmt.Errorf("error deleting secret %s/%s: %w", namespace, secret.Name, err)
+				}
+
+				continue
+			}
+
+			if err = ctrl.updateSecret(ctx, logger, client, serviceAccount, secret, roles, clusterName, ca, endpoints); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isOwnedBy checks whether the secret was created for the service account.
+func isOwnedBy(secret *corev1.Secret, serviceAccount *unstructured.Unstructured) bool {
+	for _, ref := range secret.OwnerReferences {
+		if ref.UID == serviceAccount.GetUID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// grantedRoles returns the roles requested by the service account, or the reason why the access is denied.
+func (ctrl *TalosServiceAccountController) grantedRoles(serviceAccount *unstructured.Unstructured, allowedRoles role.Set) (role.Set, string) {
+	requestedRoles, _, err := unstructured.NestedStringSlice(serviceAccount.Object, "spec", "roles")
+	if err != nil {
+		return role.Zero, fmt.Sprintf("invalid roles: %s", err)
+	}
+
+	roles, unknownRoles := role.Parse(requestedRoles)
+	if len(unknownRoles) > 0 {
+		return role.Zero, fmt.Sprintf("unknown roles: %s", strings.Join(unknownRoles, ", "))
+	}
+
+	var deniedRoles []string
+
+	for _, r := range roles.Strings() {
+		if !allowedRoles.Includes(role.Role(r)) {
+			deniedRoles = append(deniedRoles, r)
+		}
+	}
+
+	if len(deniedRoles) > 0 {
+		return role.Zero, fmt.Sprintf("roles not allowed: %s", strings.Join(deniedRoles, ", "))
+	}
+
+	if len(roles.Strings()) == 0 {
+		return role.Zero, "no roles requested"
+	}
+
+	return roles, ""
+}
+
+func (ctrl *TalosServiceAccountController) updateStatus(
+	ctx context.Context,
+	client dynamic.ResourceInterface,
+	serviceAccount *unstructured.Unstructured,
+	failureReason string,
+) error {
+	current, _, err := unstructured.NestedString(serviceAccount.Object, "status", "failureReason")
+	if err != nil {
+		return err
+	}
+
+	if current == failureReason {
+		return nil
+	}
+
+	if err = unstructured.SetNestedField(serviceAccount.Object, failureReason, "status", "failureReason"); err != nil {
+		return err
+	}
+
+	if _, err = client.UpdateStatus(ctx, serviceAccount, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating status of %s/%s: %w", serviceAccount.GetNamespace(), serviceAccount.GetName(), err)
+	}
+
+	return nil
+}
+
+func (ctrl *TalosServiceAccountController) updateSecret(
+	ctx context.Context,
+	logger *zap.Logger,
+	client k8sclient.Interface,
+	serviceAccount *unstructured.Unstructured,
+	existing *corev1.Secret,
+	roles role.Set,
+	clusterName string,
+	ca *x509.PEMEncodedCertificateAndKey,
+	endpoints []string,
+) error {
+	namespace, name := serviceAccount.GetNamespace(), serviceAccount.GetName()
+	now := time.Now()
+
+	if existing != nil {
+		expiry, parseErr := time.Parse(time.RFC3339, existing.Annotations[talosServiceAccountExpiryAnnotation])
+		if parseErr == nil && expiry.Sub(now) > constants.KubernetesTalosAPIServiceAccountCertRefresh &&
+			existing.Annotations[talosServiceAccountRolesAnnotation] == strings.Join(roles.Strings(), ",") {
+			return nil
+		}
+	}
+
+	cert, err := generate.NewAdminCertificateAndKey(now, ca, roles, constants.KubernetesTalosAPIServiceAccountCertTTL)
+	if err != nil {
+		return fmt.Errorf("error generating certificate: %w", err)
+	}
+
+	clientConfig, err := clientconfig.NewConfig(fmt.Sprintf("%s@%s", name, clusterName), endpoints, ca.Crt, cert).Bytes()
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				talosServiceAccountExpiryAnnotation: now.Add(constants.KubernetesTalosAPIServiceAccountCertTTL).Format(time.RFC3339),
+				talosServiceAccountRolesAnnotation:  strings.Join(roles.Strings(), ","),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: serviceAccount.GetAPIVersion(),
+					Kind:       serviceAccount.GetKind(),
+					Name:       name,
+					UID:        serviceAccount.GetUID(),
+					Controller: pointer.To(true),
+				},
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			constants.KubernetesTalosAPIServiceAccountSecretKey: clientConfig,
+		},
+	}
+
+	if existing != nil {
+		// resource version makes the update fail if the secret was modified since it was read
+		secret.ResourceVersion = existing.ResourceVersion
+
+		_, err = client.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	} else {
+		_, err = client.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	}
+
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+			logger.Debug("secret was modified concurrently, skipping", zap.String("namespace", namespace), zap.String("name", name))
+
+			return nil
+		}
+
+		return fmt.Errorf("error writing secret %s/%s: %w", namespace, name, err)
+	}
+
+	logger.Info("issued Talos API access secret",
+		zap.String("namespace", namespace), zap.String("name", name), zap.Strings("roles", roles.Strings()))
+
+	return nil
+}
@@ -0,0 +1,295 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/crypto/x509"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	k8sctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
+	clientconfig "github.com/talos-systems/talos/pkg/machinery/client/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+var serviceAccountGVR = schema.GroupVersionResource{
+	Group:    constants.KubernetesTalosAPIServiceAccountGroup,
+	Version:  constants.KubernetesTalosAPIServiceAccountVersion,
+	Resource: constants.KubernetesTalosAPIServiceAccountResource,
+}
+
+func newServiceAccount(name string, roles ...interface{}) *unstructured.Unstructured {
+	serviceAccount := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"roles": roles,
+			},
+		},
+	}
+
+	serviceAccount.SetAPIVersion(constants.KubernetesTalosAPIServiceAccountGroup + "/" + constants.KubernetesTalosAPIServiceAccountVersion)
+	serviceAccount.SetKind(constants.KubernetesTalosAPIServiceAccountKind)
+	serviceAccount.SetNamespace("kube-system")
+	serviceAccount.SetName(name)
+	serviceAccount.SetUID(types.UID(name + "-uid"))
+
+	return serviceAccount
+}
+
+func failureReason(t *testing.T, serviceAccount *unstructured.Unstructured) string {
+	reason, _, err := unstructured.NestedString(serviceAccount.Object, "status", "failureReason")
+	require.NoError(t, err)
+
+	return reason
+}
+
+type serviceAccountEnv struct {
+	client *fake.Clientset
+	dyn    *dynamicfake.FakeDynamicClient
+	access *v1alpha1.KubernetesTalosAPIAccessConfig
+	ca     *x509.PEMEncodedCertificateAndKey
+}
+
+func newServiceAccountEnv(t *testing.T, objects []runtime.Object, serviceAccounts ...runtime.Object) *serviceAccountEnv {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"))
+	require.NoError(t, err)
+
+	return &serviceAccountEnv{
+		client: fake.NewSimpleClientset(objects...),
+		dyn: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+			runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				serviceAccountGVR: constants.KubernetesTalosAPIServiceAccountKind + "List",
+			},
+			serviceAccounts...,
+		),
+		access: &v1alpha1.KubernetesTalosAPIAccessConfig{
+			AccessEnabled:                     true,
+			AccessAllowedRoles:                []string{string(role.Reader), string(role.EtcdBackup)},
+			AccessAllowedKubernetesNamespaces: []string{"kube-system"},
+		},
+		ca: &x509.PEMEncodedCertificateAndKey{
+			Crt: ca.CrtPEM,
+			Key: ca.KeyPEM,
+		},
+	}
+}
+
+func (env *serviceAccountEnv) reconcile(t *testing.T) error {
+	return (&k8sctrl.TalosServiceAccountController{}).ReconcileServiceAccounts(
+		context.Background(), zaptest.NewLogger(t), env.client, env.dyn, env.access, "cluster", env.ca, []string{"10.5.0.2"},
+	)
+}
+
+func (env *serviceAccountEnv) serviceAccount(t *testing.T, name string) *unstructured.Unstructured {
+	serviceAccount, err := env.dyn.Resource(serviceAccountGVR).Namespace("kube-system").Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return serviceAccount
+}
+
+func (env *serviceAccountEnv) secretWrites() int {
+	writes := 0
+
+	for _, action := range env.client.Actions() {
+		if action.GetResource().Resource == "secrets" && (action.GetVerb() == "create" || action.GetVerb() == "update") {
+			writes++
+		}
+	}
+
+	return writes
+}
+
+func TestTalosServiceAccountGrantedRoles(t *testing.T) {
+	t.Parallel()
+
+	allowedRoles := role.MakeSet(role.Reader, role.EtcdBackup)
+
+	for _, tt := range []struct {
+		name           string
+		serviceAccount *unstructured.Unstructured
+
+		expectedRoles  role.Set
+		expectedReason string
+	}{
+		{
+			name:           "allowed",
+			serviceAccount: newServiceAccount("sa", "os:reader", "os:etcd:backup"),
+			expectedRoles:  role.MakeSet(role.Reader, role.EtcdBackup),
+		},
+		{
+			name:           "no roles",
+			serviceAccount: newServiceAccount("sa"),
+			expectedRoles:  role.Zero,
+			expectedReason: "no roles requested",
+		},
+		{
+			name:           "unknown role",
+			serviceAccount: newServiceAccount("sa", "os:reader", "os:superuser"),
+			expectedRoles:  role.Zero,
+			expectedReason: "unknown roles: os:superuser",
+		},
+		{
+			name:           "not allowed",
+			serviceAccount: newServiceAccount("sa", "os:reader", "os:admin"),
+			expectedRoles:  role.Zero,
+			expectedReason: "roles not allowed: os:admin",
+		},
+		{
+			name: "invalid",
+			serviceAccount: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"roles": "os:reader",
+					},
+				},
+			},
+			expectedRoles:  role.Zero,
+			expectedReason: "invalid roles: .spec.roles accessor error: os:reader is of the type string, expected []interface{}",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roles, reason := (&k8sctrl.TalosServiceAccountController{}).GrantedRoles(tt.serviceAccount, allowedRoles)
+
+			assert.Equal(t, tt.expectedRoles.Strings(), roles.Strings())
+			assert.Equal(t, tt.expectedReason, reason)
+		})
+	}
+}
+
+func TestTalosServiceAccountReconcile(t *testing.T) {
+	t.Parallel()
+
+	foreignSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foreign",
+			Namespace: "kube-system",
+		},
+	}
+
+	env := newServiceAccountEnv(t,
+		[]runtime.Object{foreignSecret},
+		newServiceAccount("reader", "os:reader"),
+		newServiceAccount("admin", "os:admin"),
+		newServiceAccount("foreign", "os:reader"),
+	)
+
+	require.NoError(t, env.reconcile(t))
+
+	secret, err := env.client.CoreV1().Secrets("kube-system").Get(context.Background(), "reader", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, types.UID("reader-uid"), secret.OwnerReferences[0].UID)
+	assert.Equal(t, "os:reader", secret.Annotations["talos.dev/roles"])
+
+	talosconfig, err := clientconfig.FromBytes(secret.Data[constants.KubernetesTalosAPIServiceAccountSecretKey])
+	require.NoError(t, err)
+	assert.Equal(t, "reader@cluster", talosconfig.Context)
+	assert.Equal(t, []string{"10.5.0.2"}, talosconfig.Contexts[talosconfig.Context].Endpoints)
+
+	assert.Equal(t, "", failureReason(t, env.serviceAccount(t, "reader")))
+	assert.Equal(t, "roles not allowed: os:admin", failureReason(t, env.serviceAccount(t, "admin")))
+	assert.Equal(t, `secret "foreign" already exists and is not owned by the service account`, failureReason(t, env.serviceAccount(t, "foreign")))
+
+	_, err = env.client.CoreV1().Secrets("kube-system").Get(context.Background(), "admin", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	foreign, err := env.client.CoreV1().Secrets("kube-system").Get(context.Background(), "foreign", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, foreignSecret, foreign)
+
+	// certificate is still valid, so the secret is not reissued
+	require.Equal(t, 1, env.secretWrites())
+	require.NoError(t, env.reconcile(t))
+	assert.Equal(t, 1, env.secretWrites())
+
+	// roles are changed to the disallowed ones, secret is removed
+	reader := env.serviceAccount(t, "reader")
+	require.NoError(t, unstructured.SetNestedStringSlice(reader.Object, []string{"os:admin"}, "spec", "roles"))
+
+	_, err = env.dyn.Resource(serviceAccountGVR).Namespace("kube-system").Update(context.Background(), reader, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, env.reconcile(t))
+
+	assert.Equal(t, "roles not allowed: os:admin", failureReason(t, env.serviceAccount(t, "reader")))
+
+	_, err = env.client.CoreV1().Secrets("kube-system").Get(context.Background(), "reader", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTalosServiceAccountReconcileConflicts(t *testing.T) {
+	t.Parallel()
+
+	// secret owned by the service account issued for the different roles, so it should be updated
+	outdatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "backup",
+			Namespace:       "kube-system",
+			ResourceVersion: "1",
+			Annotations: map[string]string{
+				"talos.dev/roles": "os:reader",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					UID: "backup-uid",
+				},
+			},
+		},
+	}
+
+	env := newServiceAccountEnv(t,
+		[]runtime.Object{outdatedSecret},
+		newServiceAccount("reader", "os:reader"),
+		newServiceAccount("backup", "os:etcd:backup"),
+		newServiceAccount("admin", "os:admin"),
+	)
+
+	// simulate the controller on another node writing the same resources concurrently
+	env.client.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewAlreadyExists(corev1.Resource("secrets"), "reader")
+	})
+
+	env.client.PrependReactor("update", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(corev1.Resource("secrets"), "backup", nil)
+	})
+
+	env.dyn.PrependReactor("update", constants.KubernetesTalosAPIServiceAccountResource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(serviceAccountGVR.GroupResource(), "admin", nil)
+	})
+
+	require.NoError(t, env.reconcile(t))
+
+	_, err := env.client.CoreV1().Secrets("kube-system").Get(context.Background(), "reader", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	backup, err := env.client.CoreV1().Secrets("kube-system").Get(context.Background(), "backup", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, outdatedSecret, backup)
+
+	// status update conflicted, so the service account is skipped until the next reconcile
+	assert.Equal(t, "", failureReason(t, env.serviceAccount(t, "admin")))
+}
@@ -174,8 +174,9 @@ spec:
         hostPath:
           path: /etc/ssl/certs
       - name: kubeconfig
-        configMap:
-          name: kubeconfig-in-cluster
+        hostPath:
+          path: /etc/kubernetes/kube-proxy
+          type: Directory
   updateStrategy:
     rollingUpdate:
       maxUnavailable: 1
@@ -296,17 +297,50 @@ data:
             class error
         }
         prometheus :9153
+        {{- if .CoreDNSHosts }}
+
+        hosts {
+            {{- range $host := .CoreDNSHosts }}
+            {{ $host.IP }} {{ join $host.Hostnames " " }}
+            {{- end }}
+            fallthrough
+        }
+        {{- end }}
 
         kubernetes {{ .ClusterDomain }} in-addr.arpa ip6.arpa {
             pods insecure
             fallthrough in-addr.arpa ip6.arpa
         }
-        forward . /etc/resolv.conf
-        cache 30
+        forward . {{ if .CoreDNSUpstreams }}{{ join .CoreDNSUpstreams " " }}{{ else }}/etc/resolv.conf{{ end }}
+        cache {{ with .CoreDNSCacheTTL }}{{ . }}{{ else }}30{{ end }}
+        {{- if or .CoreDNSCacheSuccess .CoreDNSCacheDenial }} {
+            {{- with .CoreDNSCacheSuccess }}
+            success {{ . }}
+            {{- end }}
+            {{- with .CoreDNSCacheDenial }}
+            denial {{ . }}
+            {{- end }}
+        }
+        {{- end }}
+        loop
+        reload
+        loadbalance
+    }
+    {{- range $stubDomain := .CoreDNSStubDomains }}
+
+    {{ $stubDomain.Domain }}:53 {
+        errors
+        forward . {{ join $stubDomain.Servers " " }}
+        cache {{ with $.CoreDNSCacheTTL }}{{ . }}{{ else }}30{{ end }}
         loop
         reload
         loadbalance
     }
+    {{- end }}
+    {{- range $block := .CoreDNSExtraServerBlocks }}
+
+{{ indent 4 $block }}
+    {{- end }}
 ---
 apiVersion: apps/v1
 kind: Deployment
@@ -459,6 +493,186 @@ spec:
       protocol: TCP
 `)
 
+// nodeLocalDNSTemplate deploys NodeLocal DNSCache.
+//
+// Caching agent listens on the link-local IP only (kubelet is configured to use it as cluster DNS),
+// and forwards all queries to CoreDNS via kube-dns-upstream service, so that CoreDNS configuration is applied.
+// `__PILLAR__` placeholders are replaced by the caching agent itself.
+var nodeLocalDNSTemplate = []byte(`apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: node-local-dns
+  namespace: kube-system
+  labels:
+    kubernetes.io/cluster-service: "true"
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: kube-dns-upstream
+  namespace: kube-system
+  labels:
+    k8s-app: kube-dns
+    kubernetes.io/cluster-service: "true"
+    kubernetes.io/name: "KubeDNSUpstream"
+spec:
+  ports:
+    - name: dns
+      port: 53
+      protocol: UDP
+      targetPort: 53
+    - name: dns-tcp
+      port: 53
+      protocol: TCP
+      targetPort: 53
+  selector:
+    k8s-app: kube-dns
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: node-local-dns
+  namespace: kube-system
+data:
+  Corefile: |
+    {{ .ClusterDomain }}:53 {
+        errors
+        cache {
+            success 9984 30
+            denial 9984 5
+        }
+        reload
+        loop
+        bind {{ .NodeLocalDNSIP }}
+        forward . __PILLAR__CLUSTER__DNS__ {
+            force_tcp
+        }
+        prometheus :9253
+        health {{ .NodeLocalDNSIP }}:8080
+    }
+    in-addr.arpa:53 {
+        errors
+        cache 30
+        reload
+        loop
+        bind {{ .NodeLocalDNSIP }}
+        forward . __PILLAR__CLUSTER__DNS__ {
+            force_tcp
+        }
+        prometheus :9253
+    }
+    ip6.arpa:53 {
+        errors
+        cache 30
+        reload
+        loop
+        bind {{ .NodeLocalDNSIP }}
+        forward . __PILLAR__CLUSTER__DNS__ {
+            force_tcp
+        }
+        prometheus :9253
+    }
+    .:53 {
+        errors
+        cache 30
+        reload
+        loop
+        bind {{ .NodeLocalDNSIP }}
+        forward . __PILLAR__CLUSTER__DNS__
+        prometheus :9253
+    }
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: node-local-dns
+  namespace: kube-system
+  labels:
+    k8s-app: node-local-dns
+spec:
+  selector:
+    matchLabels:
+      k8s-app: node-local-dns
+  template:
+    metadata:
+      labels:
+        k8s-app: node-local-dns
+      annotations:
+        prometheus.io/port: "9253"
+        prometheus.io/scrape: "true"
+    spec:
+      priorityClassName: system-node-critical
+      serviceAccountName: node-local-dns
+      hostNetwork: true
+      dnsPolicy: Default # Don't use cluster DNS.
+      tolerations:
+      - key: CriticalAddonsOnly
+        operator: Exists
+      - effect: NoSchedule
+        operator: Exists
+      - effect: NoExecute
+        operator: Exists
+      containers:
+      - name: node-cache
+        image: {{ .NodeLocalDNSImage }}
+        resources:
+          requests:
+            cpu: 25m
+            memory: 5Mi
+        args:
+          - -localip={{ .NodeLocalDNSIP }}
+          - -conf=/etc/Corefile
+          - -upstreamsvc=kube-dns-upstream
+        securityContext:
+          capabilities:
+            add:
+            - NET_ADMIN
+        ports:
+        - containerPort: 53
+          name: dns
+          protocol: UDP
+        - containerPort: 53
+          name: dns-tcp
+          protocol: TCP
+        - containerPort: 9253
+          name: metrics
+          protocol: TCP
+        livenessProbe:
+          httpGet:
+            host: {{ .NodeLocalDNSIP }}
+            path: /health
+            port: 8080
+          initialDelaySeconds: 60
+          timeoutSeconds: 5
+        volumeMounts:
+        - mountPath: /run/xtables.lock
+          name: xtables-lock
+          readOnly: false
+        - name: config-volume
+          mountPath: /etc/coredns
+        - name: kube-dns-config
+          mountPath: /etc/kube-dns
+      volumes:
+      - name: xtables-lock
+        hostPath:
+          path: /run/xtables.lock
+          type: FileOrCreate
+      - name: kube-dns-config
+        configMap:
+          name: kube-dns
+          optional: true
+      - name: config-volume
+        configMap:
+          name: node-local-dns
+          items:
+            - key: Corefile
+              path: Corefile.base
+  updateStrategy:
+    rollingUpdate:
+      maxUnavailable: 10%
+    type: RollingUpdate
+`)
+
 var flannelTemplate = []byte(`apiVersion: rbac.authorization.k8s.io/v1
 kind: ClusterRole
 metadata:
@@ -693,3 +907,380 @@ spec:
   - min: 1
     max: 65536
 `)
+
+var cloudControllerManagerRBACTemplate = []byte(`apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: cloud-controller-manager
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: system:cloud-controller-manager
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
+  - update
+- apiGroups:
+  - ""
+  resources:
+  - nodes
+  verbs:
+  - "*"
+- apiGroups:
+  - ""
+  resources:
+  - nodes/status
+  verbs:
+  - patch
+- apiGroups:
+  - ""
+  resources:
+  - services
+  - services/status
+  verbs:
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - ""
+  resources:
+  - serviceaccounts
+  verbs:
+  - create
+  - get
+- apiGroups:
+  - ""
+  resources:
+  - serviceaccounts/token
+  verbs:
+  - create
+- apiGroups:
+  - ""
+  resources:
+  - persistentvolumes
+  verbs:
+  - get
+  - list
+  - update
+  - watch
+- apiGroups:
+  - ""
+  resources:
+  - endpoints
+  verbs:
+  - create
+  - get
+  - list
+  - update
+  - watch
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+  - list
+  - watch
+- apiGroups:
+  - coordination.k8s.io
+  resources:
+  - leases
+  verbs:
+  - create
+  - get
+  - list
+  - update
+  - watch
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: system:cloud-controller-manager
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: system:cloud-controller-manager
+subjects:
+- kind: ServiceAccount
+  name: cloud-controller-manager
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: cloud-controller-manager:apiserver-authentication-reader
+  namespace: kube-system
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: extension-apiserver-authentication-reader
+subjects:
+- kind: ServiceAccount
+  name: cloud-controller-manager
+  namespace: kube-system
+{{- if .CloudProviderConfig }}
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: cloud-controller-manager-config
+  namespace: kube-system
+type: Opaque
+stringData:
+  cloud.conf: {{ .CloudProviderConfig | json }}
+{{- end }}
+`)
+
+// cloudControllerManagerDaemonSetTemplate is shared by all built-in cloud controller managers.
+//
+// Cloud controller manager runs on control plane nodes with host networking, and it uses
+// kubeconfig-in-cluster to reach the API server, as the node might not be initialized yet.
+var cloudControllerManagerDaemonSetTemplate = []byte(`apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: cloud-controller-manager
+  namespace: kube-system
+  labels:
+    k8s-app: cloud-controller-manager
+spec:
+  selector:
+    matchLabels:
+      k8s-app: cloud-controller-manager
+  updateStrategy:
+    type: RollingUpdate
+  template:
+    metadata:
+      labels:
+        k8s-app: cloud-controller-manager
+    spec:
+      nodeSelector:
+        node-role.kubernetes.io/control-plane: ""
+      tolerations:
+      - key: node.cloudprovider.kubernetes.io/uninitialized
+        value: "true"
+        effect: NoSchedule
+      - key: node-role.kubernetes.io/master
+        effect: NoSchedule
+      - key: node-role.kubernetes.io/control-plane
+        effect: NoSchedule
+      - key: node.kubernetes.io/not-ready
+        effect: NoSchedule
+      serviceAccountName: cloud-controller-manager
+      priorityClassName: system-cluster-critical
+      hostNetwork: true
+      containers:
+      - name: cloud-controller-manager
+        image: {{ .CloudProviderImage }}
+        {{- if eq .CloudProviderName "hcloud" }}
+        command:
+        - /bin/hcloud-cloud-controller-manager
+        {{- else if eq .CloudProviderName "openstack" }}
+        command:
+        - /bin/openstack-cloud-controller-manager
+        {{- end }}
+        args:
+        {{- if eq .CloudProviderName "gcp" }}
+        - --cloud-provider=gce
+        {{- else }}
+        - --cloud-provider={{ .CloudProviderName }}
+        {{- end }}
+        - --cluster-name={{ .ClusterName }}
+        - --kubeconfig=/etc/kubernetes/kubeconfig
+        - --authentication-kubeconfig=/etc/kubernetes/kubeconfig
+        - --authorization-kubeconfig=/etc/kubernetes/kubeconfig
+        - --bind-address=127.0.0.1
+        - --leader-elect=true
+        - --allocate-node-cidrs=false
+        - --configure-cloud-routes=false
+        {{- if eq .CloudProviderName "azure" }}
+        - --controllers=*,-cloud-node
+        {{- end }}
+        {{- if eq .CloudProviderName "hcloud" }}
+        - --allow-untagged-cloud
+        {{- end }}
+        {{- if .CloudProviderConfig }}
+        - --cloud-config=/etc/cloud-config/cloud.conf
+        {{- end }}
+        - --v=2
+        {{- if eq .CloudProviderName "hcloud" }}
+        env:
+        - name: NODE_NAME
+          valueFrom:
+            fieldRef:
+              fieldPath: spec.nodeName
+        - name: HCLOUD_TOKEN
+          valueFrom:
+            secretKeyRef:
+              name: hcloud
+              key: token
+        {{- end }}
+        resources:
+          requests:
+            cpu: 100m
+            memory: 128Mi
+        volumeMounts:
+        - name: kubeconfig
+          mountPath: /etc/kubernetes
+          readOnly: true
+        {{- if .CloudProviderConfig }}
+        - name: cloud-config
+          mountPath: /etc/cloud-config
+          readOnly: true
+        {{- end }}
+        - name: ssl-certs-host
+          mountPath: /etc/ssl/certs
+          readOnly: true
+      volumes:
+      - name: kubeconfig
+        configMap:
+          name: kubeconfig-in-cluster
+      {{- if .CloudProviderConfig }}
+      - name: cloud-config
+        secret:
+          secretName: cloud-controller-manager-config
+      {{- end }}
+      - name: ssl-certs-host
+        hostPath:
+          path: /etc/ssl/certs
+{{- if .CloudProviderNodeManagerImage }}
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: cloud-node-manager
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: cloud-node-manager
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - nodes
+  verbs:
+  - get
+  - list
+  - watch
+  - update
+  - patch
+- apiGroups:
+  - ""
+  resources:
+  - nodes/status
+  verbs:
+  - patch
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: cloud-node-manager
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: cloud-node-manager
+subjects:
+- kind: ServiceAccount
+  name: cloud-node-manager
+  namespace: kube-system
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: cloud-node-manager
+  namespace: kube-system
+  labels:
+    k8s-app: cloud-node-manager
+spec:
+  selector:
+    matchLabels:
+      k8s-app: cloud-node-manager
+  updateStrategy:
+    type: RollingUpdate
+  template:
+    metadata:
+      labels:
+        k8s-app: cloud-node-manager
+    spec:
+      tolerations:
+      - effect: NoSchedule
+        operator: Exists
+      - effect: NoExecute
+        operator: Exists
+      serviceAccountName: cloud-node-manager
+      priorityClassName: system-node-critical
+      hostNetwork: true
+      containers:
+      - name: cloud-node-manager
+        image: {{ .CloudProviderNodeManagerImage }}
+        command:
+        - cloud-node-manager
+        - --node-name=$(NODE_NAME)
+        - --kubeconfig=/etc/kubernetes/kubeconfig
+        - --v=2
+        env:
+        - name: NODE_NAME
+          valueFrom:
+            fieldRef:
+              fieldPath: spec.nodeName
+        resources:
+          requests:
+            cpu: 50m
+            memory: 50Mi
+        volumeMounts:
+        - name: kubeconfig
+          mountPath: /etc/kubernetes
+          readOnly: true
+      volumes:
+      - name: kubeconfig
+        configMap:
+          name: kubeconfig-in-cluster
+{{- end }}
+`)
+
+var talosServiceAccountCRDTemplate = []byte(`apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: {{ .TalosServiceAccount.ResourcePlural }}.{{ .TalosServiceAccount.Group }}
+spec:
+  group: {{ .TalosServiceAccount.Group }}
+  versions:
+    - name: {{ .TalosServiceAccount.Version }}
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              properties:
+                roles:
+                  type: array
+                  items:
+                    type: string
+            status:
+              type: object
+              properties:
+                failureReason:
+                  type: string
+      subresources:
+        status: {}
+  scope: Namespaced
+  names:
+    plural: {{ .TalosServiceAccount.ResourcePlural }}
+    singular: {{ .TalosServiceAccount.ResourceSingular }}
+    kind: {{ .TalosServiceAccount.Kind }}
+    shortNames:
+      - tsa
+`)
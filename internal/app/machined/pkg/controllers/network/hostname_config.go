@@ -14,16 +14,23 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/go-pointer"
 	"github.com/talos-systems/go-procfs/procfs"
+	"github.com/talos-systems/go-smbios/smbios"
 	"go.uber.org/zap"
 
+	hardwarectrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/hardware"
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // HostnameConfigController manages network.HostnameSpec based on machine configuration, kernel cmdline.
 type HostnameConfigController struct {
 	Cmdline *procfs.Cmdline
+
+	// SMBIOS is used to render hostname template, it is read from the system if not set.
+	SMBIOS *smbios.SMBIOS
 }
 
 // Name implements controller.Controller interface.
@@ -46,6 +53,17 @@ func (ctrl *HostnameConfigController) Inputs() []controller.Input {
 			ID:        pointer.To(network.NodeAddressDefaultID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtimeres.NamespaceName,
+			Type:      runtimeres.PlatformMetadataType,
+			ID:        pointer.To(runtimeres.PlatformMetadataID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -107,7 +125,12 @@ func (ctrl *HostnameConfigController) Run(ctx context.Context, r controller.Runt
 
 		// parse machine configuration for specs
 		if cfgProvider != nil {
-			configHostname := ctrl.parseMachineConfiguration(logger, cfgProvider)
+			var configHostname network.HostnameSpecSpec
+
+			configHostname, err = ctrl.parseMachineConfiguration(ctx, r, logger, cfgProvider)
+			if err != nil {
+				return err
+			}
 
 			if configHostname.Hostname != "" {
 				specs = append(specs, configHostname)
@@ -210,20 +233,96 @@ func (ctrl *HostnameConfigController) parseCmdline(logger *zap.Logger) (spec net
 	return spec
 }
 
-func (ctrl *HostnameConfigController) parseMachineConfiguration(logger *zap.Logger, cfgProvider talosconfig.Provider) (spec network.HostnameSpecSpec) {
+func (ctrl *HostnameConfigController) parseMachineConfiguration(
+	ctx context.Context,
+	r controller.Runtime,
+	logger *zap.Logger,
+	cfgProvider talosconfig.Provider,
+) (spec network.HostnameSpecSpec, err error) {
 	hostname := cfgProvider.Machine().Network().Hostname()
 
+	if hostnameTemplate := cfgProvider.Machine().Network().HostnameTemplate(); hostname == "" && hostnameTemplate != "" {
+		var data *nethelpers.HostnameTemplateData
+
+		data, err = ctrl.hostnameTemplateData(ctx, r, logger)
+		if err != nil {
+			return spec, err
+		}
+
+		hostname, err = nethelpers.RenderHostnameTemplate(hostnameTemplate, data)
+		if err != nil {
+			logger.Warn("ignoring hostname template error", zap.Error(err))
+
+			return spec, nil
+		}
+	}
+
 	if hostname == "" {
-		return
+		return spec, nil
 	}
 
-	if err := spec.ParseFQDN(hostname); err != nil {
+	if err = spec.ParseFQDN(hostname); err != nil {
 		logger.Warn("ignoring error", zap.Error(err))
 
-		return network.HostnameSpecSpec{}
+		return network.HostnameSpecSpec{}, nil
 	}
 
 	spec.ConfigLayer = network.ConfigMachineConfiguration
 
-	return spec
+	return spec, nil
+}
+
+// hostnameTemplateData collects the data for the hostname template.
+//
+// Hostname template uses only the data which doesn't change when the node is re-addressed.
+func (ctrl *HostnameConfigController) hostnameTemplateData(ctx context.Context, r controller.Runtime, logger *zap.Logger) (*nethelpers.HostnameTemplateData, error) {
+	if ctrl.SMBIOS == nil {
+		s, err := hardwarectrl.GetSMBIOSInfo()
+		if err != nil {
+			// SMBIOS is not available on some platforms, SMBIOS fields are empty then
+			logger.Warn("error reading SMBIOS", zap.Error(err))
+
+			s = &smbios.SMBIOS{}
+		}
+
+		ctrl.SMBIOS = s
+	}
+
+	data := &nethelpers.HostnameTemplateData{
+		SMBIOS: nethelpers.HostnameTemplateSMBIOS{
+			UUID:         ctrl.SMBIOS.SystemInformation.UUID,
+			SerialNumber: ctrl.SMBIOS.SystemInformation.SerialNumber,
+			Manufacturer: ctrl.SMBIOS.SystemInformation.Manufacturer,
+			ProductName:  ctrl.SMBIOS.SystemInformation.ProductName,
+			SKUNumber:    ctrl.SMBIOS.SystemInformation.SKUNumber,
+		},
+		Hardware: nethelpers.HostnameTemplateHardware{
+			MACs: map[string]nethelpers.HardwareAddr{},
+		},
+	}
+
+	links, err := r.List(ctx, resource.NewMetadata(network.NamespaceName, network.LinkStatusType, "", resource.VersionUndefined))
+	if err != nil {
+		return nil, fmt.Errorf("error listing links: %w", err)
+	}
+
+	for _, link := range links.Items {
+		data.Hardware.MACs[link.Metadata().ID()] = link.(*network.LinkStatus).TypedSpec().HardwareAddr
+	}
+
+	platformMetadata, err := r.Get(ctx, resource.NewMetadata(runtimeres.NamespaceName, runtimeres.PlatformMetadataType, runtimeres.PlatformMetadataID, resource.VersionUndefined))
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return nil, fmt.Errorf("error getting platform metadata: %w", err)
+		}
+	} else {
+		spec := platformMetadata.(*runtimeres.PlatformMetadata).TypedSpec()
+
+		data.Platform = nethelpers.HostnameTemplatePlatform{
+			Name:       spec.Platform,
+			InstanceID: spec.InstanceID,
+		}
+	}
+
+	return data, nil
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/url"
 	"strings"
 	"sync"
@@ -23,13 +24,16 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/talos-systems/go-procfs/procfs"
 	"github.com/talos-systems/go-retry/retry"
+	"github.com/talos-systems/go-smbios/smbios"
 	"inet.af/netaddr"
 
 	netctrl "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/talos-systems/talos/pkg/logging"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 type HostnameConfigSuite struct {
@@ -244,6 +248,131 @@ func (suite *HostnameConfigSuite) TestMachineConfiguration() {
 	)
 }
 
+func (suite *HostnameConfigSuite) TestMachineConfigurationTemplate() {
+	suite.Require().NoError(
+		suite.runtime.RegisterController(
+			&netctrl.HostnameConfigController{
+				SMBIOS: &smbios.SMBIOS{
+					SystemInformation: smbios.SystemInformation{
+						UUID:         "4c4c4544-0039-3010-8048-b7c04f384432",
+						SerialNumber: "ABC123",
+					},
+				},
+			},
+		),
+	)
+
+	suite.startRuntime()
+
+	mac, err := net.ParseMAC("68:05:ca:b8:f1:f7")
+	suite.Require().NoError(err)
+
+	link := network.NewLinkStatus(network.NamespaceName, "eth0")
+	link.TypedSpec().HardwareAddr = nethelpers.HardwareAddr(mac)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, link))
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineNetwork: &v1alpha1.NetworkConfig{
+					NetworkHostnameTemplate: "talos-{{ hash .SMBIOS.UUID }}",
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+			},
+		},
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertHostnames(
+					[]string{
+						"configuration/hostname",
+					}, func(r *network.HostnameSpec) error {
+						suite.Assert().Equal("talos-08dd0d5bdd", r.TypedSpec().Hostname)
+						suite.Assert().Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	_, err = suite.state.UpdateWithConflicts(
+		suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
+			r.(*config.MachineConfig).Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkHostnameTemplate = `node-{{ lower .SMBIOS.SerialNumber }}-{{ .Hardware.MAC "eth0" }}`
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertHostnames(
+					[]string{
+						"configuration/hostname",
+					}, func(r *network.HostnameSpec) error {
+						if r.TypedSpec().Hostname != "node-abc123-6805cab8f1f7" {
+							return fmt.Errorf("unexpected hostname %q", r.TypedSpec().Hostname)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+
+	platformMetadata := runtimeres.NewPlatformMetadata(runtimeres.NamespaceName, runtimeres.PlatformMetadataID)
+	platformMetadata.TypedSpec().Platform = "upcloud"
+	platformMetadata.TypedSpec().InstanceID = "00123456-1111-2222-3333-123456789012"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, platformMetadata))
+
+	_, err = suite.state.UpdateWithConflicts(
+		suite.ctx, cfg.Metadata(), func(r resource.Resource) error {
+			r.(*config.MachineConfig).Config().(*v1alpha1.Config).MachineConfig.MachineNetwork.NetworkHostnameTemplate = `{{ .Platform.Name }}-{{ hash .Platform.InstanceID }}`
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	suite.Assert().NoError(
+		retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertHostnames(
+					[]string{
+						"configuration/hostname",
+					}, func(r *network.HostnameSpec) error {
+						if r.TypedSpec().Hostname != "upcloud-7eb3b17942" {
+							return fmt.Errorf("unexpected hostname %q", r.TypedSpec().Hostname)
+						}
+
+						return nil
+					},
+				)
+			},
+		),
+	)
+}
+
 func (suite *HostnameConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
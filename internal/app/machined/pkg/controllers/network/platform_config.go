@@ -34,6 +34,8 @@ import (
 const externalLink = "external"
 
 // PlatformConfigController manages updates hostnames and addressstatuses based on platform information.
+//
+// Platform metadata (e.g. instance ID) is published as runtime.PlatformMetadata resource.
 type PlatformConfigController struct {
 	V1alpha1Platform v1alpha1runtime.Platform
 	StatePath        string
@@ -91,6 +93,10 @@ func (ctrl *PlatformConfigController) Outputs() []controller.Output {
 			Type: network.OperatorSpecType,
 			Kind: controller.OutputShared,
 		},
+		{
+			Type: runtimeres.PlatformMetadataType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -195,8 +201,18 @@ func (ctrl *PlatformConfigController) Run(ctx context.Context, r controller.Runt
 	}
 }
 
-//nolint:dupl,gocyclo
+//nolint:dupl,gocyclo,cyclop
 func (ctrl *PlatformConfigController) apply(ctx context.Context, r controller.Runtime, networkConfig *v1alpha1runtime.PlatformNetworkConfig) error {
+	if networkConfig.Metadata != nil {
+		if err := r.Modify(ctx, runtimeres.NewPlatformMetadata(runtimeres.NamespaceName, runtimeres.PlatformMetadataID), func(r resource.Resource) error {
+			*r.(*runtimeres.PlatformMetadata).TypedSpec() = *networkConfig.Metadata
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying platform metadata: %w", err)
+		}
+	}
+
 	// handle all network specs in a loop as all specs can be handled in a similar way
 	for _, specType := range []struct {
 		length           int
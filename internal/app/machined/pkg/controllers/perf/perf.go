@@ -6,20 +6,29 @@ package perf
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/prometheus/procfs"
+	"github.com/prometheus/procfs/blockdevice"
 	"go.uber.org/zap"
 
 	perfadapter "github.com/talos-systems/talos/internal/app/machined/pkg/adapters/perf"
 	"github.com/talos-systems/talos/pkg/machinery/resources/perf"
 )
 
-const updateInterval = time.Second * 30
+const (
+	updateInterval = time.Second * 30
 
-// StatsController manages v1alpha1.Stats which is the current snaphot of the machine CPU and Memory consumption.
+	sysBlockPath = "/sys/block"
+)
+
+// StatsController manages v1alpha1.Stats which is the current snaphot of the machine CPU and Memory consumption,
+// disk and network I/O and pressure stall information.
 type StatsController struct{}
 
 // Name implements controller.StatsController interface.
@@ -43,6 +52,18 @@ func (ctrl *StatsController) Outputs() []controller.Output {
 			Type: perf.MemoryType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: perf.DiskIOType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: perf.NetIOType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: perf.PressureType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
@@ -54,6 +75,7 @@ func (ctrl *StatsController) Run(ctx context.Context, r controller.Runtime, logg
 
 	var (
 		fs  procfs.FS
+		bfs blockdevice.FS
 		err error
 	)
 
@@ -62,6 +84,14 @@ func (ctrl *StatsController) Run(ctx context.Context, r controller.Runtime, logg
 		return err
 	}
 
+	bfs, err = blockdevice.NewDefaultFS()
+	if err != nil {
+		return err
+	}
+
+	// pressure stall information might be disabled in the kernel
+	pressureSupported := true
+
 	for {
 		select {
 		case <-r.EventCh():
@@ -77,6 +107,22 @@ func (ctrl *StatsController) Run(ctx context.Context, r controller.Runtime, logg
 		if err := ctrl.updateCPU(ctx, r, &fs); err != nil {
 			return err
 		}
+
+		if err := ctrl.updateDiskIO(ctx, r, &bfs); err != nil {
+			return err
+		}
+
+		if err := ctrl.updateNetIO(ctx, r, &fs); err != nil {
+			return err
+		}
+
+		if pressureSupported {
+			if err := ctrl.updatePressure(ctx, r, &fs); err != nil {
+				logger.Warn("pressure stall information is not available", zap.Error(err))
+
+				pressureSupported = false
+			}
+		}
 	}
 }
 
@@ -109,3 +155,90 @@ func (ctrl *StatsController) updateMemory(ctx context.Context, r controller.Runt
 		return nil
 	})
 }
+
+func (ctrl *StatsController) updateDiskIO(ctx context.Context, r controller.Runtime, bfs *blockdevice.FS) error {
+	diskIO := perf.NewDiskIO()
+
+	stats, err := bfs.ProcDiskstats()
+	if err != nil {
+		return err
+	}
+
+	disks, err := physicalDisks(sysBlockPath)
+	if err != nil {
+		return err
+	}
+
+	return r.Modify(ctx, diskIO, func(r resource.Resource) error {
+		perfadapter.DiskIO(r.(*perf.DiskIO)).Update(stats, disks)
+
+		return nil
+	})
+}
+
+// physicalDisks returns the names of the whole physical disks.
+//
+// Only whole disks are listed in /sys/block, and virtual devices (dm, md, loop, etc.) link to /sys/devices/virtual.
+func physicalDisks(path string) (map[string]struct{}, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	disks := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		target, err := os.Readlink(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.Contains(target, "/devices/virtual/") {
+			continue
+		}
+
+		disks[entry.Name()] = struct{}{}
+	}
+
+	return disks, nil
+}
+
+func (ctrl *StatsController) updateNetIO(ctx context.Context, r controller.Runtime, fs *procfs.FS) error {
+	netIO := perf.NewNetIO()
+
+	netDev, err := fs.NetDev()
+	if err != nil {
+		return err
+	}
+
+	return r.Modify(ctx, netIO, func(r resource.Resource) error {
+		perfadapter.NetIO(r.(*perf.NetIO)).Update(netDev)
+
+		return nil
+	})
+}
+
+func (ctrl *StatsController) updatePressure(ctx context.Context, r controller.Runtime, fs *procfs.FS) error {
+	pressure := perf.NewPressure()
+
+	cpu, err := fs.PSIStatsForResource("cpu")
+	if err != nil {
+		return err
+	}
+
+	memory, err := fs.PSIStatsForResource("memory")
+	if err != nil {
+		return err
+	}
+
+	io, err := fs.PSIStatsForResource("io")
+	if err != nil {
+		return err
+	}
+
+	return r.Modify(ctx, pressure, func(r resource.Resource) error {
+		perfadapter.Pressure(r.(*perf.Pressure)).Update(&cpu, &memory, &io)
+
+		return nil
+	})
+}
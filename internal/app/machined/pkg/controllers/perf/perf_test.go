@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -17,6 +19,8 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/talos-systems/go-retry/retry"
 
@@ -103,13 +107,52 @@ func (suite *PerfSuite) TestReconcile() {
 					return err
 				}
 
+				netIO, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						perfresource.NamespaceName,
+						perfresource.NetIOType,
+						perfresource.NetIOID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				_, err = suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						perfresource.NamespaceName,
+						perfresource.DiskIOType,
+						perfresource.DiskIOID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
 				cpuSpec := cpu.(*perfresource.CPU).TypedSpec()
 				memSpec := mem.(*perfresource.Memory).TypedSpec()
+				netIOSpec := netIO.(*perfresource.NetIO).TypedSpec()
 
 				if len(cpuSpec.CPU) == 0 || memSpec.MemTotal == 0 {
 					return retry.ExpectedError(fmt.Errorf("cpu spec does not contain any CPU or Total memory is zero"))
 				}
 
+				if len(netIOSpec.Devices) == 0 {
+					return retry.ExpectedError(fmt.Errorf("net I/O spec does not contain any devices"))
+				}
+
 				return nil
 			},
 		),
@@ -127,3 +170,24 @@ func (suite *PerfSuite) TearDownTest() {
 func TestPerfSuite(t *testing.T) {
 	suite.Run(t, new(PerfSuite))
 }
+
+func TestPhysicalDisks(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, target := range map[string]string{
+		"sda":   "../devices/pci0000:00/0000:00:1f.2/ata1/host0/target0:0:0/0:0:0:0/block/sda",
+		"vda":   "../devices/pci0000:00/0000:00:02.0/virtio1/block/vda",
+		"dm-0":  "../devices/virtual/block/dm-0",
+		"md127": "../devices/virtual/block/md127",
+		"loop0": "../devices/virtual/block/loop0",
+	} {
+		require.NoError(t, os.Symlink(target, filepath.Join(dir, name)))
+	}
+
+	disks, err := perf.PhysicalDisks(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]struct{}{"sda": {}, "vda": {}}, disks)
+}
@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+)
+
+var ProbeSignature = probeSignature
+
+func (ctrl *VolumeGroupController) ResolvePhysicalVolumes(machineConfig talosconfig.MachineConfig, vg talosconfig.VolumeGroup, pvGroups map[string]string,
+	listDisks func() ([]*disk.Disk, error), blank func(device string) (bool, error),
+) ([]string, error) {
+	return ctrl.resolvePhysicalVolumes(machineConfig, vg, pvGroups, listDisks, blank)
+}
@@ -67,13 +67,14 @@ func (ctrl *KernelParamConfigController) Run(ctx context.Context, r controller.R
 
 			touchedIDs := make(map[resource.ID]struct{})
 
-			setKernelParam := func(kind, key, value string) error {
-				item := runtime.NewKernelParamSpec(runtime.NamespaceName, strings.Join([]string{kind, key}, "."))
+			setKernelParam := func(id, value string, ignoreErrors bool) error {
+				item := runtime.NewKernelParamSpec(runtime.NamespaceName, id)
 
 				touchedIDs[item.Metadata().ID()] = struct{}{}
 
 				return r.Modify(ctx, item, func(res resource.Resource) error {
 					res.(*runtime.KernelParamSpec).TypedSpec().Value = value
+					res.(*runtime.KernelParamSpec).TypedSpec().IgnoreErrors = ignoreErrors
 
 					return nil
 				})
@@ -81,14 +82,32 @@ func (ctrl *KernelParamConfigController) Run(ctx context.Context, r controller.R
 
 			if cfg != nil {
 				c, _ := cfg.(*config.MachineConfig) //nolint:errcheck
+
+				// profiles are applied first, so that explicit sysctls override profile values;
+				// profile sysctls missing in the running kernel are reported as unsupported
+				for _, profile := range c.Config().Machine().SysctlProfiles() {
+					params, ok := kernel.ProfileParams(profile)
+					if !ok {
+						logger.Warn("unknown sysctl profile", zap.String("profile", profile))
+
+						continue
+					}
+
+					for _, param := range params {
+						if err = setKernelParam(param.Key, param.Value, true); err != nil {
+							return err
+						}
+					}
+				}
+
 				for key, value := range c.Config().Machine().Sysctls() {
-					if err = setKernelParam(kernel.Sysctl, key, value); err != nil {
+					if err = setKernelParam(strings.Join([]string{kernel.Sysctl, key}, "."), value, false); err != nil {
 						return err
 					}
 				}
 
 				for key, value := range c.Config().Machine().Sysfs() {
-					if err = setKernelParam(kernel.Sysfs, key, value); err != nil {
+					if err = setKernelParam(strings.Join([]string{kernel.Sysfs, key}, "."), value, false); err != nil {
 						return err
 					}
 				}
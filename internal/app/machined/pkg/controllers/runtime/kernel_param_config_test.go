@@ -110,6 +110,48 @@ func (suite *KernelParamConfigSuite) TestReconcileConfig() {
 	))
 }
 
+func (suite *KernelParamConfigSuite) TestReconcileProfiles() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.KernelParamConfigController{}))
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineSysctlProfiles: []string{"etcd"},
+			MachineSysctls: map[string]string{
+				"vm.dirty_ratio": "20",
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	for id, expected := range map[string]runtimeresource.KernelParamSpecSpec{
+		// profile value
+		"proc.sys.vm.dirty_background_ratio": {
+			Value:        "5",
+			IgnoreErrors: true,
+		},
+		// explicit sysctl overrides the profile value
+		"proc.sys.vm.dirty_ratio": {
+			Value: "20",
+		},
+	} {
+		expected := expected
+
+		suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(
+				resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelParamSpecType, id, resource.VersionUndefined),
+				func(res resource.Resource) bool {
+					return *res.(*runtimeresource.KernelParamSpec).TypedSpec() == expected
+				},
+			),
+		))
+	}
+}
+
 func TestKernelParamConfigSuite(t *testing.T) {
 	suite.Run(t, new(KernelParamConfigSuite))
 }
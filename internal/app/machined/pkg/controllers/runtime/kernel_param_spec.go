@@ -7,6 +7,7 @@ package runtime
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -96,6 +97,12 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 
 			list = append(list, defaults.Items...)
 
+			defaultValues := make(map[string]string, len(defaults.Items))
+
+			for _, item := range defaults.Items {
+				defaultValues[item.Metadata().ID()] = item.(runtime.KernelParam).TypedSpec().Value
+			}
+
 			touchedIDs := map[string]string{}
 
 			var errs *multierror.Error
@@ -105,14 +112,24 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 				id := item.Metadata().ID()
 
 				if value, duplicate := touchedIDs[id]; i >= configsCounts && duplicate {
-					if _, ok := ksppParams[id]; ok {
+					if _, ok := ksppParams[id]; ok && value != spec.Value {
 						logger.Warn("overriding KSPP enforced parameter, this is not recommended", zap.String("key", id), zap.String("value", value))
 					}
 
 					continue
 				}
 
-				if err = ctrl.updateKernelParam(ctx, r, id, spec.Value); err != nil {
+				var conflict string
+
+				if def, ok := defaultValues[id]; ok && i < configsCounts && def != spec.Value {
+					if _, kspp := ksppParams[id]; kspp {
+						conflict = fmt.Sprintf("overrides KSPP required value %q", def)
+					} else {
+						conflict = fmt.Sprintf("overrides Talos default value %q", def)
+					}
+				}
+
+				if err = ctrl.updateKernelParam(ctx, r, id, spec.Value, conflict); err != nil {
 					if errors.Is(err, os.ErrNotExist) && spec.IgnoreErrors {
 						status := runtime.NewKernelParamStatus(runtime.NamespaceName, id)
 
@@ -150,7 +167,7 @@ func (ctrl *KernelParamSpecController) Run(ctx context.Context, r controller.Run
 	}
 }
 
-func (ctrl *KernelParamSpecController) updateKernelParam(ctx context.Context, r controller.Runtime, key, value string) error {
+func (ctrl *KernelParamSpecController) updateKernelParam(ctx context.Context, r controller.Runtime, key, value, conflict string) error {
 	prop := &kernel.Param{
 		Key:   key,
 		Value: value,
@@ -175,6 +192,7 @@ func (ctrl *KernelParamSpecController) updateKernelParam(ctx context.Context, r
 	return r.Modify(ctx, status, func(res resource.Resource) error {
 		res.(*runtime.KernelParamStatus).TypedSpec().Current = value
 		res.(*runtime.KernelParamStatus).TypedSpec().Default = strings.TrimSpace(ctrl.defaults[key])
+		res.(*runtime.KernelParamStatus).TypedSpec().Conflict = conflict
 
 		return nil
 	})
@@ -107,6 +107,66 @@ func (suite *KernelParamSpecSuite) TestParamsUnsupported() {
 	))
 }
 
+func (suite *KernelParamSpecSuite) TestParamsConflict() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.KernelParamSpecController{}))
+
+	suite.startRuntime()
+
+	def := runtimeresource.NewKernelParamDefaultSpec(runtimeresource.NamespaceName, procSysfsFileMax)
+	def.TypedSpec().Value = "400000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, def))
+
+	spec := runtimeresource.NewKernelParamSpec(runtimeresource.NamespaceName, procSysfsFileMax)
+	spec.TypedSpec().Value = "500000"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, spec))
+
+	statusMD := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelParamStatusType, procSysfsFileMax, resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			statusMD,
+			func(res resource.Resource) bool {
+				status := res.(*runtimeresource.KernelParamStatus).TypedSpec()
+
+				return status.Current == "500000" && status.Conflict == `overrides Talos default value "400000"`
+			},
+		),
+	))
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, spec.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(
+			statusMD,
+			func(res resource.Resource) bool {
+				status := res.(*runtimeresource.KernelParamStatus).TypedSpec()
+
+				return status.Current == "400000" && status.Conflict == ""
+			},
+		),
+	))
+
+	// original value is restored when the default is removed
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, def.Metadata()))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, statusMD)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			return retry.ExpectedError(fmt.Errorf("resource still exists"))
+		},
+	))
+}
+
 func TestKernelParamSpecSuite(t *testing.T) {
 	suite.Run(t, new(KernelParamSpecSuite))
 }
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/raid"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+// RAIDStatusController watches /proc/mdstat and updates RAIDArrayStatus resources.
+//
+// Array state changes are reported to the events stream.
+type RAIDStatusController struct {
+	V1Alpha1Mode   v1alpha1runtime.Mode
+	V1Alpha1Events v1alpha1runtime.Publisher
+
+	// MDStatPath overrides the path to the md status file, used in tests.
+	MDStatPath   string
+	PollInterval time.Duration
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *RAIDStatusController) Name() string {
+	return "runtime.RAIDStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RAIDStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RAIDStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.RAIDArrayStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *RAIDStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.MDStatPath == "" {
+		ctrl.MDStatPath = raid.MDStatPath
+	}
+
+	if ctrl.PollInterval == 0 {
+		ctrl.PollInterval = 15 * time.Second
+	}
+
+	ticker := time.NewTicker(ctrl.PollInterval)
+	defer ticker.Stop()
+
+	states := map[string]string{}
+
+	for {
+		arrays, err := raid.ReadMDStatFrom(ctrl.MDStatPath)
+		if err != nil {
+			// md driver might be loaded later on, when the array is created
+			if !errors.Is(err, raid.ErrNotSupported) {
+				return fmt.Errorf("error reading md status: %w", err)
+			}
+		}
+
+		names := raid.Names()
+		touchedIDs := make(map[resource.ID]struct{}, len(arrays))
+
+		for _, array := range arrays {
+			array := array
+
+			touchedIDs[array.Device] = struct{}{}
+
+			if err = r.Modify(ctx, runtime.NewRAIDArrayStatus(runtime.NamespaceName, array.Device), func(res resource.Resource) error {
+				spec := res.(*runtime.RAIDArrayStatus).TypedSpec()
+
+				spec.Name = names[array.Device]
+				spec.Device = array.Device
+				spec.Level = array.Level
+				spec.State = array.State()
+				spec.Devices = array.Devices
+				spec.FailedDevices = array.FailedDevices
+				spec.SpareDevices = array.SpareDevices
+				spec.TotalDevices = array.TotalDevices
+				spec.ActiveDevices = array.ActiveDevices
+				spec.SyncAction = array.SyncAction
+				spec.SyncProgress = array.SyncProgress
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating RAID array status: %w", err)
+			}
+
+			state := array.State()
+
+			if previous, known := states[array.Device]; (known && previous != state) || (!known && state != raid.StateClean) {
+				logger.Info("RAID array state changed", zap.String("device", array.Device), zap.String("state", state))
+
+				ctrl.V1Alpha1Events.Publish(&machine.RAIDArrayEvent{
+					Name:          names[array.Device],
+					Device:        array.Device,
+					State:         state,
+					ActiveDevices: int32(array.ActiveDevices),
+					TotalDevices:  int32(array.TotalDevices),
+					FailedDevices: array.FailedDevices,
+				})
+			}
+
+			states[array.Device] = state
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.RAIDArrayStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up RAID array status: %w", err)
+				}
+
+				delete(states, res.Metadata().ID())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-r.EventCh():
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/proto"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+const (
+	mdstatDegraded = `Personalities : [raid1]
+md127 : active raid1 sdb3[1](F) sda3[0]
+      1046528 blocks super 1.2 [2/1] [U_]
+
+unused devices: <none>
+`
+
+	mdstatClean = `Personalities : [raid1]
+md127 : active raid1 sdb3[2] sda3[0]
+      1046528 blocks super 1.2 [2/2] [UU]
+
+unused devices: <none>
+`
+
+	mdstatEmpty = `Personalities : [raid1]
+unused devices: <none>
+`
+)
+
+type mockEventsStream struct {
+	messagesMu sync.Mutex
+	messages   []proto.Message
+}
+
+func (s *mockEventsStream) Publish(m proto.Message) {
+	s.messagesMu.Lock()
+	defer s.messagesMu.Unlock()
+
+	s.messages = append(s.messages, m)
+}
+
+func (s *mockEventsStream) lastRAIDEvent() (*machine.RAIDArrayEvent, error) {
+	s.messagesMu.Lock()
+	defer s.messagesMu.Unlock()
+
+	if len(s.messages) == 0 {
+		return nil, retry.ExpectedError(fmt.Errorf("no events published"))
+	}
+
+	event, ok := s.messages[len(s.messages)-1].(*machine.RAIDArrayEvent)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event %T", s.messages[len(s.messages)-1])
+	}
+
+	return event, nil
+}
+
+type RAIDStatusSuite struct {
+	RuntimeSuite
+}
+
+func (suite *RAIDStatusSuite) TestReconcile() {
+	mdstatPath := filepath.Join(suite.T().TempDir(), "mdstat")
+
+	suite.Require().NoError(os.WriteFile(mdstatPath, []byte(mdstatDegraded), 0o644))
+
+	events := &mockEventsStream{}
+
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.RAIDStatusController{
+		V1Alpha1Mode:   v1alpha1runtime.ModeMetal,
+		V1Alpha1Events: events,
+		MDStatPath:     mdstatPath,
+		PollInterval:   100 * time.Millisecond,
+	}))
+
+	suite.startRuntime()
+
+	md := resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.RAIDArrayStatusType, "md127", resource.VersionUndefined)
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		suite.assertResource(md, func(res resource.Resource) bool {
+			spec := res.(*runtimeresource.RAIDArrayStatus).TypedSpec()
+
+			return spec.Level == "raid1" && spec.State == "degraded" && spec.ActiveDevices == 1 && spec.TotalDevices == 2
+		}),
+	))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			event, err := events.lastRAIDEvent()
+			if err != nil {
+				return err
+			}
+
+			suite.Assert().Equal("md127", event.Device)
+			suite.Assert().Equal("degraded", event.State)
+			suite.Assert().Equal([]string{"sdb3"}, event.FailedDevices)
+
+			return nil
+		},
+	))
+
+	suite.Require().NoError(os.WriteFile(mdstatPath, []byte(mdstatClean), 0o644))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			event, err := events.lastRAIDEvent()
+			if err != nil {
+				return err
+			}
+
+			if event.State != "clean" {
+				return retry.ExpectedError(fmt.Errorf("unexpected state %q", event.State))
+			}
+
+			return nil
+		},
+	))
+
+	suite.Require().NoError(os.WriteFile(mdstatPath, []byte(mdstatEmpty), 0o644))
+
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, md)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			return retry.ExpectedError(fmt.Errorf("resource still exists"))
+		},
+	))
+}
+
+func TestRAIDStatusSuite(t *testing.T) {
+	suite.Run(t, new(RAIDStatusSuite))
+}
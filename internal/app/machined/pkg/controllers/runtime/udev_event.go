@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/api/machine"
+	"github.com/talos-systems/talos/pkg/machinery/udev"
+)
+
+// UdevEventController listens for the device events processed by udevd and reports add/remove events to the events stream.
+type UdevEventController struct {
+	V1Alpha1Mode   v1alpha1runtime.Mode
+	V1Alpha1Events v1alpha1runtime.Publisher
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UdevEventController) Name() string {
+	return "runtime.UdevEventController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UdevEventController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UdevEventController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *UdevEventController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		// udevd is not running in container mode
+		return nil
+	}
+
+	sock, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return fmt.Errorf("error opening uevent socket: %w", err)
+	}
+
+	defer unix.Close(sock) //nolint:errcheck
+
+	if err = unix.Bind(sock, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: udev.UdevGroup}); err != nil {
+		return fmt.Errorf("error binding uevent socket: %w", err)
+	}
+
+	// events might come in bursts on hotplug
+	//nolint:errcheck
+	unix.SetsockoptInt(sock, unix.SOL_SOCKET, unix.SO_RCVBUFFORCE, 1024*1024)
+
+	// receive timeout allows to check for context cancellation
+	if err = unix.SetsockoptTimeval(sock, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &unix.Timeval{Sec: 1}); err != nil {
+		return fmt.Errorf("error setting uevent socket timeout: %w", err)
+	}
+
+	buf := make([]byte, 64*1024)
+
+	for {
+		n, _, recvErr := unix.Recvfrom(sock, buf, 0)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if recvErr != nil {
+			if errors.Is(recvErr, unix.EAGAIN) || errors.Is(recvErr, unix.EINTR) {
+				continue
+			}
+
+			if errors.Is(recvErr, unix.ENOBUFS) {
+				logger.Warn("uevent socket buffer overrun, some device events were lost")
+
+				continue
+			}
+
+			return fmt.Errorf("error receiving device event: %w", recvErr)
+		}
+
+		event, parseErr := udev.ParseEvent(buf[:n])
+		if parseErr != nil {
+			logger.Debug("failed to parse device event", zap.Error(parseErr))
+
+			continue
+		}
+
+		if event.Action != "add" && event.Action != "remove" {
+			continue
+		}
+
+		ctrl.V1Alpha1Events.Publish(&machine.UdevEvent{
+			Action:     event.Action,
+			Devpath:    event.DevPath,
+			Subsystem:  event.Subsystem,
+			Devtype:    event.DevType,
+			Devname:    event.DevName,
+			Properties: event.Properties,
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+)
+
+type UdevRuleConfigSuite struct {
+	RuntimeSuite
+}
+
+func (suite *UdevRuleConfigSuite) TestReconcileConfig() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.UdevRuleConfigController{}))
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineUdev: &v1alpha1.UdevConfig{
+				UdevRules: []string{
+					`SUBSYSTEM=="drm", KERNEL=="renderD*", GROUP="44", MODE="0660"`,
+					`SUBSYSTEM=="block", KERNEL=="nvme*", ATTR{queue/scheduler}="none"`,
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	for id, expected := range map[string]string{
+		"0000": `SUBSYSTEM=="drm", KERNEL=="renderD*", GROUP="44", MODE="0660"`,
+		"0001": `SUBSYSTEM=="block", KERNEL=="nvme*", ATTR{queue/scheduler}="none"`,
+	} {
+		expected := expected
+
+		suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(
+				resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.UdevRuleType, id, resource.VersionUndefined),
+				func(res resource.Resource) bool {
+					return res.(*runtimeresource.UdevRule).TypedSpec().Rule == expected
+				},
+			),
+		))
+	}
+
+	cfg = config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineUdev: &v1alpha1.UdevConfig{
+				UdevRules: []string{
+					`SUBSYSTEM=="drm", KERNEL=="renderD*", GROUP="44", MODE="0660"`,
+				},
+			},
+		},
+		ClusterConfig: &v1alpha1.ClusterConfig{},
+	})
+
+	old := cfg.Metadata().Version()
+
+	cfg.Metadata().BumpVersion()
+
+	suite.Require().NoError(suite.state.Update(suite.ctx, old, cfg))
+
+	// wait for the resource to be removed
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			_, err := suite.state.Get(suite.ctx, resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.UdevRuleType, "0001", resource.VersionUndefined))
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			return retry.ExpectedError(fmt.Errorf("resource still exists"))
+		},
+	))
+}
+
+func TestUdevRuleConfigSuite(t *testing.T) {
+	suite.Run(t, new(UdevRuleConfigSuite))
+}
@@ -0,0 +1,219 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/talos-systems/go-cmd/pkg/cmd"
+	"go.uber.org/zap"
+
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/udev"
+)
+
+// UdevRuleFileController watches UdevRules, writes the rules file and reloads udevd.
+//
+// Initial rules file is written by the boot sequence before udevd is started, so the controller
+// only handles the changes to the rules while udevd is running.
+type UdevRuleFileController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+
+	// RulesPath overrides the path to the rules file, used in tests.
+	RulesPath string
+	// Udevadm overrides udevadm invocation, used in tests.
+	Udevadm func(ctx context.Context, args ...string) error
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UdevRuleFileController) Name() string {
+	return "runtime.UdevRuleFileController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UdevRuleFileController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.UdevRuleType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        pointer.To("udevd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UdevRuleFileController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *UdevRuleFileController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		// udevd is not running in container mode
+		return nil
+	}
+
+	if ctrl.RulesPath == "" {
+		ctrl.RulesPath = constants.UdevRulesPath
+	}
+
+	if ctrl.Udevadm == nil {
+		ctrl.Udevadm = func(ctx context.Context, args ...string) error {
+			_, err := cmd.RunContext(ctx, "/sbin/udevadm", args...)
+
+			return err
+		}
+	}
+
+	// rules which were written to the rules file, nil if the file wasn't written yet
+	var applied []string
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		udevd, err := r.Get(ctx, resource.NewMetadata(v1alpha1.NamespaceName, v1alpha1.ServiceType, "udevd", resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting udevd service: %w", err)
+		}
+
+		if udevd == nil || !udevd.(*v1alpha1.Service).TypedSpec().Running {
+			continue
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.UdevRuleType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing udev rules: %w", err)
+		}
+
+		sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].Metadata().ID() < list.Items[j].Metadata().ID() })
+
+		rules := make([]string, 0, len(list.Items))
+
+		var content bytes.Buffer
+
+		for _, res := range list.Items {
+			rule := res.(*runtime.UdevRule).TypedSpec().Rule
+
+			rules = append(rules, rule)
+
+			content.WriteString(strings.ReplaceAll(rule, "\n", "\\\n"))
+			content.WriteByte('\n')
+		}
+
+		existing, err := os.ReadFile(ctrl.RulesPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error reading udev rules: %w", err)
+		}
+
+		if bytes.Equal(existing, content.Bytes()) {
+			applied = rules
+
+			continue
+		}
+
+		if err = os.WriteFile(ctrl.RulesPath, content.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("error writing udev rules: %w", err)
+		}
+
+		if err = ctrl.Udevadm(ctx, "control", "--reload"); err != nil {
+			return fmt.Errorf("error reloading udev rules: %w", err)
+		}
+
+		// rules are re-applied to the devices matched by the rules which were changed,
+		// if previous rules are not known (file was written before), all devices are triggered
+		var subsystems []string
+
+		if applied != nil || len(existing) == 0 {
+			subsystems = changedSubsystems(applied, rules)
+		}
+
+		args := []string{"trigger", "--type=devices", "--action=add"}
+
+		for _, subsystem := range subsystems {
+			args = append(args, "--subsystem-match="+subsystem)
+		}
+
+		if err = ctrl.Udevadm(ctx, args...); err != nil {
+			return fmt.Errorf("error triggering udev events: %w", err)
+		}
+
+		logger.Info("udev rules updated", zap.Int("rules", len(rules)), zap.Strings("subsystems", subsystems))
+
+		applied = rules
+	}
+}
+
+// changedSubsystems returns the list of subsystems matched by added or removed rules.
+//
+// If any of the changed rules is not limited to a subsystem, nil is returned.
+func changedSubsystems(previous, current []string) []string {
+	counts := map[string]int{}
+
+	for _, rule := range previous {
+		counts[rule]--
+	}
+
+	for _, rule := range current {
+		counts[rule]++
+	}
+
+	subsystems := map[string]struct{}{}
+
+	for rule, count := range counts {
+		if count == 0 {
+			continue
+		}
+
+		parsed, err := udev.Parse(rule)
+		if err != nil {
+			return nil
+		}
+
+		matched := parsed.Subsystems()
+		if matched == nil {
+			return nil
+		}
+
+		for _, subsystem := range matched {
+			subsystems[subsystem] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(subsystems))
+
+	for subsystem := range subsystems {
+		result = append(result, subsystem)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
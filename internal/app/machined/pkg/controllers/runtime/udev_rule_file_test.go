@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/talos-systems/go-retry/retry"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	runtimeresource "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type mockUdevadm struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (m *mockUdevadm) Run(ctx context.Context, args ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, strings.Join(args, " "))
+
+	return nil
+}
+
+func (m *mockUdevadm) Calls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string(nil), m.calls...)
+}
+
+type UdevRuleFileSuite struct {
+	RuntimeSuite
+}
+
+func (suite *UdevRuleFileSuite) assertRules(rulesPath, expected string) {
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			contents, err := os.ReadFile(rulesPath)
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+
+			if string(contents) != expected {
+				return retry.ExpectedError(fmt.Errorf("unexpected rules file contents %q", string(contents)))
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *UdevRuleFileSuite) assertCalls(udevadm *mockUdevadm, expected []string) {
+	suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			calls := udevadm.Calls()
+
+			if len(calls) < len(expected) {
+				return retry.ExpectedError(fmt.Errorf("expected %d calls, got %d", len(expected), len(calls)))
+			}
+
+			suite.Assert().Equal(expected, calls)
+
+			return nil
+		},
+	))
+}
+
+func (suite *UdevRuleFileSuite) TestReconcile() {
+	rulesPath := filepath.Join(suite.T().TempDir(), "99-talos.rules")
+	udevadm := &mockUdevadm{}
+
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.UdevRuleFileController{
+		V1Alpha1Mode: v1alpha1runtime.ModeMetal,
+		RulesPath:    rulesPath,
+		Udevadm:      udevadm.Run,
+	}))
+
+	suite.startRuntime()
+
+	rule1 := runtimeresource.NewUdevRule(runtimeresource.NamespaceName, "0000")
+	rule1.TypedSpec().Rule = `SUBSYSTEM=="drm", KERNEL=="renderD*", GROUP="44", MODE="0660"`
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule1))
+
+	rule2 := runtimeresource.NewUdevRule(runtimeresource.NamespaceName, "0001")
+	rule2.TypedSpec().Rule = "SUBSYSTEM==\"net\", ACTION==\"add\",\nATTR{address}==\"00:11:22:33:44:55\", NAME=\"lan0\""
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule2))
+
+	// rules are not written until udevd is running
+	time.Sleep(500 * time.Millisecond)
+
+	_, err := os.Stat(rulesPath)
+	suite.Assert().True(os.IsNotExist(err))
+
+	udevd := v1alpha1.NewService("udevd")
+	udevd.TypedSpec().Running = true
+	suite.Require().NoError(suite.state.Create(suite.ctx, udevd))
+
+	suite.assertRules(rulesPath, "SUBSYSTEM==\"drm\", KERNEL==\"renderD*\", GROUP=\"44\", MODE=\"0660\"\n"+
+		"SUBSYSTEM==\"net\", ACTION==\"add\",\\\nATTR{address}==\"00:11:22:33:44:55\", NAME=\"lan0\"\n")
+
+	suite.assertCalls(udevadm, []string{
+		"control --reload",
+		"trigger --type=devices --action=add --subsystem-match=drm --subsystem-match=net",
+	})
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, rule2.Metadata()))
+
+	suite.assertRules(rulesPath, "SUBSYSTEM==\"drm\", KERNEL==\"renderD*\", GROUP=\"44\", MODE=\"0660\"\n")
+
+	suite.assertCalls(udevadm, []string{
+		"control --reload",
+		"trigger --type=devices --action=add --subsystem-match=drm --subsystem-match=net",
+		"control --reload",
+		"trigger --type=devices --action=add --subsystem-match=net",
+	})
+
+	rule3 := runtimeresource.NewUdevRule(runtimeresource.NamespaceName, "0001")
+	rule3.TypedSpec().Rule = `KERNEL=="sd*", ATTR{queue/scheduler}="none"`
+	suite.Require().NoError(suite.state.Create(suite.ctx, rule3))
+
+	suite.assertCalls(udevadm, []string{
+		"control --reload",
+		"trigger --type=devices --action=add --subsystem-match=drm --subsystem-match=net",
+		"control --reload",
+		"trigger --type=devices --action=add --subsystem-match=net",
+		"control --reload",
+		"trigger --type=devices --action=add",
+	})
+}
+
+func TestUdevRuleFileSuite(t *testing.T) {
+	suite.Run(t, new(UdevRuleFileSuite))
+}
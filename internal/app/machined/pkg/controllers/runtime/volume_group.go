@@ -0,0 +1,503 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
+	"github.com/talos-systems/go-blockdevice/blockdevice"
+	"github.com/talos-systems/go-blockdevice/blockdevice/filesystem"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	v1alpha1runtime "github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/lvm"
+	"github.com/talos-systems/talos/internal/pkg/mount"
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/runtime"
+	"github.com/talos-systems/talos/pkg/makefs"
+)
+
+// VolumeGroupController creates LVM volume groups and logical volumes defined in the machine config.
+//
+// Physical volumes, volume groups and logical volumes are never removed: changes to the config
+// only add new volumes, so the controller is safe to re-run on every boot.
+type VolumeGroupController struct {
+	V1Alpha1Mode v1alpha1runtime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *VolumeGroupController) Name() string {
+	return "runtime.VolumeGroupController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *VolumeGroupController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        pointer.To(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MountStatusType,
+			ID:        pointer.To(constants.EphemeralPartitionLabel),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *VolumeGroupController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.LogicalVolumeStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *VolumeGroupController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.V1Alpha1Mode == v1alpha1runtime.ModeContainer {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// wait for the EPHEMERAL to be mounted, logical volumes are usually mounted under /var
+		if _, err := r.Get(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.MountStatusType, constants.EphemeralPartitionLabel, resource.VersionUndefined)); err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error reading mount status: %w", err)
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.V1Alpha1ID, resource.VersionUndefined))
+		if err != nil {
+			if !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting config: %w", err)
+			}
+		}
+
+		touchedIDs := make(map[resource.ID]struct{})
+
+		if cfg != nil {
+			machineConfig := cfg.(*config.MachineConfig).Config().Machine()
+
+			for _, vg := range machineConfig.VolumeGroups() {
+				if err = ctrl.ensureVolumeGroup(logger, machineConfig, vg); err != nil {
+					return err
+				}
+
+				lvs, lvsErr := lvm.LogicalVolumes(vg.Name())
+				if lvsErr != nil {
+					return lvsErr
+				}
+
+				for _, lv := range vg.LogicalVolumes() {
+					status, statusErr := ctrl.ensureLogicalVolume(logger, vg, lv, lvs)
+					if statusErr != nil {
+						return statusErr
+					}
+
+					id := vg.Name() + "-" + lv.Name()
+					touchedIDs[id] = struct{}{}
+
+					if err = r.Modify(ctx, runtime.NewLogicalVolumeStatus(runtime.NamespaceName, id), func(res resource.Resource) error {
+						*res.(*runtime.LogicalVolumeStatus).TypedSpec() = status
+
+						return nil
+					}); err != nil {
+						return fmt.Errorf("error updating logical volume status: %w", err)
+					}
+				}
+			}
+		}
+
+		list, err := r.List(ctx, resource.NewMetadata(runtime.NamespaceName, runtime.LogicalVolumeStatusType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("error listing resources: %w", err)
+		}
+
+		for _, res := range list.Items {
+			if res.Metadata().Owner() != ctrl.Name() {
+				continue
+			}
+
+			if _, ok := touchedIDs[res.Metadata().ID()]; !ok {
+				if err = r.Destroy(ctx, res.Metadata()); err != nil {
+					return fmt.Errorf("error cleaning up logical volume status: %w", err)
+				}
+			}
+		}
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *VolumeGroupController) ensureVolumeGroup(logger *zap.Logger, machineConfig talosconfig.MachineConfig, vg talosconfig.VolumeGroup) error {
+	pvs, err := lvm.PhysicalVolumes()
+	if err != nil {
+		return err
+	}
+
+	pvGroups := make(map[string]string, len(pvs))
+
+	for _, pv := range pvs {
+		pvGroups[pv.Name] = pv.VolumeGroup
+	}
+
+	devices, err := ctrl.resolvePhysicalVolumes(machineConfig, vg, pvGroups, disk.List, isBlank)
+	if err != nil {
+		return err
+	}
+
+	var newDevices []string
+
+	for _, device := range devices {
+		group, isPV := pvGroups[device]
+
+		switch {
+		case !isPV:
+			logger.Info("creating physical volume", zap.String("device", device))
+
+			if err = lvm.CreatePhysicalVolume(device); err != nil {
+				return err
+			}
+
+			newDevices = append(newDevices, device)
+		case group == "":
+			newDevices = append(newDevices, device)
+		case group != vg.Name():
+			return fmt.Errorf("device %q is a physical volume of another volume group %q", device, group)
+		}
+	}
+
+	vgs, err := lvm.VolumeGroups()
+	if err != nil {
+		return err
+	}
+
+	exists := false
+
+	for _, name := range vgs {
+		if name == vg.Name() {
+			exists = true
+
+			break
+		}
+	}
+
+	switch {
+	case !exists:
+		if len(newDevices) == 0 {
+			return fmt.Errorf("no physical volumes found for volume group %q", vg.Name())
+		}
+
+		logger.Info("creating volume group", zap.String("name", vg.Name()), zap.Strings("devices", newDevices))
+
+		if err = lvm.CreateVolumeGroup(vg.Name(), newDevices); err != nil {
+			return err
+		}
+	case len(newDevices) > 0:
+		logger.Info("extending volume group", zap.String("name", vg.Name()), zap.Strings("devices", newDevices))
+
+		if err = lvm.ExtendVolumeGroup(vg.Name(), newDevices); err != nil {
+			return err
+		}
+	}
+
+	return lvm.ActivateVolumeGroup(vg.Name())
+}
+
+// resolvePhysicalVolumes returns the list of devices for the physical volumes of the volume group.
+//
+// Disk selectors match only the disks which are already physical volumes of the volume group or
+// which are blank: disks with a partition table, a filesystem or a RAID/LVM superblock are never picked up.
+// Disks used by machine.disks (either directly or as RAID members) are skipped as well.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *VolumeGroupController) resolvePhysicalVolumes(machineConfig talosconfig.MachineConfig, vg talosconfig.VolumeGroup, pvGroups map[string]string,
+	listDisks func() ([]*disk.Disk, error), blank func(device string) (bool, error),
+) ([]string, error) {
+	var (
+		devices  []string
+		disks    []*disk.Disk
+		disksErr error
+	)
+
+	used := map[string]struct{}{}
+
+	for _, d := range machineConfig.Disks() {
+		used[d.Device()] = struct{}{}
+
+		if d.RAID() == nil {
+			continue
+		}
+
+		for _, member := range d.RAID().Devices() {
+			used[member] = struct{}{}
+		}
+	}
+
+	for _, pv := range vg.PhysicalVolumes() {
+		if pv.Device() != "" {
+			devices = append(devices, pv.Device())
+
+			continue
+		}
+
+		if disks == nil {
+			if disks, disksErr = listDisks(); disksErr != nil {
+				return nil, fmt.Errorf("error listing disks: %w", disksErr)
+			}
+		}
+
+		for _, d := range disks {
+			if _, ok := used[d.DeviceName]; ok {
+				continue
+			}
+
+			if !matchDisk(d, pv.DiskMatchers()) {
+				continue
+			}
+
+			if group, isPV := pvGroups[d.DeviceName]; isPV {
+				if group == vg.Name() || group == "" {
+					devices = append(devices, d.DeviceName)
+				}
+
+				continue
+			}
+
+			empty, err := blank(d.DeviceName)
+			if err != nil {
+				return nil, err
+			}
+
+			if empty {
+				devices = append(devices, d.DeviceName)
+			}
+		}
+	}
+
+	return devices, nil
+}
+
+func (ctrl *VolumeGroupController) ensureLogicalVolume(logger *zap.Logger, vg talosconfig.VolumeGroup, lv talosconfig.LogicalVolume, existing []lvm.LogicalVolume) (runtime.LogicalVolumeStatusSpec, error) {
+	status := runtime.LogicalVolumeStatusSpec{
+		VolumeGroup: vg.Name(),
+		Name:        lv.Name(),
+		Filesystem:  lv.Filesystem(),
+		MountPoint:  lv.MountPoint(),
+	}
+
+	for _, volume := range existing {
+		if volume.Name == lv.Name() {
+			status.Device = volume.Path
+			status.Size = volume.Size
+		}
+	}
+
+	if status.Device == "" {
+		logger.Info("creating logical volume", zap.String("volume_group", vg.Name()), zap.String("name", lv.Name()))
+
+		if err := lvm.CreateLogicalVolume(vg.Name(), lv.Name(), lv.Size(), lv.Percent()); err != nil {
+			return status, err
+		}
+
+		lvs, err := lvm.LogicalVolumes(vg.Name())
+		if err != nil {
+			return status, err
+		}
+
+		for _, volume := range lvs {
+			if volume.Name == lv.Name() {
+				status.Device = volume.Path
+				status.Size = volume.Size
+			}
+		}
+
+		if status.Device == "" {
+			return status, fmt.Errorf("logical volume %q not found in volume group %q after creation", lv.Name(), vg.Name())
+		}
+	}
+
+	if lv.Filesystem() == "none" {
+		return status, nil
+	}
+
+	sb, err := filesystem.Probe(status.Device)
+	if err != nil {
+		return status, fmt.Errorf("error probing logical volume %q: %w", status.Device, err)
+	}
+
+	if sb == nil || sb.Type() == filesystem.Unknown {
+		logger.Info("formatting logical volume", zap.String("device", status.Device))
+
+		if err = makefs.XFS(status.Device, makefs.WithForce(true)); err != nil {
+			return status, fmt.Errorf("error formatting logical volume %q: %w", status.Device, err)
+		}
+	}
+
+	if lv.MountPoint() == "" {
+		return status, nil
+	}
+
+	if err = os.MkdirAll(lv.MountPoint(), 0o755); err != nil {
+		return status, err
+	}
+
+	mountpoint := mount.NewMountPoint(status.Device, lv.MountPoint(), lv.Filesystem(), unix.MS_NOATIME, "")
+
+	mounted, err := mountpoint.IsMounted()
+	if err != nil {
+		return status, err
+	}
+
+	if !mounted {
+		logger.Info("mounting logical volume", zap.String("device", status.Device), zap.String("mountpoint", lv.MountPoint()))
+
+		if err = mountpoint.Mount(); err != nil {
+			return status, fmt.Errorf("error mounting logical volume %q: %w", status.Device, err)
+		}
+	}
+
+	status.Mounted = true
+
+	return status, nil
+}
+
+func matchDisk(d *disk.Disk, matchers []disk.Matcher) bool {
+	for _, match := range matchers {
+		if !match(d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isBlank checks whether the disk has neither a partition table, nor a filesystem, nor a RAID/LVM superblock.
+func isBlank(device string) (bool, error) {
+	bd, err := blockdevice.Open(device)
+	if err != nil {
+		return false, fmt.Errorf("error opening %q: %w", device, err)
+	}
+
+	defer bd.Close() //nolint:errcheck
+
+	if _, err = bd.PartitionTable(); err == nil {
+		return false, nil
+	} else if !errors.Is(err, blockdevice.ErrMissingPartitionTable) {
+		return false, fmt.Errorf("error reading partition table of %q: %w", device, err)
+	}
+
+	size, err := bd.Size()
+	if err != nil {
+		return false, fmt.Errorf("error reading size of %q: %w", device, err)
+	}
+
+	signature, err := probeSignature(bd.Device(), int64(size))
+	if err != nil {
+		return false, fmt.Errorf("error probing %q: %w", device, err)
+	}
+
+	if signature != "" {
+		return false, nil
+	}
+
+	sb, err := filesystem.Probe(device)
+	if err != nil {
+		return false, fmt.Errorf("error probing %q: %w", device, err)
+	}
+
+	return sb == nil || sb.Type() == filesystem.Unknown, nil
+}
+
+const (
+	signatureMD  = "linux_raid_member"
+	signatureLVM = "LVM2_member"
+
+	// mdMagic is the magic number of the md superblock (stored little-endian).
+	mdMagic = 0xa92b4efc
+
+	// lvmLabelSectors is the number of sectors at the start of the device which might contain the LVM label.
+	lvmLabelSectors = 4
+	sectorSize      = 512
+)
+
+// probeSignature looks for the md RAID and LVM physical volume superblocks on the device of the specified size.
+//
+// The name of the signature found is returned, or an empty string if there is none.
+func probeSignature(r io.ReaderAt, size int64) (string, error) {
+	// md superblock 1.1 is at the start of the device, 1.2 is 4K from the start,
+	// 1.0 is at least 8K and less than 12K from the end (4K aligned),
+	// 0.90 is in the last 64K-aligned 64K block of the device.
+	mdOffsets := []int64{0, 4096}
+
+	if offset := ((size >> 9) - 16) &^ 7; offset > 0 {
+		mdOffsets = append(mdOffsets, offset<<9)
+	}
+
+	if offset := (size &^ (64*1024 - 1)) - 64*1024; offset > 0 {
+		mdOffsets = append(mdOffsets, offset)
+	}
+
+	buf := make([]byte, sectorSize)
+
+	for _, offset := range mdOffsets {
+		if offset+4 > size {
+			continue
+		}
+
+		if _, err := r.ReadAt(buf[:4], offset); err != nil {
+			return "", err
+		}
+
+		if binary.LittleEndian.Uint32(buf[:4]) == mdMagic {
+			return signatureMD, nil
+		}
+	}
+
+	for sector := int64(0); sector < lvmLabelSectors; sector++ {
+		if (sector+1)*sectorSize > size {
+			break
+		}
+
+		if _, err := r.ReadAt(buf, sector*sectorSize); err != nil {
+			return "", err
+		}
+
+		if bytes.Equal(buf[:8], []byte("LABELONE")) && bytes.Equal(buf[24:32], []byte("LVM2 001")) {
+			return signatureLVM, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,187 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
+
+	runtimecontrollers "github.com/talos-systems/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestResolvePhysicalVolumes(t *testing.T) {
+	t.Parallel()
+
+	disks := []*disk.Disk{
+		{DeviceName: "/dev/sda", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/sdb", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/sdc", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/sdd", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/sde", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/sdf", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/sdg", Model: "QEMU HARDDISK"},
+		{DeviceName: "/dev/nvme0n1", Model: "Samsung SSD"},
+	}
+
+	blankDisks := map[string]bool{
+		"/dev/sda":     true,
+		"/dev/sdb":     true,
+		"/dev/sdc":     true,
+		"/dev/sdd":     true,
+		"/dev/sde":     false,
+		"/dev/nvme0n1": true,
+	}
+
+	cfg := &v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineDisks: []*v1alpha1.MachineDisk{
+				{
+					DeviceName: "/dev/sda",
+				},
+				{
+					DeviceName: "/dev/md0",
+					DiskRAID: &v1alpha1.DiskRAIDConfig{
+						RAIDLevel:   "raid1",
+						RAIDDevices: []string{"/dev/sdb", "/dev/sdc"},
+					},
+				},
+			},
+			MachineVolumeGroups: []*v1alpha1.VolumeGroupConfig{
+				{
+					VolumeGroupName: "data",
+					VolumeGroupPhysicalVolumes: []*v1alpha1.PhysicalVolumeConfig{
+						{
+							PhysicalVolumeDevice: "/dev/vdb",
+						},
+						{
+							PhysicalVolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+								Model: "QEMU*",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pvGroups := map[string]string{
+		"/dev/sdf": "data",
+		"/dev/sdg": "other",
+	}
+
+	listed := 0
+
+	devices, err := (&runtimecontrollers.VolumeGroupController{}).ResolvePhysicalVolumes(
+		cfg.Machine(),
+		cfg.Machine().VolumeGroups()[0],
+		pvGroups,
+		func() ([]*disk.Disk, error) {
+			listed++
+
+			return disks, nil
+		},
+		func(device string) (bool, error) {
+			assert.NotContains(t, pvGroups, device, "physical volumes should not be probed")
+
+			return blankDisks[device], nil
+		},
+	)
+	require.NoError(t, err)
+
+	// sda is used by machine.disks, sdb and sdc are RAID members, sde is not blank,
+	// sdg belongs to another volume group and nvme0n1 doesn't match the selector
+	assert.Equal(t, []string{"/dev/vdb", "/dev/sdd", "/dev/sdf"}, devices)
+	assert.Equal(t, 1, listed)
+}
+
+func TestProbeSignature(t *testing.T) {
+	t.Parallel()
+
+	const size = 1024 * 1024
+
+	mdMagic := make([]byte, 4)
+	binary.LittleEndian.PutUint32(mdMagic, 0xa92b4efc)
+
+	lvmLabel := make([]byte, 32)
+	copy(lvmLabel, "LABELONE")
+	copy(lvmLabel[24:], "LVM2 001")
+
+	for _, tt := range []struct {
+		name     string
+		offset   int64
+		data     []byte
+		expected string
+	}{
+		{
+			name: "blank",
+		},
+		{
+			name:     "md 1.1",
+			offset:   0,
+			data:     mdMagic,
+			expected: "linux_raid_member",
+		},
+		{
+			name:     "md 1.2",
+			offset:   4096,
+			data:     mdMagic,
+			expected: "linux_raid_member",
+		},
+		{
+			name:     "md 1.0",
+			offset:   size - 8192,
+			data:     mdMagic,
+			expected: "linux_raid_member",
+		},
+		{
+			name:     "md 0.90",
+			offset:   size - 64*1024,
+			data:     mdMagic,
+			expected: "linux_raid_member",
+		},
+		{
+			name:     "lvm first sector",
+			offset:   0,
+			data:     lvmLabel,
+			expected: "LVM2_member",
+		},
+		{
+			name:     "lvm second sector",
+			offset:   512,
+			data:     lvmLabel,
+			expected: "LVM2_member",
+		},
+		{
+			name:   "lvm label beyond first sectors",
+			offset: 4 * 512,
+			data:   lvmLabel,
+		},
+		{
+			name:   "partial lvm label",
+			offset: 512,
+			data:   lvmLabel[:8],
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := make([]byte, size)
+			copy(device[tt.offset:], tt.data)
+
+			signature, err := runtimecontrollers.ProbeSignature(bytes.NewReader(device), size)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, signature)
+		})
+	}
+}
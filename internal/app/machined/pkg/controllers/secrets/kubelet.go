@@ -7,6 +7,9 @@ package secrets
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -15,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/secrets"
 )
@@ -84,6 +88,13 @@ func (ctrl *KubeletController) Run(ctx context.Context, r controller.Runtime, lo
 func (ctrl *KubeletController) updateKubeletSecrets(cfgProvider talosconfig.Provider, kubeletSecrets *secrets.KubeletSpec) error {
 	kubeletSecrets.Endpoint = cfgProvider.Cluster().Endpoint()
 
+	if lb := cfgProvider.Machine().Features().APIServerLoadBalancer(); lb.Enabled() {
+		kubeletSecrets.Endpoint = &url.URL{
+			Scheme: "https",
+			Host:   net.JoinHostPort(constants.APIServerLoadBalancerHost, strconv.Itoa(lb.Port())),
+		}
+	}
+
 	kubeletSecrets.CA = cfgProvider.Cluster().CA()
 
 	if kubeletSecrets.CA == nil {
@@ -7,6 +7,7 @@ package runtime
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 )
@@ -70,4 +71,20 @@ type Controller interface {
 type V1Alpha2Controller interface {
 	Run(context.Context, *Drainer) error
 	DependencyGraph() (*controller.DependencyGraph, error)
+	ControllerStats() []ControllerStats
+}
+
+// ControllerStats describes the reconcile loop statistics of a controller.
+type ControllerStats struct {
+	Name string
+
+	ReconcileCount        uint64
+	Reconciling           bool
+	ReconcileStarted      time.Time
+	LastReconcileDuration time.Duration
+
+	RestartCount   uint64
+	LastError      string
+	LastErrorTime  time.Time
+	RestartBackoff time.Duration
 }
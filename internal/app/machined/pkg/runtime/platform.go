@@ -11,6 +11,7 @@ import (
 	"inet.af/netaddr"
 
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Platform defines the requirements for a platform.
@@ -54,4 +55,6 @@ type PlatformNetworkConfig struct {
 	Operators []network.OperatorSpecSpec `yaml:"operators"`
 
 	ExternalIPs []netaddr.IP `yaml:"externalIPs"`
+
+	Metadata *runtimeres.PlatformMetadataSpec `yaml:"metadata,omitempty"`
 }
@@ -87,10 +87,12 @@ func getBlockDeviceName(bootDisk string) (string, error) {
 	//nolint:errcheck
 	defer dev.Close()
 
-	// verify that BootDisk has boot partition
+	// verify that BootDisk has boot partition, or it's a member of the system RAID array
 	_, err = dev.GetPartition(constants.BootPartitionLabel)
 	if err != nil {
-		return "", err
+		if _, raidErr := dev.GetPartition(constants.RAIDPartitionLabel); raidErr != nil {
+			return "", err
+		}
 	}
 
 	blk := dev.Device().Name()
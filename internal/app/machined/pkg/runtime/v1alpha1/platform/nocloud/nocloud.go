@@ -16,6 +16,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 // Nocloud is the concrete type that implements the runtime.Platform interface.
@@ -126,6 +127,13 @@ func (n *Nocloud) NetworkConfiguration(ctx context.Context, ch chan<- *runtime.P
 		return err
 	}
 
+	if unmarshalledMetadataConfig.InstanceID != "" {
+		networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+			Platform:   n.Name(),
+			InstanceID: unmarshalledMetadataConfig.InstanceID,
+		}
+	}
+
 	select {
 	case ch <- networkConfig:
 	case <-ctx.Done():
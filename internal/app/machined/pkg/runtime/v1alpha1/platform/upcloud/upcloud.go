@@ -18,6 +18,7 @@ import (
 	"github.com/talos-systems/talos/pkg/download"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
 	"github.com/talos-systems/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 )
 
 const (
@@ -69,6 +70,13 @@ func (u *UpCloud) Name() string {
 func (u *UpCloud) ParseMetadata(meta *MetaData) (*runtime.PlatformNetworkConfig, error) {
 	networkConfig := &runtime.PlatformNetworkConfig{}
 
+	if meta.InstanceID != "" {
+		networkConfig.Metadata = &runtimeres.PlatformMetadataSpec{
+			Platform:   u.Name(),
+			InstanceID: meta.InstanceID,
+		}
+	}
+
 	if meta.Hostname != "" {
 		hostnameSpec := network.HostnameSpecSpec{
 			ConfigLayer: network.ConfigPlatform,
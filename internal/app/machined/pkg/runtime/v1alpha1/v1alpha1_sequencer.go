@@ -186,6 +186,7 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"saveConfig",
 		SaveConfig,
+		LoadRemovedMembers,
 	).Append(
 		"env",
 		SetUserEnvVars,
@@ -269,6 +270,10 @@ func (*Sequencer) Reboot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"dbus",
 		StopDBus,
+	).AppendWhen(
+		r.Config() != nil && r.Config().Machine().Type() == machine.TypeWorker,
+		"etcdData",
+		RemoveEtcdDataOnPromotion,
 	).
 		AppendList(stopAllPhaselist(r, true)).
 		Append("reboot", Reboot)
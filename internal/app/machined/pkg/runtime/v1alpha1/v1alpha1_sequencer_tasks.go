@@ -54,9 +54,11 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
 	"github.com/talos-systems/talos/internal/app/maintenance"
 	"github.com/talos-systems/talos/internal/pkg/cri"
+	"github.com/talos-systems/talos/internal/pkg/discovery/blocklist"
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/internal/pkg/partition"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/images"
 	krnl "github.com/talos-systems/talos/pkg/kernel"
@@ -69,6 +71,7 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/machinery/kernel"
+	machineryraid "github.com/talos-systems/talos/pkg/machinery/raid"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
 	resourceruntime "github.com/talos-systems/talos/pkg/machinery/resources/runtime"
 	"github.com/talos-systems/talos/pkg/version"
@@ -531,6 +534,13 @@ func SaveConfig(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFu
 	}, "saveConfig"
 }
 
+// LoadRemovedMembers represents the LoadRemovedMembers task.
+func LoadRemovedMembers(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
+		return blocklist.Load(ctx, r.State().V1Alpha2().Resources(), filepath.Join(constants.StateMountPoint, constants.RemovedMembersFilename))
+	}, "loadRemovedMembers"
+}
+
 func fetchConfig(ctx context.Context, r runtime.Runtime) (out []byte, err error) {
 	var b []byte
 
@@ -600,7 +610,24 @@ func ValidateConfig(seq runtime.Sequence, data interface{}) (runtime.TaskExecuti
 			logger.Printf("WARNING:\n%s", w)
 		}
 
-		return err
+		if err != nil {
+			return err
+		}
+
+		// RAID for the system disk is set up by the installer, user disks are set up on boot
+		if seq == runtime.SequenceBoot && r.State().Platform().Mode() != runtime.ModeContainer {
+			for _, disk := range r.Config().Machine().Disks() {
+				if disk.RAID() == nil {
+					continue
+				}
+
+				if err = raid.CheckAvailable(); err != nil {
+					return fmt.Errorf("disk %q: %w, it should be installed via the system extension", disk.Device(), err)
+				}
+			}
+		}
+
+		return nil
 	}, "validateConfig"
 }
 
@@ -632,6 +659,9 @@ func StartContainerd(seq runtime.Sequence, data interface{}) (runtime.TaskExecut
 }
 
 // WriteUdevRules is the task that writes udev rules to a udev rules file.
+//
+// Initial rules should be in place before udevd starts and triggers the devices,
+// changes to the rules are applied later by the UdevRuleFileController.
 func WriteUdevRules(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) (err error) {
 		rules := r.Config().Machine().Udev().Rules()
@@ -823,7 +853,13 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 		disk := disk
 
 		if err := func() error {
-			bd, err := blockdevice.Open(disk.Device())
+			if err := ensureDiskRAID(logger, disk); err != nil {
+				return err
+			}
+
+			device := diskDevicePath(disk)
+
+			bd, err := blockdevice.Open(device)
 			if err != nil {
 				return err
 			}
@@ -853,15 +889,15 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 				}
 			}
 
-			m.Devices[disk.Device()] = installer.Device{
-				Device:                 disk.Device(),
+			m.Devices[device] = installer.Device{
+				Device:                 device,
 				ResetPartitionTable:    true,
 				SkipOverlayMountsCheck: true,
 			}
 
 			for _, part := range disk.Partitions() {
 				extraTarget := &installer.Target{
-					Device: disk.Device(),
+					Device: device,
 					FormatOptions: &partition.FormatOptions{
 						Size:           part.Size(),
 						Force:          true,
@@ -870,7 +906,7 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 					},
 				}
 
-				m.Targets[disk.Device()] = append(m.Targets[disk.Device()], extraTarget)
+				m.Targets[device] = append(m.Targets[device], extraTarget)
 			}
 
 			return nil
@@ -882,6 +918,39 @@ func partitionAndFormatDisks(logger *log.Logger, r runtime.Runtime) error {
 	return m.Execute()
 }
 
+// ensureDiskRAID assembles (or creates) the software RAID array for the disk, if configured.
+func ensureDiskRAID(logger *log.Logger, disk config.Disk) error {
+	if disk.RAID() == nil {
+		return nil
+	}
+
+	if err := raid.CheckAvailable(); err != nil {
+		return fmt.Errorf("disk %q: %w", disk.Device(), err)
+	}
+
+	level, err := machineryraid.ParseLevel(disk.RAID().Level())
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Base(disk.Device())
+
+	logger.Printf("ensuring RAID array %q (%s) from %v", name, level, disk.RAID().Devices())
+
+	return raid.Ensure(name, level, disk.RAID().Devices())
+}
+
+// diskDevicePath returns the path to the kernel block device for the disk.
+//
+// Software RAID arrays are referenced as /dev/md/<name>, which is a symlink to the md device.
+func diskDevicePath(disk config.Disk) string {
+	if disk.RAID() == nil {
+		return disk.Device()
+	}
+
+	return raid.Resolve(disk.Device())
+}
+
 func mountDisks(r runtime.Runtime) (err error) {
 	mountpoints := mount.NewMountPoints()
 
@@ -889,7 +958,7 @@ func mountDisks(r runtime.Runtime) (err error) {
 		for i, part := range disk.Partitions() {
 			var partname string
 
-			partname, err = util.PartPath(disk.Device(), i+1)
+			partname, err = util.PartPath(diskDevicePath(disk), i+1)
 			if err != nil {
 				return err
 			}
@@ -914,7 +983,7 @@ func unmountDisks(r runtime.Runtime) (err error) {
 		for i, part := range disk.Partitions() {
 			var partname string
 
-			partname, err = util.PartPath(disk.Device(), i+1)
+			partname, err = util.PartPath(diskDevicePath(disk), i+1)
 			if err != nil {
 				return err
 			}
@@ -923,6 +992,19 @@ func unmountDisks(r runtime.Runtime) (err error) {
 		}
 	}
 
+	// logical volumes are mounted by the VolumeGroupController
+	for _, vg := range r.Config().Machine().VolumeGroups() {
+		for _, lv := range vg.LogicalVolumes() {
+			if lv.MountPoint() == "" {
+				continue
+			}
+
+			device := filepath.Join("/dev", vg.Name(), lv.Name())
+
+			mountpoints.Set(device, mount.NewMountPoint(device, lv.MountPoint(), lv.Filesystem(), unix.MS_NOATIME, ""))
+		}
+	}
+
 	return mount.Unmount(mountpoints)
 }
 
@@ -1670,7 +1752,14 @@ func UnmountStatePartition(seq runtime.Sequence, data interface{}) (runtime.Task
 // MountEphemeralPartition mounts the ephemeral partition.
 func MountEphemeralPartition(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
-		return mount.SystemPartitionMount(r, logger, constants.EphemeralPartitionLabel, mount.WithFlags(mount.Resize))
+		var opts []mount.Option
+
+		// EPHEMERAL partition is grown to occupy the rest of the disk only if its size is not limited
+		if r.Config().Machine().Install().EphemeralMaxSize() == 0 {
+			opts = append(opts, mount.WithFlags(mount.Resize))
+		}
+
+		return mount.SystemPartitionMount(r, logger, constants.EphemeralPartitionLabel, opts...)
 	}, "mountEphemeralPartition"
 }
 
@@ -1899,6 +1988,31 @@ func StopDBus(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc
 	}, "stopDBus"
 }
 
+// RemoveEtcdDataOnPromotion removes etcd data directory if the worker node is rebooted with the control plane config.
+//
+// etcd joins the existing cluster as a learner only if there is no data on disk, while the node might have
+// the data left over from the time it was a control plane node.
+func RemoveEtcdDataOnPromotion(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		cfg, err := configloader.NewFromFile(constants.ConfigPath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+
+			return fmt.Errorf("error loading saved config: %w", err)
+		}
+
+		if cfg.Machine().Type() == machine.TypeWorker {
+			return nil
+		}
+
+		logger.Printf("machine type changed to %q, removing etcd data directory", cfg.Machine().Type())
+
+		return os.RemoveAll(constants.EtcdDataPath)
+	}, "removeEtcdDataOnPromotion"
+}
+
 func pauseOnFailure(callback func(runtime.Sequence, interface{}) (runtime.TaskExecutionFunc, string),
 	timeout time.Duration,
 ) func(seq runtime.Sequence, data interface{}) (runtime.TaskExecutionFunc, string) {
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"errors"
+	"log"
 	"os"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -17,6 +18,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/adv"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha1/platform"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime/v1alpha2"
+	"github.com/talos-systems/talos/internal/pkg/raid"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
 )
 
@@ -57,6 +59,8 @@ func NewState() (s *State, err error) {
 		platform: p,
 	}
 
+	machine.assembleRAID()
+
 	err = machine.probeDisks()
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
@@ -103,6 +107,17 @@ func (s *State) V1Alpha2() runtime.V1Alpha2State {
 	return s.v2
 }
 
+// assembleRAID assembles software RAID arrays, so that system partitions on the arrays can be found.
+func (s *MachineState) assembleRAID() {
+	if s.platform.Mode() == runtime.ModeContainer || !raid.Available() {
+		return
+	}
+
+	if err := raid.AssembleAll(); err != nil {
+		log.Printf("failed to assemble RAID arrays: %s", err)
+	}
+}
+
 func (s *MachineState) probeDisks(labels ...string) error {
 	if s.platform.Mode() == runtime.ModeContainer {
 		return os.ErrNotExist
@@ -21,6 +21,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/config"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/etcd"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/files"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/hardware"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/controllers/k8s"
@@ -35,6 +36,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	runtimelogging "github.com/talos-systems/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/otlp"
 	"github.com/talos-systems/talos/pkg/logging"
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
@@ -44,6 +46,7 @@ import (
 // Controller implements runtime.V1alpha2Controller.
 type Controller struct {
 	controllerRuntime *osruntime.Runtime
+	stats             controllerStats
 
 	loggingManager  runtime.LoggingManager
 	consoleLogLevel zap.AtomicLevel
@@ -94,6 +97,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&cluster.EndpointController{},
 		&cluster.LocalAffiliateController{},
 		&cluster.MemberController{},
+		&cluster.KubernetesCleanupController{},
 		&cluster.KubernetesPullController{},
 		&cluster.KubernetesPushController{},
 		&cluster.NodeIdentityController{
@@ -102,6 +106,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&config.MachineTypeController{},
 		&config.K8sAddressFilterController{},
 		&config.K8sControlPlaneController{},
+		&etcd.MemberStatusController{},
 		&files.CRIConfigPartsController{},
 		&files.CRIRegistryConfigController{},
 		&files.EtcFileController{
@@ -109,6 +114,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			ShadowPath: constants.SystemEtcPath,
 		},
 		&hardware.SystemInfoController{},
+		&k8s.APIServerLoadBalancerConfigController{},
+		&k8s.APIServerLoadBalancerController{},
 		&k8s.ControlPlaneStaticPodController{},
 		&k8s.EndpointController{},
 		&k8s.ExtraManifestController{},
@@ -125,9 +132,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.NodeIPController{},
 		&k8s.NodeIPConfigController{},
 		&k8s.NodenameController{},
+		&k8s.NodeStatusController{},
 		&k8s.RenderConfigsStaticPodController{},
 		&k8s.RenderSecretsStaticPodController{},
 		&k8s.StaticPodConfigController{},
+		&k8s.TalosServiceAccountController{},
 		&kubespan.ConfigController{},
 		&kubespan.EndpointController{},
 		&kubespan.IdentityController{},
@@ -215,6 +224,21 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 			Drainer: drainer,
 		},
+		&runtimecontrollers.RAIDStatusController{
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&runtimecontrollers.UdevEventController{
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&runtimecontrollers.UdevRuleConfigController{},
+		&runtimecontrollers.UdevRuleFileController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.VolumeGroupController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&secrets.APIController{},
 		&secrets.APICertSANsController{},
 		&secrets.EtcdController{},
@@ -226,7 +250,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			Cmdline: procfs.ProcCmdline(),
 		},
 	} {
-		if err := ctrl.controllerRuntime.RegisterController(c); err != nil {
+		if err := ctrl.controllerRuntime.RegisterController(ctrl.stats.wrap(c)); err != nil {
 			return err
 		}
 	}
@@ -239,6 +263,11 @@ func (ctrl *Controller) DependencyGraph() (*controller.DependencyGraph, error) {
 	return ctrl.controllerRuntime.GetDependencyGraph()
 }
 
+// ControllerStats returns reconcile loop statistics of the controllers.
+func (ctrl *Controller) ControllerStats() []runtime.ControllerStats {
+	return ctrl.stats.Get()
+}
+
 func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 	watchCh := make(chan state.Event)
 
@@ -252,7 +281,12 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		return
 	}
 
-	var loggingEndpoints []*url.URL
+	var (
+		loggingEndpoints []*url.URL
+		tracing          tracingState
+	)
+
+	defer tracing.stop()
 
 	for {
 		var cfg talosconfig.Provider
@@ -270,7 +304,66 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 
 		ctrl.updateConsoleLoggingConfig(cfg)
 		ctrl.updateLoggingConfig(ctx, cfg, &loggingEndpoints)
+		ctrl.updateTracingConfig(ctx, cfg, &tracing)
+	}
+}
+
+// tracingState keeps the running trace exporter.
+type tracingState struct {
+	endpoint string
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func (t *tracingState) stop() {
+	if t.cancel != nil {
+		t.cancel()
+		t.wg.Wait()
+
+		t.cancel = nil
+	}
+}
+
+func (ctrl *Controller) updateTracingConfig(ctx context.Context, cfg talosconfig.Provider, tracing *tracingState) {
+	var endpoint string
+
+	endpointURL := cfg.Machine().Features().ControllerTracing().Endpoint()
+	if endpointURL != nil {
+		endpoint = endpointURL.String()
+	}
+
+	if endpoint == tracing.endpoint {
+		return
 	}
+
+	tracing.endpoint = endpoint
+
+	ctrl.stats.setExporter(nil)
+	tracing.stop()
+
+	if endpoint == "" {
+		ctrl.logger.Info("disabling controller tracing")
+
+		return
+	}
+
+	ctrl.logger.Info("enabling controller tracing", zap.String("endpoint", endpoint))
+
+	exporter := otlp.NewExporter(endpointURL, "machined", "controller-runtime")
+
+	var exporterCtx context.Context
+
+	exporterCtx, tracing.cancel = context.WithCancel(ctx)
+
+	tracing.wg.Add(1)
+
+	go func() {
+		defer tracing.wg.Done()
+
+		exporter.Run(exporterCtx, ctrl.logger)
+	}()
+
+	ctrl.stats.setExporter(exporter)
 }
 
 func (ctrl *Controller) updateConsoleLoggingConfig(cfg talosconfig.Provider) {
@@ -16,6 +16,7 @@ import (
 	talosconfig "github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
 	"github.com/talos-systems/talos/pkg/machinery/resources/config"
+	"github.com/talos-systems/talos/pkg/machinery/resources/etcd"
 	"github.com/talos-systems/talos/pkg/machinery/resources/files"
 	"github.com/talos-systems/talos/pkg/machinery/resources/hardware"
 	"github.com/talos-systems/talos/pkg/machinery/resources/k8s"
@@ -63,6 +64,7 @@ func NewState() (*State, error) {
 		{cluster.NamespaceName, "Cluster configuration and discovery resources."},
 		{cluster.RawNamespaceName, "Cluster unmerged raw resources."},
 		{config.NamespaceName, "Talos node configuration."},
+		{etcd.NamespaceName, "etcd resources."},
 		{files.NamespaceName, "Files and file-like resources."},
 		{hardware.NamespaceName, "Hardware resources."},
 		{k8s.NamespaceName, "Kubernetes all node types resources."},
@@ -84,15 +86,19 @@ func NewState() (*State, error) {
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
+		&cluster.Info{},
 		&cluster.Member{},
+		&cluster.RemovedMember{},
 		&config.MachineConfig{},
 		&config.MachineType{},
+		&etcd.MemberStatus{},
 		&files.EtcFileSpec{},
 		&files.EtcFileStatus{},
 		&hardware.Processor{},
 		&hardware.MemoryModule{},
 		&k8s.AdmissionControlConfig{},
 		&k8s.APIServerConfig{},
+		&k8s.APIServerLoadBalancerConfig{},
 		&k8s.ConfigStatus{},
 		&k8s.ControllerManagerConfig{},
 		&k8s.Endpoint{},
@@ -106,6 +112,7 @@ func NewState() (*State, error) {
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
 		&k8s.Nodename{},
+		&k8s.NodeStatus{},
 		&k8s.SchedulerConfig{},
 		&k8s.StaticPod{},
 		&k8s.StaticPodStatus{},
@@ -135,13 +142,20 @@ func NewState() (*State, error) {
 		&network.TimeServerStatus{},
 		&network.TimeServerSpec{},
 		&perf.CPU{},
+		&perf.DiskIO{},
 		&perf.Memory{},
+		&perf.NetIO{},
+		&perf.Pressure{},
 		&runtime.ExtensionStatus{},
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamDefaultSpec{},
 		&runtime.KernelParamStatus{},
+		&runtime.LogicalVolumeStatus{},
 		&runtime.MountStatus{},
+		&runtime.PlatformMetadata{},
+		&runtime.RAIDArrayStatus{},
+		&runtime.UdevRule{},
 		&secrets.API{},
 		&secrets.CertSAN{},
 		&secrets.Etcd{},
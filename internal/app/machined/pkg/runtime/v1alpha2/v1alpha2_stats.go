@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"go.uber.org/zap"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/otlp"
+)
+
+// controllerStats collects reconcile loop statistics of the controllers.
+type controllerStats struct {
+	mu          sync.Mutex
+	controllers []*instrumentedController
+	exporter    *otlp.Exporter
+}
+
+// wrap the controller to collect the statistics.
+func (s *controllerStats) wrap(c controller.Controller) controller.Controller {
+	wrapped := &instrumentedController{
+		Controller: c,
+		registry:   s,
+	}
+
+	wrapped.stats.Name = c.Name()
+
+	s.mu.Lock()
+	s.controllers = append(s.controllers, wrapped)
+	s.mu.Unlock()
+
+	return wrapped
+}
+
+// setExporter sets the exporter for the reconcile spans, nil disables tracing.
+func (s *controllerStats) setExporter(exporter *otlp.Exporter) {
+	s.mu.Lock()
+	s.exporter = exporter
+	s.mu.Unlock()
+}
+
+func (s *controllerStats) export(span otlp.Span) {
+	s.mu.Lock()
+	exporter := s.exporter
+	s.mu.Unlock()
+
+	if exporter != nil {
+		exporter.Export(span)
+	}
+}
+
+// Get returns the snapshot of the statistics in the controller registration order.
+func (s *controllerStats) Get() []runtime.ControllerStats {
+	s.mu.Lock()
+	controllers := append([]*instrumentedController(nil), s.controllers...)
+	s.mu.Unlock()
+
+	result := make([]runtime.ControllerStats, 0, len(controllers))
+
+	for _, c := range controllers {
+		c.mu.Lock()
+		result = append(result, c.stats)
+		c.mu.Unlock()
+	}
+
+	return result
+}
+
+// instrumentedController wraps the controller to track reconcile loops and restarts.
+//
+// Controllers receive a reconcile event at the beginning of each reconcile loop, so the reconcile
+// loop starts when the event is received and finishes when the controller asks for the event channel
+// again.
+type instrumentedController struct {
+	controller.Controller
+
+	registry *controllerStats
+
+	mu          sync.Mutex
+	stats       runtime.ControllerStats
+	lastFailure time.Time
+}
+
+// Run implements controller.Controller interface.
+func (c *instrumentedController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	c.started()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	err := c.Controller.Run(ctx, &instrumentedRuntime{
+		Runtime:    r,
+		ctx:        ctx,
+		controller: c,
+	}, logger)
+
+	c.finished(err)
+
+	return err
+}
+
+func (c *instrumentedController) started() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.lastFailure.IsZero() {
+		c.stats.RestartBackoff = time.Since(c.lastFailure)
+		c.lastFailure = time.Time{}
+	}
+}
+
+func (c *instrumentedController) finished(err error) {
+	c.reconcileDone(err)
+
+	if err == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.RestartCount++
+	c.stats.LastError = err.Error()
+	c.stats.LastErrorTime = time.Now()
+	c.lastFailure = c.stats.LastErrorTime
+}
+
+func (c *instrumentedController) reconcileStarted() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.ReconcileCount++
+	c.stats.Reconciling = true
+	c.stats.ReconcileStarted = time.Now()
+}
+
+func (c *instrumentedController) reconcileDone(err error) {
+	c.mu.Lock()
+
+	if !c.stats.Reconciling {
+		c.mu.Unlock()
+
+		return
+	}
+
+	end := time.Now()
+
+	c.stats.Reconciling = false
+	c.stats.LastReconcileDuration = end.Sub(c.stats.ReconcileStarted)
+
+	span := otlp.Span{
+		Name:  "reconcile",
+		Start: c.stats.ReconcileStarted,
+		End:   end,
+		Attributes: map[string]string{
+			"controller": c.stats.Name,
+		},
+	}
+
+	c.mu.Unlock()
+
+	if err != nil {
+		span.Error = err.Error()
+	}
+
+	c.registry.export(span)
+}
+
+// instrumentedRuntime proxies the reconcile events to the controller to track reconcile loops.
+//
+// The next event is forwarded only after the controller finishes the previous reconcile loop
+// (asks for the event channel again), so the reconcile loop is always marked as started before
+// the event is delivered and before the controller might finish the loop.
+type instrumentedRuntime struct {
+	controller.Runtime
+
+	ctx        context.Context //nolint:containedctx
+	controller *instrumentedController
+
+	once    sync.Once
+	eventCh chan controller.ReconcileEvent
+	readyCh chan struct{}
+}
+
+// EventCh implements controller.Runtime interface.
+func (r *instrumentedRuntime) EventCh() <-chan controller.ReconcileEvent {
+	r.once.Do(func() {
+		r.eventCh = make(chan controller.ReconcileEvent)
+		r.readyCh = make(chan struct{}, 1)
+
+		go r.forward(r.Runtime.EventCh())
+	})
+
+	r.controller.reconcileDone(nil)
+
+	select {
+	case r.readyCh <- struct{}{}:
+	default:
+	}
+
+	return r.eventCh
+}
+
+func (r *instrumentedRuntime) forward(in <-chan controller.ReconcileEvent) {
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-r.readyCh:
+		}
+
+		var event controller.ReconcileEvent
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case event = <-in:
+		}
+
+		r.controller.reconcileStarted()
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case r.eventCh <- event:
+		}
+	}
+}
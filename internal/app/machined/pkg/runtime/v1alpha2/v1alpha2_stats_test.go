@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha2 //nolint:testpackage // to test unexported types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+)
+
+type mockRuntime struct {
+	controller.Runtime
+
+	eventCh chan controller.ReconcileEvent
+}
+
+func (r *mockRuntime) EventCh() <-chan controller.ReconcileEvent {
+	return r.eventCh
+}
+
+type mockController struct {
+	reconciledCh chan struct{}
+	releaseCh    chan struct{}
+
+	onReconcile func()
+}
+
+func (ctrl *mockController) Name() string {
+	return "test.MockController"
+}
+
+func (ctrl *mockController) Inputs() []controller.Input {
+	return nil
+}
+
+func (ctrl *mockController) Outputs() []controller.Output {
+	return nil
+}
+
+func (ctrl *mockController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.New("stopped")
+		case <-r.EventCh():
+		}
+
+		if ctrl.onReconcile != nil {
+			ctrl.onReconcile()
+		}
+
+		ctrl.reconciledCh <- struct{}{}
+
+		<-ctrl.releaseCh
+	}
+}
+
+func TestControllerStats(t *testing.T) {
+	stats := &controllerStats{}
+
+	mock := &mockController{
+		reconciledCh: make(chan struct{}),
+		releaseCh:    make(chan struct{}),
+	}
+
+	wrapped := stats.wrap(mock)
+
+	assert.Equal(t, mock.Name(), wrapped.Name())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &mockRuntime{
+		eventCh: make(chan controller.ReconcileEvent, 1),
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- wrapped.Run(ctx, r, zaptest.NewLogger(t))
+	}()
+
+	for i := 1; i <= 3; i++ {
+		r.eventCh <- controller.ReconcileEvent{}
+
+		select {
+		case <-mock.reconciledCh:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the reconcile loop")
+		}
+
+		// the controller is in the middle of the reconcile loop
+		s := stats.Get()
+		require.Len(t, s, 1)
+
+		assert.Equal(t, "test.MockController", s[0].Name)
+		assert.EqualValues(t, i, s[0].ReconcileCount)
+		assert.True(t, s[0].Reconciling)
+
+		mock.releaseCh <- struct{}{}
+
+		// the reconcile loop is finished when the controller asks for the next event
+		assert.Eventually(t, func() bool {
+			return !stats.Get()[0].Reconciling
+		}, time.Second, time.Millisecond)
+
+		assert.EqualValues(t, i, stats.Get()[0].ReconcileCount)
+	}
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		assert.EqualError(t, err, "stopped")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the controller to stop")
+	}
+
+	s := stats.Get()[0]
+
+	assert.EqualValues(t, 3, s.ReconcileCount)
+	assert.EqualValues(t, 1, s.RestartCount)
+	assert.Equal(t, "stopped", s.LastError)
+	assert.False(t, s.LastErrorTime.IsZero())
+}
+
+func TestControllerStatsFastReconcile(t *testing.T) {
+	stats := &controllerStats{}
+
+	mock := &mockController{
+		reconciledCh: make(chan struct{}, 100),
+		releaseCh:    make(chan struct{}, 100),
+	}
+
+	for i := 0; i < 100; i++ {
+		mock.releaseCh <- struct{}{}
+	}
+
+	var notReconciling int
+
+	mock.onReconcile = func() {
+		if !stats.Get()[0].Reconciling {
+			notReconciling++
+		}
+	}
+
+	wrapped := stats.wrap(mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &mockRuntime{
+		eventCh: make(chan controller.ReconcileEvent),
+	}
+
+	go wrapped.Run(ctx, r, zaptest.NewLogger(t)) //nolint:errcheck
+
+	// the controller finishes reconcile loops immediately, every loop should be accounted for
+	for i := 0; i < 100; i++ {
+		r.eventCh <- controller.ReconcileEvent{}
+	}
+
+	assert.Eventually(t, func() bool {
+		s := stats.Get()[0]
+
+		return s.ReconcileCount == 100 && !s.Reconciling
+	}, time.Second, time.Millisecond)
+
+	assert.Zero(t, notReconciling, "reconcile loop should be marked as started before the event is delivered")
+}
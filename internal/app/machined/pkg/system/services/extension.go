@@ -98,7 +98,7 @@ func (svc *Extension) DependsOn(r runtime.Runtime) []string {
 func (svc *Extension) getOCIOptions() []oci.SpecOpts {
 	ociOpts := []oci.SpecOpts{
 		oci.WithRootFSPath(filepath.Join(constants.ExtensionServicesRootfsPath, svc.Spec.Name)),
-		oci.WithCgroup(constants.CgroupExtensions),
+		oci.WithCgroup(filepath.Join(constants.CgroupExtensions, svc.Spec.Name)),
 		oci.WithMounts(svc.Spec.Container.Mounts),
 		oci.WithHostNamespace(specs.NetworkNamespace),
 		oci.WithSelinuxLabel(""),
@@ -151,6 +151,20 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		env = append(env, fmt.Sprintf("%s=%s", key, val))
 	}
 
+	ociOpts := svc.getOCIOptions()
+
+	// expose local Talos API to the extension services granted access in the machine config
+	if r.Config().Machine().Features().LocalAPIAccess().Service(svc.Spec.Name) != nil {
+		ociOpts = append(ociOpts, oci.WithMounts([]specs.Mount{
+			{
+				Type:        "bind",
+				Destination: constants.LocalAPIContainerPath,
+				Source:      filepath.Dir(constants.LocalAPISocketPath),
+				Options:     []string{"rbind", "ro"},
+			},
+		}))
+	}
+
 	var restartType restart.Type
 
 	switch svc.Spec.Restart {
@@ -169,7 +183,7 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithEnv(env),
-		runner.WithOCISpecOpts(svc.getOCIOptions()...),
+		runner.WithOCISpecOpts(ociOpts...),
 		runner.WithOOMScoreAdj(-600),
 	),
 		restart.WithType(restartType),
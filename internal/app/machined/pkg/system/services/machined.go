@@ -6,16 +6,20 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
 
+	"google.golang.org/grpc"
+
 	v1alpha1server "github.com/talos-systems/talos/internal/app/machined/internal/server/v1alpha1"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/runtime"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/pkg/localapi"
 	"github.com/talos-systems/talos/pkg/conditions"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
 	"github.com/talos-systems/talos/pkg/grpc/middleware/authz"
@@ -24,9 +28,12 @@ import (
 )
 
 var rules = map[string]role.Set{
-	"/cluster.ClusterService/HealthCheck": role.MakeSet(role.Admin, role.Reader),
+	"/cluster.ClusterService/ClearRemovedMember": role.MakeSet(role.Admin),
+	"/cluster.ClusterService/HealthCheck":        role.MakeSet(role.Admin, role.Reader),
+	"/cluster.ClusterService/RemoveMember":       role.MakeSet(role.Admin),
 
 	"/inspect.InspectService/ControllerRuntimeDependencies": role.MakeSet(role.Admin, role.Reader),
+	"/inspect.InspectService/ControllerRuntimeStats":        role.MakeSet(role.Admin, role.Reader),
 
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
@@ -45,6 +52,7 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/Events":                      role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/GenerateClientConfiguration": role.MakeSet(role.Admin),
 	"/machine.MachineService/GenerateConfiguration":       role.MakeSet(role.Admin),
+	"/machine.MachineService/GenerateKubeconfig":          role.MakeSet(role.Admin, role.KubeconfigIssuer),
 	"/machine.MachineService/Hostname":                    role.MakeSet(role.Admin, role.Reader),
 	"/machine.MachineService/Kubeconfig":                  role.MakeSet(role.Admin),
 	"/machine.MachineService/List":                        role.MakeSet(role.Admin, role.Reader),
@@ -138,11 +146,87 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		server.Serve(listener)
 	}()
 
+	// local API is optional, so failure to start it shouldn't bring down the main API
+	localServer, err := s.startLocalAPI(r, logWriter, authorizer)
+	if err != nil {
+		fmt.Fprintf(logWriter, "failed to start local API: %s\n", err)
+	} else {
+		defer localServer.Stop()
+	}
+
 	<-ctx.Done()
 
 	return nil
 }
 
+// startLocalAPI starts the local API server exposed to the extension services over the Unix socket,
+// and to the VM host over vsock if enabled in the machine config.
+//
+// Local API clients are granted roles based on their identity as configured in the machine config.
+func (s *machinedService) startLocalAPI(r runtime.Runtime, logWriter io.Writer, authorizer *authz.Authorizer) (*grpc.Server, error) {
+	injector := &authz.Injector{
+		Mode: authz.PeerCredentials,
+		PeerRoles: (&localapi.RolesResolver{
+			Config: r.Config,
+		}).Roles,
+		Logger: log.New(logWriter, "machined/localapi/authz/injector ", log.Flags()).Printf,
+	}
+
+	server := factory.NewServer(
+		&v1alpha1server.Server{
+			Controller: s.c,
+		},
+		factory.WithLog("machined/localapi ", logWriter),
+		factory.ServerOptions(grpc.Creds(localapi.NewCredentials())),
+
+		factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
+		factory.WithStreamInterceptor(injector.StreamInterceptor()),
+
+		factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
+		factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
+	)
+
+	// socket is accessible only to root, extension services run as root
+	if err := os.MkdirAll(filepath.Dir(constants.LocalAPISocketPath), 0o700); err != nil {
+		server.Stop()
+
+		return nil, err
+	}
+
+	listener, err := factory.NewListener(factory.Network("unix"), factory.SocketPath(constants.LocalAPISocketPath))
+	if err != nil {
+		server.Stop()
+
+		return nil, err
+	}
+
+	go func() {
+		//nolint:errcheck
+		server.Serve(listener)
+	}()
+
+	if r.Config() == nil {
+		return server, nil
+	}
+
+	if vsock := r.Config().Machine().Features().LocalAPIAccess().Vsock(); vsock.Enabled() {
+		vsockListener, listenErr := localapi.ListenVsock(vsock.Port())
+		if listenErr != nil {
+			// vsock might be not available on the platform, so don't fail the service
+			fmt.Fprintf(logWriter, "failed to start local API vsock listener: %s\n", listenErr)
+
+			return server, nil
+		}
+
+		go func() {
+			//nolint:errcheck
+			server.Serve(vsockListener)
+		}()
+	}
+
+	return server, nil
+}
+
 // Machined implements the Service interface. It serves as the concrete type with
 // the required methods.
 type Machined struct {
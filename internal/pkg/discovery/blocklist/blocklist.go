@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package blocklist manages the list of cluster members removed from the cluster.
+//
+// Removed members are stored as RemovedMember resources and persisted to the file
+// on the STATE partition, so that the block survives reboots.
+package blocklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+)
+
+var mu sync.Mutex
+
+// Load creates RemovedMember resources from the persisted list.
+func Load(ctx context.Context, st state.State, path string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	members, err := read(path)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		if err = put(ctx, st, member); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Add blocks the member and persists the list.
+func Add(ctx context.Context, st state.State, path string, member cluster.RemovedMemberSpec) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	members, err := read(path)
+	if err != nil {
+		return err
+	}
+
+	members = append(remove(members, member.NodeID), member)
+
+	if err = write(path, members); err != nil {
+		return err
+	}
+
+	return put(ctx, st, member)
+}
+
+// Remove clears the block of the member and persists the list.
+//
+// Remove returns false if the member was not blocked.
+func Remove(ctx context.Context, st state.State, path, nodeID string) (bool, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	members, err := read(path)
+	if err != nil {
+		return false, err
+	}
+
+	filtered := remove(members, nodeID)
+
+	if len(filtered) != len(members) {
+		if err = write(path, filtered); err != nil {
+			return false, err
+		}
+	}
+
+	md := cluster.NewRemovedMember(cluster.NamespaceName, nodeID).Metadata()
+
+	if err = st.Destroy(ctx, md); err != nil {
+		if state.IsNotFoundError(err) {
+			return len(filtered) != len(members), nil
+		}
+
+		return false, fmt.Errorf("error destroying removed member: %w", err)
+	}
+
+	return true, nil
+}
+
+func put(ctx context.Context, st state.State, member cluster.RemovedMemberSpec) error {
+	res := cluster.NewRemovedMember(cluster.NamespaceName, member.NodeID)
+	*res.TypedSpec() = member
+
+	existing, err := st.Get(ctx, res.Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return st.Create(ctx, res)
+		}
+
+		return fmt.Errorf("error getting removed member: %w", err)
+	}
+
+	res.Metadata().SetVersion(existing.Metadata().Version())
+	res.Metadata().BumpVersion()
+
+	return st.Update(ctx, existing.Metadata().Version(), res)
+}
+
+func remove(members []cluster.RemovedMemberSpec, nodeID string) []cluster.RemovedMemberSpec {
+	result := make([]cluster.RemovedMemberSpec, 0, len(members))
+
+	for _, member := range members {
+		if member.NodeID != nodeID {
+			result = append(result, member)
+		}
+	}
+
+	return result
+}
+
+func read(path string) ([]cluster.RemovedMemberSpec, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading removed members: %w", err)
+	}
+
+	var members []cluster.RemovedMemberSpec
+
+	if err = yaml.Unmarshal(contents, &members); err != nil {
+		return nil, fmt.Errorf("error unmarshaling removed members: %w", err)
+	}
+
+	return members, nil
+}
+
+func write(path string, members []cluster.RemovedMemberSpec) error {
+	sort.Slice(members, func(i, j int) bool { return members[i].NodeID < members[j].NodeID })
+
+	contents, err := yaml.Marshal(members)
+	if err != nil {
+		return fmt.Errorf("error marshaling removed members: %w", err)
+	}
+
+	tmp := path + ".tmp"
+
+	if err = os.WriteFile(tmp, contents, 0o600); err != nil {
+		return fmt.Errorf("error writing removed members: %w", err)
+	}
+
+	if err = os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("error writing removed members: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package blocklist_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/discovery/blocklist"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+)
+
+func TestBlocklist(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "removed-members.yaml")
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	member := cluster.RemovedMemberSpec{
+		NodeID:   "7x1SuC8Ege5BGXdAfTEff5iQnlWZLfv9h1LGMxA2pYkC",
+		Nodename: "worker-1",
+		Hostname: "worker-1.example.com",
+	}
+
+	// nothing persisted yet
+	require.NoError(t, blocklist.Load(ctx, st, path))
+
+	require.NoError(t, blocklist.Add(ctx, st, path, member))
+
+	// adding the same member again updates the resource
+	require.NoError(t, blocklist.Add(ctx, st, path, member))
+
+	res, err := st.Get(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, member.NodeID, resource.VersionUndefined))
+	require.NoError(t, err)
+	assert.Equal(t, member, *res.(*cluster.RemovedMember).TypedSpec())
+
+	// list survives the restart
+	restarted := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, blocklist.Load(ctx, restarted, path))
+
+	res, err = restarted.Get(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, member.NodeID, resource.VersionUndefined))
+	require.NoError(t, err)
+	assert.Equal(t, member, *res.(*cluster.RemovedMember).TypedSpec())
+
+	removed, err := blocklist.Remove(ctx, st, path, member.NodeID)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = blocklist.Remove(ctx, st, path, member.NodeID)
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	restarted = state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, blocklist.Load(ctx, restarted, path))
+
+	list, err := restarted.List(ctx, resource.NewMetadata(cluster.NamespaceName, cluster.RemovedMemberType, "", resource.VersionUndefined))
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+}
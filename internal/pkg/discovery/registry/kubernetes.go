@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +30,21 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
 )
 
+// StaleNodeTimeout is the time after which a Node which stopped reporting its status is considered to be gone.
+//
+// Affiliates are not pulled from stale Nodes, and the discovery annotations of stale Nodes are garbage collected.
+const StaleNodeTimeout = 30 * time.Minute
+
+// discoveryAnnotations is the list of Node annotations managed by the registry.
+var discoveryAnnotations = []string{
+	constants.ClusterNodeIDAnnotation,
+	constants.NetworkSelfIPsAnnotation,
+	constants.KubeSpanIPAnnotation,
+	constants.KubeSpanPublicKeyAnnotation,
+	constants.KubeSpanAssignedPrefixesAnnotation,
+	constants.KubeSpanKnownEndpointsAnnotation,
+}
+
 // Kubernetes defines a Kubernetes-based node discoverer.
 type Kubernetes struct {
 	client *kubernetes.Client
@@ -93,12 +109,9 @@ func AffiliateFromNode(node *v1.Node) *cluster.AffiliateSpec {
 	}
 
 	// Machine type is derived from node roles.
-	_, labelMaster := node.Labels[constants.LabelNodeRoleMaster]
-	_, labelControlPlane := node.Labels[constants.LabelNodeRoleControlPlane]
-
 	affiliate.MachineType = machine.TypeWorker
 
-	if labelMaster || labelControlPlane {
+	if isControlPlaneNode(node) {
 		affiliate.MachineType = machine.TypeControlPlane
 	}
 
@@ -124,6 +137,45 @@ func AffiliateFromNode(node *v1.Node) *cluster.AffiliateSpec {
 	return affiliate
 }
 
+// RemovedMembersFromNode returns the node IDs removed from the cluster recorded in the Node annotations.
+func RemovedMembersFromNode(node *v1.Node) []string {
+	var result []string
+
+	for _, nodeID := range strings.Split(node.Annotations[constants.ClusterRemovedMembersAnnotation], ",") {
+		if nodeID != "" {
+			result = append(result, nodeID)
+		}
+	}
+
+	return result
+}
+
+func isControlPlaneNode(node *v1.Node) bool {
+	_, labelMaster := node.Labels[constants.LabelNodeRoleMaster]
+	_, labelControlPlane := node.Labels[constants.LabelNodeRoleControlPlane]
+
+	return labelMaster || labelControlPlane
+}
+
+// IsStaleNode returns true if the Node hasn't reported its status for longer than the timeout.
+//
+// Nodes which never reported their status are not considered to be stale.
+func IsStaleNode(node *v1.Node, now time.Time, timeout time.Duration) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != v1.NodeReady {
+			continue
+		}
+
+		if condition.Status == v1.ConditionTrue {
+			return false
+		}
+
+		return !condition.LastHeartbeatTime.IsZero() && now.Sub(condition.LastHeartbeatTime.Time) > timeout
+	}
+
+	return false
+}
+
 func ipsToString(in []netaddr.IP) string {
 	items := make([]string, len(in))
 
@@ -191,10 +243,168 @@ func parseIPPorts(in string) []netaddr.IPPort {
 }
 
 // Push updates Kubernetes Node resource to track Affiliate state.
+//
+// If the Node annotations were pushed by a node with a different identity (e.g. the node was reinstalled),
+// they are overwritten.
 func (r *Kubernetes) Push(ctx context.Context, affiliate *cluster.Affiliate) error {
-	node, err := r.client.CoreV1().Nodes().Get(ctx, affiliate.TypedSpec().Nodename, metav1.GetOptions{})
+	return r.patchAnnotations(ctx, affiliate.TypedSpec().Nodename, func(annotations map[string]string) bool {
+		for key, value := range AnnotationsFromAffiliate(affiliate) {
+			if value == "" {
+				delete(annotations, key)
+			} else {
+				annotations[key] = value
+			}
+		}
+
+		return true
+	})
+}
+
+// Delete removes discovery annotations from the Kubernetes Node resource.
+//
+// Annotations are only removed if they were pushed by the node with the specified node ID.
+func (r *Kubernetes) Delete(ctx context.Context, nodename, nodeID string) error {
+	err := r.patchAnnotations(ctx, nodename, func(annotations map[string]string) bool {
+		if annotations[constants.ClusterNodeIDAnnotation] != nodeID {
+			return false
+		}
+
+		for _, key := range discoveryAnnotations {
+			delete(annotations, key)
+		}
+
+		return true
+	})
+
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// Cleanup removes discovery annotations from the stale Kubernetes Node resources.
+//
+// Names of the cleaned up Nodes are returned.
+func (r *Kubernetes) Cleanup(ctx context.Context, timeout time.Duration) ([]string, error) {
+	nodes, err := r.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	var cleaned []string
+
+	now := time.Now()
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+
+		nodeID, ok := node.Annotations[constants.ClusterNodeIDAnnotation]
+		if !ok || !IsStaleNode(node, now, timeout) {
+			continue
+		}
+
+		if err = r.Delete(ctx, node.Name, nodeID); err != nil {
+			return cleaned, err
+		}
+
+		cleaned = append(cleaned, node.Name)
+	}
+
+	return cleaned, nil
+}
+
+// BlockMember records the node ID as removed from the cluster in the annotations of the control plane Nodes.
+//
+// The list of removed members is pulled from the registry by every node, so the block applies to the whole cluster,
+// including the nodes which join the cluster later.
+func (r *Kubernetes) BlockMember(ctx context.Context, nodeID string) error {
+	return r.updateRemovedMembers(ctx, func(nodeIDs map[string]struct{}) {
+		nodeIDs[nodeID] = struct{}{}
+	})
+}
+
+// UnblockMember removes the node ID from the list of members removed from the cluster.
+func (r *Kubernetes) UnblockMember(ctx context.Context, nodeID string) error {
+	return r.updateRemovedMembers(ctx, func(nodeIDs map[string]struct{}) {
+		delete(nodeIDs, nodeID)
+	})
+}
+
+// updateRemovedMembers updates the list of removed members on every control plane Node, and on every Node which has the list.
+func (r *Kubernetes) updateRemovedMembers(ctx context.Context, update func(nodeIDs map[string]struct{})) error {
+	nodes, err := r.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get node %q: %w", affiliate.TypedSpec().Nodename, err)
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	updated := 0
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+
+		if _, ok := node.Annotations[constants.ClusterRemovedMembersAnnotation]; !ok && !isControlPlaneNode(node) {
+			continue
+		}
+
+		if err = r.patchAnnotations(ctx, node.Name, func(annotations map[string]string) bool {
+			nodeIDs := make(map[string]struct{})
+
+			for _, nodeID := range strings.Split(annotations[constants.ClusterRemovedMembersAnnotation], ",") {
+				if nodeID != "" {
+					nodeIDs[nodeID] = struct{}{}
+				}
+			}
+
+			update(nodeIDs)
+
+			value := stringSetToString(nodeIDs)
+
+			current, ok := annotations[constants.ClusterRemovedMembersAnnotation]
+			if ok && current == value {
+				return false
+			}
+
+			if value == "" {
+				delete(annotations, constants.ClusterRemovedMembersAnnotation)
+
+				return ok
+			}
+
+			annotations[constants.ClusterRemovedMembersAnnotation] = value
+
+			return true
+		}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		updated++
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("no control plane nodes found")
+	}
+
+	return nil
+}
+
+func stringSetToString(in map[string]struct{}) string {
+	items := make([]string, 0, len(in))
+
+	for item := range in {
+		items = append(items, item)
+	}
+
+	sort.Strings(items)
+
+	return strings.Join(items, ",")
+}
+
+// patchAnnotations updates annotations of the Node resource, update is skipped if the callback returns false.
+func (r *Kubernetes) patchAnnotations(ctx context.Context, nodename string, update func(annotations map[string]string) bool) error {
+	node, err := r.client.CoreV1().Nodes().Get(ctx, nodename, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get node %q: %w", nodename, err)
 	}
 
 	oldData, err := json.Marshal(node)
@@ -202,12 +412,12 @@ func (r *Kubernetes) Push(ctx context.Context, affiliate *cluster.Affiliate) err
 		return fmt.Errorf("failed to marshal existing node data: %w", err)
 	}
 
-	for key, value := range AnnotationsFromAffiliate(affiliate) {
-		if value == "" {
-			delete(node.Annotations, key)
-		} else {
-			node.Annotations[key] = value
-		}
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	if !update(node.Annotations) {
+		return nil
 	}
 
 	newData, err := json.Marshal(node)
@@ -220,12 +430,12 @@ func (r *Kubernetes) Push(ctx context.Context, affiliate *cluster.Affiliate) err
 		return fmt.Errorf("failed to create two way merge patch: %w", err)
 	}
 
-	if _, err := r.client.CoreV1().Nodes().Patch(ctx, affiliate.TypedSpec().Nodename, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+	if _, err := r.client.CoreV1().Nodes().Patch(ctx, nodename, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
 		if apierrors.IsConflict(err) {
-			return fmt.Errorf("unable to update node %q due to conflict: %w", affiliate.TypedSpec().Nodename, err)
+			return fmt.Errorf("unable to update node %q due to conflict: %w", nodename, err)
 		}
 
-		return fmt.Errorf("error patching node %q: %w", affiliate.TypedSpec().Nodename, err)
+		return fmt.Errorf("error patching node %q: %w", nodename, err)
 	}
 
 	return nil
@@ -246,12 +456,19 @@ func (r *Kubernetes) List(localNodeName string) ([]*cluster.AffiliateSpec, error
 
 	result := make([]*cluster.AffiliateSpec, 0, len(nodes))
 
+	now := time.Now()
+
 	for _, node := range nodes {
 		// skip this node, no need to pull itself
 		if node.Name == localNodeName {
 			continue
 		}
 
+		// skip nodes which are gone, their annotations will be garbage collected
+		if IsStaleNode(node, now, StaleNodeTimeout) {
+			continue
+		}
+
 		affiliate := AffiliateFromNode(node)
 		if affiliate == nil {
 			continue
@@ -263,6 +480,38 @@ func (r *Kubernetes) List(localNodeName string) ([]*cluster.AffiliateSpec, error
 	return result, nil
 }
 
+// RemovedMembers returns the node IDs removed from the cluster.
+//
+// Watch should be called first for the RemovedMembers to return data.
+func (r *Kubernetes) RemovedMembers() ([]string, error) {
+	if r.nodes == nil {
+		return nil, fmt.Errorf("RemovedMembers() called without Watch() first")
+	}
+
+	nodes, err := r.nodes.Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	nodeIDs := make(map[string]struct{})
+
+	for _, node := range nodes {
+		for _, nodeID := range RemovedMembersFromNode(node) {
+			nodeIDs[nodeID] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(nodeIDs))
+
+	for nodeID := range nodeIDs {
+		result = append(result, nodeID)
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
 // Watch starts watching Node state and notifies on updates via notify channel.
 func (r *Kubernetes) Watch(ctx context.Context, logger *zap.Logger) (<-chan struct{}, error) {
 	informerFactory := informers.NewSharedInformerFactory(r.client.Clientset, 30*time.Second)
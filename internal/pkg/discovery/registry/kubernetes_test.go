@@ -6,6 +6,7 @@ package registry_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"inet.af/netaddr"
@@ -141,3 +142,74 @@ func TestAffiliateFromNode(t *testing.T) {
 		})
 	}
 }
+
+func TestIsStaleNode(t *testing.T) {
+	now := time.Now()
+
+	nodeWithReady := func(status v1.ConditionStatus, heartbeat time.Time) *v1.Node {
+		return &v1.Node{
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{
+						Type:   v1.NodeMemoryPressure,
+						Status: v1.ConditionFalse,
+					},
+					{
+						Type:              v1.NodeReady,
+						Status:            status,
+						LastHeartbeatTime: metav1.NewTime(heartbeat),
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		node     *v1.Node
+		expected bool
+	}{
+		{
+			name: "no status",
+			node: &v1.Node{},
+		},
+		{
+			name: "ready",
+			node: nodeWithReady(v1.ConditionTrue, now.Add(-time.Hour)),
+		},
+		{
+			name: "recently not ready",
+			node: nodeWithReady(v1.ConditionUnknown, now.Add(-time.Minute)),
+		},
+		{
+			name:     "not ready",
+			node:     nodeWithReady(v1.ConditionUnknown, now.Add(-time.Hour)),
+			expected: true,
+		},
+		{
+			name: "no heartbeat",
+			node: nodeWithReady(v1.ConditionFalse, time.Time{}),
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, registry.IsStaleNode(tt.node, now, registry.StaleNodeTimeout))
+		})
+	}
+}
+
+func TestRemovedMembersFromNode(t *testing.T) {
+	assert.Empty(t, registry.RemovedMembersFromNode(&v1.Node{}))
+
+	assert.Equal(t,
+		[]string{"7x1SuC8Ege5BGXdAfTEff5iQnlWZLfv9h1LGMxA2pYkC", "9dwHNUViZlPlIervqX9Qo256RUhrfhgO0xBBnKcKl4F"},
+		registry.RemovedMembersFromNode(&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					constants.ClusterRemovedMembersAnnotation: "7x1SuC8Ege5BGXdAfTEff5iQnlWZLfv9h1LGMxA2pYkC,9dwHNUViZlPlIervqX9Qo256RUhrfhgO0xBBnKcKl4F",
+				},
+			},
+		}),
+	)
+}
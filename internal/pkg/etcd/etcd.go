@@ -6,6 +6,8 @@ package etcd
 
 import (
 	"context"
+	"crypto/tls"
+	stdlibx509 "crypto/x509"
 	"errors"
 	"fmt"
 	"log"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/talos-systems/crypto/x509"
 	"github.com/talos-systems/net"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
@@ -51,6 +54,31 @@ func NewClient(endpoints []string) (client *Client, err error) {
 		return nil, fmt.Errorf("error building etcd client TLS config: %w", err)
 	}
 
+	return newClient(endpoints, tlsConfig)
+}
+
+// NewClientWithCertificate initializes and returns an etcd client configured to talk to
+// a list of endpoints using the in-memory client certificate.
+func NewClientWithCertificate(endpoints []string, ca []byte, cert *x509.PEMEncodedCertificateAndKey) (client *Client, err error) {
+	keyPair, err := tls.X509KeyPair(cert.Crt, cert.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error loading etcd client certificate: %w", err)
+	}
+
+	pool := stdlibx509.NewCertPool()
+
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("error loading etcd CA certificate")
+	}
+
+	return newClient(endpoints, &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	})
+}
+
+func newClient(endpoints []string, tlsConfig *tls.Config) (*Client, error) {
 	c, err := clientv3.New(clientv3.Config{
 		Endpoints:   endpoints,
 		DialTimeout: 5 * time.Second,
@@ -73,6 +101,16 @@ func NewLocalClient() (client *Client, err error) {
 // NewClientFromControlPlaneIPs initializes and returns an etcd client
 // configured to talk to all members.
 func NewClientFromControlPlaneIPs(ctx context.Context, resources state.State) (client *Client, err error) {
+	endpoints, err := ControlPlaneEndpoints(ctx, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(endpoints)
+}
+
+// ControlPlaneEndpoints returns etcd client endpoints of all control plane nodes.
+func ControlPlaneEndpoints(ctx context.Context, resources state.State) ([]string, error) {
 	endpointResources, err := resources.List(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.EndpointType, "", resource.VersionUndefined))
 	if err != nil {
 		return nil, fmt.Errorf("error getting endpoints resources: %w", err)
@@ -96,7 +134,7 @@ func NewClientFromControlPlaneIPs(ctx context.Context, resources state.State) (c
 		endpoints[i] = net.FormatAddress(endpoints[i]) + ":2379"
 	}
 
-	return NewClient(endpoints)
+	return endpoints, nil
 }
 
 // ValidateForUpgrade validates the etcd cluster state to ensure that performing
@@ -127,7 +165,7 @@ func (c *Client) ValidateForUpgrade(ctx context.Context, config config.Provider,
 			return fmt.Errorf("etcd member %016x is not started, all members must be running to perform an upgrade", member.ID)
 		}
 
-		if err = validateMemberHealth(ctx, member.GetClientURLs()); err != nil {
+		if err = ValidateMemberHealth(ctx, member.GetClientURLs()); err != nil {
 			return fmt.Errorf("etcd member %016x is not healthy; all members must be healthy to perform an upgrade: %w", member.ID, err)
 		}
 	}
@@ -135,6 +173,47 @@ func (c *Client) ValidateForUpgrade(ctx context.Context, config config.Provider,
 	return nil
 }
 
+// ValidateForMembershipChange validates the etcd cluster state to ensure that adding
+// or removing a member is safe.
+//
+// Health of every member is checked only for the member removal, as the node which
+// is going to join the cluster doesn't have etcd certificates on disk yet.
+func (c *Client) ValidateForMembershipChange(ctx context.Context, removing bool) error {
+	if err := c.ValidateQuorum(ctx); err != nil {
+		return fmt.Errorf("etcd cluster doesn't have quorum: %w", err)
+	}
+
+	resp, err := c.MemberList(ctx)
+	if err != nil {
+		return err
+	}
+
+	if removing && len(resp.Members) == 1 {
+		return fmt.Errorf("only 1 etcd member found, refusing to remove the last member")
+	}
+
+	for _, member := range resp.Members {
+		if member.IsLearner {
+			return fmt.Errorf("etcd member %016x is a learner, wait for it to be promoted", member.ID)
+		}
+
+		// If the member is not started, the name will be an empty string.
+		if len(member.Name) == 0 {
+			return fmt.Errorf("etcd member %016x is not started, all members must be running", member.ID)
+		}
+
+		if !removing {
+			continue
+		}
+
+		if err = ValidateMemberHealth(ctx, member.GetClientURLs()); err != nil {
+			return fmt.Errorf("etcd member %016x is not healthy; all members must be healthy: %w", member.ID, err)
+		}
+	}
+
+	return nil
+}
+
 // ValidateQuorum performs a KV operation to make certain that quorum is good.
 func (c *Client) ValidateQuorum(ctx context.Context) (err error) {
 	// Get a random key. As long as we can get the response without an error, quorum is good.
@@ -154,12 +233,16 @@ func (c *Client) ValidateQuorum(ctx context.Context) (err error) {
 	return nil
 }
 
-func validateMemberHealth(ctx context.Context, memberURIs []string) (err error) {
+// ValidateMemberHealth checks the health of the member using the member client URLs.
+func ValidateMemberHealth(ctx context.Context, memberURIs []string) (err error) {
 	c, err := NewClient(memberURIs)
 	if err != nil {
 		return fmt.Errorf("failed to create client to member: %w", err)
 	}
 
+	//nolint:errcheck
+	defer c.Close()
+
 	return c.ValidateQuorum(ctx)
 }
 
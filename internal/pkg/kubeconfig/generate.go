@@ -7,6 +7,7 @@ package kubeconfig
 import (
 	stdlibx509 "crypto/x509"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
@@ -78,6 +79,8 @@ type GenerateInput struct {
 
 	CommonName   string
 	Organization string
+	// Groups are added to the client certificate as extra organizations.
+	Groups []string
 
 	Endpoint    string
 	Username    string
@@ -98,9 +101,17 @@ func Generate(in *GenerateInput, out io.Writer) error {
 		return fmt.Errorf("error getting Kubernetes CA: %w", err)
 	}
 
+	var organizations []string
+
+	if in.Organization != "" {
+		organizations = append(organizations, in.Organization)
+	}
+
+	organizations = append(organizations, in.Groups...)
+
 	clientCert, err := x509.NewKeyPair(k8sCA,
 		x509.CommonName(in.CommonName),
-		x509.Organization(in.Organization),
+		x509.Organization(organizations...),
 		x509.NotAfter(time.Now().Add(in.CertificateLifetime)),
 		x509.KeyUsage(stdlibx509.KeyUsageDigitalSignature|stdlibx509.KeyUsageKeyEncipherment),
 		x509.ExtKeyUsage([]stdlibx509.ExtKeyUsage{
@@ -127,6 +138,73 @@ func Generate(in *GenerateInput, out io.Writer) error {
 	})
 }
 
+const oidcKubeConfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: {{ .ClusterName }}
+  cluster:
+    server: {{ .Endpoint }}
+    certificate-authority-data: {{ .CACert | base64Encode }}
+users:
+- name: oidc@{{ .ClusterName }}
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubectl
+      args:
+      - oidc-login
+      - get-token
+      - {{ printf "--oidc-issuer-url=%s" .IssuerURL | json }}
+      - {{ printf "--oidc-client-id=%s" .ClientID | json }}
+      {{- if .ClientSecret }}
+      - {{ printf "--oidc-client-secret=%s" .ClientSecret | json }}
+      {{- end }}
+      {{- range $scope := .ExtraScopes }}
+      - {{ printf "--oidc-extra-scope=%s" $scope | json }}
+      {{- end }}
+contexts:
+- context:
+    cluster: {{ .ClusterName }}
+    namespace: default
+    user: oidc@{{ .ClusterName }}
+  name: oidc@{{ .ClusterName }}
+current-context: oidc@{{ .ClusterName }}
+`
+
+// GenerateOIDCInput are input parameters for GenerateOIDC.
+type GenerateOIDCInput struct {
+	ClusterName string
+	CACert      string
+	Endpoint    string
+
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	ExtraScopes  []string
+}
+
+// GenerateOIDC generates a kubeconfig for the cluster which uses OIDC login helper
+// (https://github.com/int128/kubelogin) as a client-go credential plugin.
+//
+// Generated kubeconfig doesn't contain any credentials.
+func GenerateOIDC(in *GenerateOIDCInput, out io.Writer) error {
+	tpl, err := template.New("kubeconfig").Funcs(template.FuncMap{
+		"base64Encode": base64Encode,
+		"json":         jsonify,
+	}).Parse(oidcKubeConfigTemplate)
+	if err != nil {
+		return fmt.Errorf("error parsing kubeconfig template: %w", err)
+	}
+
+	return tpl.Execute(out, in)
+}
+
+func jsonify(input string) (string, error) {
+	out, err := json.Marshal(input)
+
+	return string(out), err
+}
+
 func base64Encode(content interface{}) (string, error) {
 	str, ok := content.(string)
 	if !ok {
@@ -6,6 +6,8 @@ package kubeconfig_test
 
 import (
 	"bytes"
+	stdlibx509 "crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net/url"
 	"testing"
@@ -94,6 +96,79 @@ func (suite *GenerateSuite) TestGenerate() {
 	suite.Assert().NoError(clientcmd.ConfirmUsable(*config, "kube-controller-manager@foo"))
 }
 
+func (suite *GenerateSuite) TestGenerateGroups() {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	input := kubeconfig.GenerateInput{
+		ClusterName: "foo",
+
+		CA:                  x509.NewCertificateAndKeyFromCertificateAuthority(ca),
+		CertificateLifetime: time.Hour,
+
+		CommonName: "jane",
+		Groups:     []string{"developers", "auditors"},
+
+		Endpoint:    "https://localhost:6443/",
+		Username:    "jane",
+		ContextName: "jane",
+	}
+
+	var buf bytes.Buffer
+
+	suite.Require().NoError(kubeconfig.Generate(&input, &buf))
+
+	config, err := clientcmd.Load(buf.Bytes())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(clientcmd.ConfirmUsable(*config, "jane@foo"))
+
+	block, _ := pem.Decode(config.AuthInfos["jane@foo"].ClientCertificateData)
+	suite.Require().NotNil(block)
+
+	cert, err := stdlibx509.ParseCertificate(block.Bytes)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal("jane", cert.Subject.CommonName)
+	suite.Assert().Equal([]string{"developers", "auditors"}, cert.Subject.Organization)
+}
+
+func (suite *GenerateSuite) TestGenerateOIDC() {
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.RSA(false))
+	suite.Require().NoError(err)
+
+	var buf bytes.Buffer
+
+	suite.Require().NoError(kubeconfig.GenerateOIDC(&kubeconfig.GenerateOIDCInput{
+		ClusterName: "foo",
+		CACert:      string(ca.CrtPEM),
+		Endpoint:    "https://localhost:6443/",
+
+		IssuerURL:   "https://accounts.example.com",
+		ClientID:    "kubernetes",
+		ExtraScopes: []string{"email", "groups"},
+	}, &buf))
+
+	config, err := clientcmd.Load(buf.Bytes())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(clientcmd.ConfirmUsable(*config, "oidc@foo"))
+
+	authInfo := config.AuthInfos["oidc@foo"]
+	suite.Require().NotNil(authInfo.Exec)
+
+	suite.Assert().Empty(authInfo.ClientCertificateData)
+	suite.Assert().Equal("kubectl", authInfo.Exec.Command)
+	suite.Assert().Equal([]string{
+		"oidc-login",
+		"get-token",
+		"--oidc-issuer-url=https://accounts.example.com",
+		"--oidc-client-id=kubernetes",
+		"--oidc-extra-scope=email",
+		"--oidc-extra-scope=groups",
+	}, authInfo.Exec.Args)
+}
+
 func TestGenerateSuite(t *testing.T) {
 	suite.Run(t, new(GenerateSuite))
 }
@@ -0,0 +1,39 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package localapi
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// ServiceFromCgroup returns the name of the extension service based on the contents of /proc/<pid>/cgroup.
+//
+// Each extension service runs in its own cgroup under constants.CgroupExtensions.
+// Empty string is returned if the process doesn't belong to any extension service.
+func ServiceFromCgroup(r io.Reader) (string, error) {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		path := strings.TrimPrefix(parts[2], constants.CgroupExtensions+"/")
+		if path == parts[2] {
+			continue
+		}
+
+		// processes might create nested cgroups
+		return strings.SplitN(path, "/", 2)[0], nil
+	}
+
+	return "", scanner.Err()
+}
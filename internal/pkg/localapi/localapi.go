@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package localapi implements the transport for the local Talos API exposed to the extension services
+// over the Unix socket and to the VM host over the virtio-vsock.
+//
+// Local API clients don't present client certificates, the identity of the client is derived
+// from the transport: the peer credentials of the Unix socket connection are resolved to the extension service name,
+// and the vsock connections are identified by the context ID of the peer.
+package localapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/credentials"
+)
+
+// AuthType is the authentication type of the local API connections.
+const AuthType = "localapi"
+
+// AuthInfo describes the identity of the local API client.
+type AuthInfo struct {
+	credentials.CommonAuthInfo
+
+	// Service is the name of the extension service the Unix socket peer belongs to.
+	//
+	// Service is empty if the peer is not an extension service.
+	Service string
+
+	// PID and UID of the Unix socket peer.
+	PID int32
+	UID uint32
+
+	// Vsock is set for the connections accepted over the vsock.
+	Vsock bool
+	// CID is the context ID of the vsock peer.
+	CID uint32
+}
+
+// AuthType implements credentials.AuthInfo.
+func (info AuthInfo) AuthType() string {
+	return AuthType
+}
+
+// Credentials implements server-side credentials.TransportCredentials for the local API listeners.
+type Credentials struct{}
+
+// NewCredentials creates new local API credentials.
+func NewCredentials() credentials.TransportCredentials {
+	return &Credentials{}
+}
+
+// ClientHandshake implements credentials.TransportCredentials.
+func (c *Credentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("local API credentials can only be used on the server side")
+}
+
+// ServerHandshake implements credentials.TransportCredentials.
+func (c *Credentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	switch typedConn := conn.(type) {
+	case *net.UnixConn:
+		info, err := unixAuthInfo(typedConn)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return conn, info, nil
+	case *vsockConn:
+		return conn, AuthInfo{
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+			Vsock:          true,
+			CID:            typedConn.remote.ContextID,
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported local API connection type %T", conn)
+	}
+}
+
+// Info implements credentials.TransportCredentials.
+func (c *Credentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{
+		SecurityProtocol: AuthType,
+	}
+}
+
+// Clone implements credentials.TransportCredentials.
+func (c *Credentials) Clone() credentials.TransportCredentials {
+	return &Credentials{}
+}
+
+// OverrideServerName implements credentials.TransportCredentials.
+func (c *Credentials) OverrideServerName(string) error {
+	return nil
+}
+
+func unixAuthInfo(conn *net.UnixConn) (AuthInfo, error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return AuthInfo{}, err
+	}
+
+	var (
+		cred    *unix.Ucred
+		credErr error
+	)
+
+	if err = rawConn.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return AuthInfo{}, err
+	}
+
+	if credErr != nil {
+		return AuthInfo{}, fmt.Errorf("error getting peer credentials: %w", credErr)
+	}
+
+	info := AuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		PID:            cred.Pid,
+		UID:            cred.Uid,
+	}
+
+	f, err := os.Open(fmt.Sprintf("/proc/%d/cgroup", cred.Pid))
+	if err != nil {
+		return AuthInfo{}, fmt.Errorf("error reading peer cgroup: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	info.Service, err = ServiceFromCgroup(f)
+	if err != nil {
+		return AuthInfo{}, err
+	}
+
+	return info, nil
+}
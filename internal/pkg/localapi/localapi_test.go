@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package localapi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/internal/pkg/localapi"
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+func TestServiceFromCgroup(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		cgroup   string
+		expected string
+	}{
+		{
+			name:     "extension",
+			cgroup:   "0::/system/extensions/agent\n",
+			expected: "agent",
+		},
+		{
+			name:     "nested",
+			cgroup:   "0::/system/extensions/agent/worker\n",
+			expected: "agent",
+		},
+		{
+			name:   "system",
+			cgroup: "0::/system/runtime\n",
+		},
+		{
+			name:   "similar prefix",
+			cgroup: "0::/system/extensions-agent\n",
+		},
+		{
+			name:     "legacy hierarchy",
+			cgroup:   "2:memory:/system/extensions/agent\n1:cpu:/system/extensions/agent\n",
+			expected: "agent",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, err := localapi.ServiceFromCgroup(strings.NewReader(tt.cgroup))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, service)
+		})
+	}
+}
+
+func TestRolesResolver(t *testing.T) {
+	t.Parallel()
+
+	cfg := &v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				LocalAPIAccessConfig: &v1alpha1.LocalAPIAccessConfig{
+					LocalAPIServices: []*v1alpha1.LocalAPIServiceConfig{
+						{
+							LocalAPIServiceName:         "agent",
+							LocalAPIServiceAllowedRoles: []string{"os:reader"},
+						},
+					},
+					LocalAPIVsock: &v1alpha1.LocalAPIVsockConfig{
+						LocalAPIVsockEnabled:      true,
+						LocalAPIVsockAllowedRoles: []string{"os:admin"},
+					},
+				},
+			},
+		},
+	}
+
+	resolver := &localapi.RolesResolver{
+		Config: func() config.Provider { return cfg },
+	}
+
+	for _, tt := range []struct {
+		name     string
+		info     localapi.AuthInfo
+		expected role.Set
+	}{
+		{
+			name:     "allowed service",
+			info:     localapi.AuthInfo{Service: "agent"},
+			expected: role.MakeSet(role.Reader),
+		},
+		{
+			name:     "unknown service",
+			info:     localapi.AuthInfo{Service: "other"},
+			expected: role.Zero,
+		},
+		{
+			name:     "not a service",
+			info:     localapi.AuthInfo{},
+			expected: role.Zero,
+		},
+		{
+			name:     "vsock",
+			info:     localapi.AuthInfo{Vsock: true, CID: 2},
+			expected: role.MakeSet(role.Admin),
+		},
+		{
+			name:     "vsock from another VM",
+			info:     localapi.AuthInfo{Vsock: true, CID: 3},
+			expected: role.Zero,
+		},
+		{
+			name:     "vsock from any CID",
+			info:     localapi.AuthInfo{Vsock: true, CID: 0xffffffff},
+			expected: role.Zero,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected.Strings(), resolver.Roles(&peer.Peer{AuthInfo: tt.info}).Strings())
+		})
+	}
+}
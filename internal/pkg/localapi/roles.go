@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package localapi
+
+import (
+	"google.golang.org/grpc/peer"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+)
+
+// RolesResolver grants the roles to the local API clients based on the machine configuration.
+type RolesResolver struct {
+	// Config returns the current machine configuration.
+	Config func() config.Provider
+}
+
+// Roles returns the roles granted to the local API peer.
+//
+// Peers which are not listed in the machine configuration don't get any roles,
+// vsock peers other than the hypervisor host don't get any roles either.
+func (r *RolesResolver) Roles(p *peer.Peer) role.Set {
+	info, ok := p.AuthInfo.(AuthInfo)
+	if !ok {
+		return role.Zero
+	}
+
+	cfg := r.Config()
+	if cfg == nil {
+		return role.Zero
+	}
+
+	access := cfg.Machine().Features().LocalAPIAccess()
+
+	var allowedRoles []string
+
+	switch {
+	case info.Vsock:
+		if !access.Vsock().Enabled() || !IsHostCID(info.CID) {
+			return role.Zero
+		}
+
+		allowedRoles = access.Vsock().AllowedRoles()
+	case info.Service != "":
+		service := access.Service(info.Service)
+		if service == nil {
+			return role.Zero
+		}
+
+		allowedRoles = service.AllowedRoles()
+	default:
+		return role.Zero
+	}
+
+	roles, _ := role.Parse(allowedRoles)
+
+	return roles
+}
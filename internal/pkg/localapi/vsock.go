@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package localapi
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// VsockAddr is the address of the vsock endpoint.
+type VsockAddr struct {
+	ContextID uint32
+	Port      uint32
+}
+
+// Network implements net.Addr.
+func (a *VsockAddr) Network() string {
+	return "vsock"
+}
+
+// String implements net.Addr.
+func (a *VsockAddr) String() string {
+	return fmt.Sprintf("vm(%d):%d", a.ContextID, a.Port)
+}
+
+// IsHostCID returns true if the vsock context ID belongs to the hypervisor host.
+func IsHostCID(cid uint32) bool {
+	return cid == unix.VMADDR_CID_HOST
+}
+
+type vsockListener struct {
+	f    *os.File
+	addr *VsockAddr
+}
+
+// ListenVsock creates a listener on the vsock port accepting connections only from the hypervisor host.
+//
+// The socket is bound to any local context ID, as the guest CID is assigned by the hypervisor,
+// but connections from other context IDs (e.g. other VMs) are dropped in Accept.
+//
+// Go standard library doesn't support AF_VSOCK sockets, so the socket is wrapped with os.File
+// to get the runtime poller support.
+func ListenVsock(port uint32) (net.Listener, error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error creating vsock socket: %w", err)
+	}
+
+	if err = unix.Bind(fd, &unix.SockaddrVM{CID: unix.VMADDR_CID_ANY, Port: port}); err != nil {
+		unix.Close(fd) //nolint:errcheck
+
+		return nil, fmt.Errorf("error binding vsock port %d: %w", port, err)
+	}
+
+	if err = unix.Listen(fd, unix.SOMAXCONN); err != nil {
+		unix.Close(fd) //nolint:errcheck
+
+		return nil, fmt.Errorf("error listening on vsock port %d: %w", port, err)
+	}
+
+	return &vsockListener{
+		f: os.NewFile(uintptr(fd), fmt.Sprintf("vsock:%d", port)),
+		addr: &VsockAddr{
+			ContextID: unix.VMADDR_CID_ANY,
+			Port:      port,
+		},
+	}, nil
+}
+
+// Accept implements net.Listener.
+func (l *vsockListener) Accept() (net.Conn, error) {
+	rawConn, err := l.f.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var (
+			nfd       int
+			sa        unix.Sockaddr
+			acceptErr error
+		)
+
+		if err = rawConn.Read(func(fd uintptr) bool {
+			nfd, sa, acceptErr = unix.Accept4(int(fd), unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC)
+
+			return acceptErr != unix.EAGAIN
+		}); err != nil {
+			return nil, err
+		}
+
+		if acceptErr != nil {
+			return nil, acceptErr
+		}
+
+		remote := &VsockAddr{}
+
+		if vm, ok := sa.(*unix.SockaddrVM); ok {
+			remote.ContextID = vm.CID
+			remote.Port = vm.Port
+		}
+
+		if !IsHostCID(remote.ContextID) {
+			unix.Close(nfd) //nolint:errcheck
+
+			continue
+		}
+
+		return &vsockConn{
+			File:   os.NewFile(uintptr(nfd), "vsock:"+remote.String()),
+			local:  l.addr,
+			remote: remote,
+		}, nil
+	}
+}
+
+// Close implements net.Listener.
+func (l *vsockListener) Close() error {
+	return l.f.Close()
+}
+
+// Addr implements net.Listener.
+func (l *vsockListener) Addr() net.Addr {
+	return l.addr
+}
+
+// vsockConn implements net.Conn for the accepted vsock connection.
+type vsockConn struct {
+	*os.File
+
+	local, remote *VsockAddr
+}
+
+// LocalAddr implements net.Conn.
+func (c *vsockConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+// RemoteAddr implements net.Conn.
+func (c *vsockConn) RemoteAddr() net.Addr {
+	return c.remote
+}
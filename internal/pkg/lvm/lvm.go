@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lvm provides helpers to manage LVM physical volumes, volume groups and logical volumes.
+package lvm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/go-cmd/pkg/cmd"
+)
+
+// Binary is the path to the lvm binary.
+const Binary = "/sbin/lvm"
+
+const separator = "|"
+
+// PhysicalVolume describes LVM physical volume.
+type PhysicalVolume struct {
+	Name        string
+	VolumeGroup string
+}
+
+// LogicalVolume describes LVM logical volume.
+type LogicalVolume struct {
+	Name        string
+	VolumeGroup string
+	Path        string
+	Size        uint64
+}
+
+// PhysicalVolumes returns the list of physical volumes.
+func PhysicalVolumes() ([]PhysicalVolume, error) {
+	rows, err := report("pvs", "pv_name,vg_name")
+	if err != nil {
+		return nil, err
+	}
+
+	pvs := make([]PhysicalVolume, 0, len(rows))
+
+	for _, row := range rows {
+		pvs = append(pvs, PhysicalVolume{
+			Name:        row[0],
+			VolumeGroup: row[1],
+		})
+	}
+
+	return pvs, nil
+}
+
+// VolumeGroups returns the list of volume group names.
+func VolumeGroups() ([]string, error) {
+	rows, err := report("vgs", "vg_name")
+	if err != nil {
+		return nil, err
+	}
+
+	vgs := make([]string, 0, len(rows))
+
+	for _, row := range rows {
+		vgs = append(vgs, row[0])
+	}
+
+	return vgs, nil
+}
+
+// LogicalVolumes returns the list of logical volumes in the volume group.
+func LogicalVolumes(volumeGroup string) ([]LogicalVolume, error) {
+	rows, err := report("lvs", "lv_name,vg_name,lv_path,lv_size", volumeGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	lvs := make([]LogicalVolume, 0, len(rows))
+
+	for _, row := range rows {
+		size, parseErr := strconv.ParseUint(row[3], 10, 64)
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing logical volume size %q: %w", row[3], parseErr)
+		}
+
+		lvs = append(lvs, LogicalVolume{
+			Name:        row[0],
+			VolumeGroup: row[1],
+			Path:        row[2],
+			Size:        size,
+		})
+	}
+
+	return lvs, nil
+}
+
+// CreatePhysicalVolume initializes the device as a physical volume.
+func CreatePhysicalVolume(device string) error {
+	return run("pvcreate", device)
+}
+
+// CreateVolumeGroup creates the volume group from the physical volumes.
+func CreateVolumeGroup(name string, devices []string) error {
+	return run("vgcreate", append([]string{name}, devices...)...)
+}
+
+// ExtendVolumeGroup adds the physical volumes to the volume group.
+func ExtendVolumeGroup(name string, devices []string) error {
+	return run("vgextend", append([]string{name}, devices...)...)
+}
+
+// ActivateVolumeGroup activates all logical volumes in the volume group.
+func ActivateVolumeGroup(name string) error {
+	return run("vgchange", "-ay", name)
+}
+
+// CreateLogicalVolume creates the logical volume in the volume group.
+//
+// Size of the volume is either set in bytes, or as a percentage of the volume group size (e.g. 50%).
+// If neither is set, the volume occupies all the free space in the volume group.
+func CreateLogicalVolume(volumeGroup, name string, size uint64, percent string) error {
+	args := []string{"--name", name, "--yes"}
+
+	switch {
+	case size > 0:
+		args = append(args, "--size", strconv.FormatUint(size, 10)+"B")
+	case percent != "":
+		args = append(args, "--extents", percent+"VG")
+	default:
+		args = append(args, "--extents", "100%FREE")
+	}
+
+	return run("lvcreate", append(args, volumeGroup)...)
+}
+
+func run(command string, args ...string) error {
+	if _, err := cmd.Run(Binary, append([]string{command}, args...)...); err != nil {
+		return fmt.Errorf("error running lvm %s: %w", command, err)
+	}
+
+	return nil
+}
+
+func report(command, fields string, args ...string) ([][]string, error) {
+	out, err := cmd.Run(Binary, append([]string{
+		command,
+		"--noheadings",
+		"--nosuffix",
+		"--units", "b",
+		"--separator", separator,
+		"--options", fields,
+	}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("error running lvm %s: %w", command, err)
+	}
+
+	return ParseReport(out, len(strings.Split(fields, ","))), nil
+}
+
+// ParseReport parses the output of LVM reporting commands (pvs, vgs, lvs).
+//
+// Rows with unexpected number of fields are skipped.
+func ParseReport(out string, fields int) [][]string {
+	var rows [][]string
+
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		row := strings.Split(line, separator)
+		if len(row) != fields {
+			continue
+		}
+
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
@@ -0,0 +1,29 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lvm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/internal/pkg/lvm"
+)
+
+func TestParseReport(t *testing.T) {
+	out := `  /dev/sdb|data
+  /dev/sdc|data
+  /dev/sdd|
+  garbage
+`
+
+	assert.Equal(t, [][]string{
+		{"/dev/sdb", "data"},
+		{"/dev/sdc", "data"},
+		{"/dev/sdd", ""},
+	}, lvm.ParseReport(out, 2))
+
+	assert.Empty(t, lvm.ParseReport("", 2))
+}
@@ -360,8 +360,15 @@ func (p *Point) ResizePartition() (resized bool, err error) {
 		return false, err
 	}
 
-	for _, partition := range pt.Partitions().Items() {
+	partitions := pt.Partitions().Items()
+
+	for i, partition := range partitions {
 		if partition.Name == constants.EphemeralPartitionLabel {
+			if i != len(partitions)-1 {
+				// EPHEMERAL is followed by other partitions, so it can't be grown
+				return false, nil
+			}
+
 			resized, err := pt.Resize(partition)
 			if err != nil {
 				return false, err
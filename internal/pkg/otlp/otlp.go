@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package otlp implements OpenTelemetry trace exporter using OTLP/HTTP protocol with JSON encoding.
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// TracesPath is the OTLP/HTTP path for the traces.
+	TracesPath = "/v1/traces"
+
+	batchSize     = 512
+	bufferSize    = 4 * batchSize
+	flushInterval = 5 * time.Second
+	exportTimeout = 10 * time.Second
+)
+
+// Span is a single traced operation.
+type Span struct {
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]string
+	// Error is set if the operation failed.
+	Error string
+}
+
+// Exporter sends spans to the OTLP/HTTP endpoint.
+//
+// Spans are batched and sent periodically, if the endpoint is not available,
+// spans are dropped.
+type Exporter struct {
+	endpoint    string
+	serviceName string
+	scopeName   string
+
+	client *http.Client
+	spanCh chan Span
+}
+
+// NewExporter initializes new Exporter.
+func NewExporter(endpoint *url.URL, serviceName, scopeName string) *Exporter {
+	u := *endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + TracesPath
+
+	return &Exporter{
+		endpoint:    u.String(),
+		serviceName: serviceName,
+		scopeName:   scopeName,
+		client:      &http.Client{Timeout: exportTimeout},
+		spanCh:      make(chan Span, bufferSize),
+	}
+}
+
+// Export queues the span to be sent.
+//
+// Export never blocks, if the queue is full, the span is dropped.
+func (e *Exporter) Export(span Span) {
+	select {
+	case e.spanCh <- span:
+	default:
+	}
+}
+
+// Run the exporter until the context is canceled.
+//
+// Queued spans are flushed before Run returns.
+func (e *Exporter) Run(ctx context.Context, logger *zap.Logger) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Span, 0, batchSize)
+
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := e.send(ctx, batch); err != nil {
+			logger.Warn("error exporting traces", zap.String("endpoint", e.endpoint), zap.Int("spans", len(batch)), zap.Error(err))
+		}
+
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			for len(e.spanCh) > 0 {
+				batch = append(batch, <-e.spanCh)
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+			defer cancel()
+
+			flush(shutdownCtx)
+
+			return
+		case span := <-e.spanCh:
+			batch = append(batch, span)
+
+			if len(batch) >= batchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
+
+func (e *Exporter) send(ctx context.Context, spans []Span) error {
+	body, err := json.Marshal(e.buildRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	//nolint:errcheck
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+
+	return nil
+}
+
+func (e *Exporter) buildRequest(spans []Span) *exportRequest {
+	out := make([]span, 0, len(spans))
+
+	for _, s := range spans {
+		otlpSpan := span{
+			TraceID:           randomID(16),
+			SpanID:            randomID(8),
+			Name:              s.Name,
+			Kind:              spanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Attributes:        keyValues(s.Attributes),
+			Status: status{
+				Code: statusCodeOK,
+			},
+		}
+
+		if s.Error != "" {
+			otlpSpan.Status = status{
+				Code:    statusCodeError,
+				Message: s.Error,
+			}
+		}
+
+		out = append(out, otlpSpan)
+	}
+
+	return &exportRequest{
+		ResourceSpans: []resourceSpans{
+			{
+				Resource: resource{
+					Attributes: keyValues(map[string]string{
+						"service.name": e.serviceName,
+					}),
+				},
+				ScopeSpans: []scopeSpans{
+					{
+						Scope: scope{
+							Name: e.scopeName,
+						},
+						Spans: out,
+					},
+				},
+			},
+		},
+	}
+}
+
+func randomID(size int) string {
+	buf := make([]byte, size)
+
+	//nolint:errcheck
+	rand.Read(buf)
+
+	return hex.EncodeToString(buf)
+}
+
+func keyValues(attributes map[string]string) []keyValue {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	result := make([]keyValue, 0, len(attributes))
+
+	for k, v := range attributes {
+		result = append(result, keyValue{
+			Key: k,
+			Value: anyValue{
+				StringValue: v,
+			},
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+
+	return result
+}
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package otlp_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/talos-systems/talos/internal/pkg/otlp"
+)
+
+func TestExporter(t *testing.T) {
+	requests := make(chan map[string]interface{}, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, otlp.TracesPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]interface{}
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		requests <- body
+	}))
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	exporter := otlp.NewExporter(endpoint, "machined", "controller-runtime")
+
+	start := time.Unix(1650000000, 0)
+
+	exporter.Export(otlp.Span{
+		Name:       "reconcile",
+		Start:      start,
+		End:        start.Add(time.Second),
+		Attributes: map[string]string{"controller": "network.AddressSpecController"},
+		Error:      "failed",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// spans are flushed on shutdown
+	exporter.Run(ctx, zaptest.NewLogger(t))
+
+	var body map[string]interface{}
+
+	select {
+	case body = <-requests:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the export request")
+	}
+
+	resourceSpans := body["resourceSpans"].([]interface{})[0].(map[string]interface{})
+
+	assert.Equal(t, map[string]interface{}{
+		"attributes": []interface{}{
+			map[string]interface{}{"key": "service.name", "value": map[string]interface{}{"stringValue": "machined"}},
+		},
+	}, resourceSpans["resource"])
+
+	scopeSpans := resourceSpans["scopeSpans"].([]interface{})[0].(map[string]interface{})
+
+	assert.Equal(t, map[string]interface{}{"name": "controller-runtime"}, scopeSpans["scope"])
+
+	span := scopeSpans["spans"].([]interface{})[0].(map[string]interface{})
+
+	assert.Len(t, span["traceId"], 32)
+	assert.Len(t, span["spanId"], 16)
+
+	delete(span, "traceId")
+	delete(span, "spanId")
+
+	assert.Equal(t, map[string]interface{}{
+		"name":              "reconcile",
+		"kind":              1.,
+		"startTimeUnixNano": "1650000000000000000",
+		"endTimeUnixNano":   "1650000001000000000",
+		"attributes": []interface{}{
+			map[string]interface{}{"key": "controller", "value": map[string]interface{}{"stringValue": "network.AddressSpecController"}},
+		},
+		"status": map[string]interface{}{"code": 2., "message": "failed"},
+	}, span)
+}
+
+func TestExporterBatching(t *testing.T) {
+	srv, spanCounts := newSpanCountServer(t)
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	exporter := otlp.NewExporter(endpoint, "machined", "controller-runtime")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		exporter.Run(ctx, zaptest.NewLogger(t))
+	}()
+
+	// full batches are sent without waiting for the flush interval
+	for i := 0; i < 1024; i++ {
+		exporter.Export(otlp.Span{Name: "reconcile"})
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case count := <-spanCounts:
+			assert.Equal(t, 512, count)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the export request")
+		}
+	}
+
+	cancel()
+	<-done
+
+	// nothing left to flush
+	assert.Empty(t, spanCounts)
+}
+
+func TestExporterQueueFull(t *testing.T) {
+	srv, spanCounts := newSpanCountServer(t)
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	exporter := otlp.NewExporter(endpoint, "machined", "controller-runtime")
+
+	// Export never blocks, spans over the queue size (2048) are dropped
+	for i := 0; i < 3000; i++ {
+		exporter.Export(otlp.Span{Name: "reconcile"})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	exporter.Run(ctx, zaptest.NewLogger(t))
+
+	select {
+	case count := <-spanCounts:
+		assert.Equal(t, 2048, count)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the export request")
+	}
+}
+
+func TestExporterUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	exporter := otlp.NewExporter(endpoint, "machined", "controller-runtime")
+	exporter.Export(otlp.Span{Name: "reconcile"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// export errors are logged, spans are dropped
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		exporter.Run(ctx, zaptest.NewLogger(t))
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("exporter didn't stop")
+	}
+}
+
+// newSpanCountServer starts the OTLP endpoint which reports the number of spans in each request.
+func newSpanCountServer(t *testing.T) (*httptest.Server, <-chan int) {
+	spanCounts := make(chan int, 10)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []json.RawMessage `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		spanCounts <- len(body.ResourceSpans[0].ScopeSpans[0].Spans)
+	}))
+
+	return srv, spanCounts
+}
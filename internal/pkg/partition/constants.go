@@ -14,6 +14,7 @@ const (
 	EFISystemPartition  Type = "C12A7328-F81F-11D2-BA4B-00A0C93EC93B"
 	BIOSBootPartition   Type = "21686148-6449-6E6F-744E-656564454649"
 	LinuxFilesystemData Type = "0FC63DAF-8483-4772-8E79-3D69D8477DE4"
+	LinuxRAID           Type = "A19D880F-05FC-4D3B-A006-743F0F84911E"
 )
 
 // FileSystemType is used to format partitions.
@@ -126,4 +126,11 @@ var systemPartitions = map[string]FormatOptions{
 		Size:           0,
 		Force:          true,
 	},
+	constants.RAIDPartitionLabel: {
+		Label:          constants.RAIDPartitionLabel,
+		PartitionType:  LinuxRAID,
+		FileSystemType: FilesystemTypeNone,
+		Size:           0,
+		Force:          true,
+	},
 }
@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package raid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MDStatPath is the path to the kernel md status file.
+const MDStatPath = "/proc/mdstat"
+
+// ErrNotSupported is returned if the kernel doesn't support md arrays.
+var ErrNotSupported = errors.New("software RAID is not supported by the kernel")
+
+// Array state values.
+const (
+	StateClean      = "clean"
+	StateDegraded   = "degraded"
+	StateRecovering = "recovering"
+	StateInactive   = "inactive"
+)
+
+// Array is the status of the md array as reported by the kernel.
+type Array struct {
+	// Device is the kernel name of the array, e.g. md127.
+	Device string
+	Level  string
+	Active bool
+
+	Devices       []string
+	FailedDevices []string
+	SpareDevices  []string
+
+	TotalDevices  int
+	ActiveDevices int
+
+	// SyncAction is the current sync operation (resync, recovery, reshape, check), if any.
+	SyncAction   string
+	SyncProgress float64
+}
+
+// State returns the summary state of the array.
+func (a *Array) State() string {
+	switch {
+	case !a.Active:
+		return StateInactive
+	case a.SyncAction == "recovery" || a.SyncAction == "resync" || a.SyncAction == "reshape":
+		return StateRecovering
+	case a.ActiveDevices < a.TotalDevices || len(a.FailedDevices) > 0:
+		return StateDegraded
+	default:
+		return StateClean
+	}
+}
+
+var (
+	arrayLineRe  = regexp.MustCompile(`^(md\S+)\s*:\s*(\S+)\s*(.*)$`)
+	memberRe     = regexp.MustCompile(`^(\S+)\[\d+\](\([A-Z]\))?$`)
+	devCountRe   = regexp.MustCompile(`\[(\d+)/(\d+)\]`)
+	syncActionRe = regexp.MustCompile(`(resync|recovery|reshape|check)\s*=\s*([\d.]+)%`)
+)
+
+// ReadMDStat reads the status of md arrays from /proc/mdstat.
+//
+// If the kernel doesn't support md arrays, ErrNotSupported is returned.
+func ReadMDStat() ([]Array, error) {
+	return ReadMDStatFrom(MDStatPath)
+}
+
+// ReadMDStatFrom reads the status of md arrays from the specified file.
+func ReadMDStatFrom(path string) ([]Array, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotSupported
+		}
+
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return ParseMDStat(f)
+}
+
+// ParseMDStat parses the contents of /proc/mdstat.
+//
+//nolint:gocyclo
+func ParseMDStat(r io.Reader) ([]Array, error) {
+	var (
+		arrays  []Array
+		current *Array
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if matches := arrayLineRe.FindStringSubmatch(line); matches != nil {
+			arrays = append(arrays, Array{
+				Device: matches[1],
+			})
+
+			current = &arrays[len(arrays)-1]
+
+			if err := current.parseHeader(matches[2], strings.Fields(matches[3])); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			current = nil
+
+			continue
+		}
+
+		if matches := devCountRe.FindStringSubmatch(line); matches != nil {
+			current.TotalDevices, _ = strconv.Atoi(matches[1])  //nolint:errcheck
+			current.ActiveDevices, _ = strconv.Atoi(matches[2]) //nolint:errcheck
+		}
+
+		if matches := syncActionRe.FindStringSubmatch(line); matches != nil {
+			current.SyncAction = matches[1]
+			current.SyncProgress, _ = strconv.ParseFloat(matches[2], 64) //nolint:errcheck
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return arrays, nil
+}
+
+func (a *Array) parseHeader(state string, fields []string) error {
+	a.Active = state == "active"
+
+	for _, field := range fields {
+		if strings.HasPrefix(field, "(") {
+			// (auto-read-only), (read-only)
+			continue
+		}
+
+		if strings.HasPrefix(field, "raid") || field == "linear" {
+			a.Level = field
+
+			continue
+		}
+
+		matches := memberRe.FindStringSubmatch(field)
+		if matches == nil {
+			return fmt.Errorf("unexpected field in mdstat for %q: %q", a.Device, field)
+		}
+
+		switch matches[2] {
+		case "(F)":
+			a.FailedDevices = append(a.FailedDevices, matches[1])
+		case "(S)":
+			a.SpareDevices = append(a.SpareDevices, matches[1])
+		default:
+			a.Devices = append(a.Devices, matches[1])
+		}
+	}
+
+	// raid0 and linear arrays don't report [n/m]
+	a.TotalDevices = len(a.Devices)
+	a.ActiveDevices = len(a.Devices)
+
+	return nil
+}
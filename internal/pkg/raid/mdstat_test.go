@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package raid_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/raid"
+)
+
+const mdstat = `Personalities : [raid0] [raid1] [raid10]
+md125 : active raid10 sdf[3] sde[2] sdd[1] sdc[0]
+      41908224 blocks super 1.2 512K chunks 2 near-copies [4/4] [UUUU]
+
+md126 : active raid1 sdb3[1](F) sda3[0]
+      1046528 blocks super 1.2 [2/1] [U_]
+      bitmap: 1/1 pages [4KB], 65536KB chunk
+
+md127 : active raid1 sdb1[2] sda1[0]
+      293039488 blocks super 1.2 [2/1] [U_]
+      [==>..................]  recovery = 12.6% (37043392/293039488) finish=127.5min speed=33440K/sec
+
+md0 : active raid0 sdh[1] sdg[0]
+      20953088 blocks super 1.2 512k chunks
+
+md1 : inactive sdi[0](S)
+      10476544 blocks super 1.2
+
+unused devices: <none>
+`
+
+func TestParseMDStat(t *testing.T) {
+	arrays, err := raid.ParseMDStat(strings.NewReader(mdstat))
+	require.NoError(t, err)
+
+	require.Len(t, arrays, 5)
+
+	assert.Equal(t, "md125", arrays[0].Device)
+	assert.Equal(t, "raid10", arrays[0].Level)
+	assert.Equal(t, []string{"sdf", "sde", "sdd", "sdc"}, arrays[0].Devices)
+	assert.Equal(t, 4, arrays[0].TotalDevices)
+	assert.Equal(t, 4, arrays[0].ActiveDevices)
+	assert.Equal(t, raid.StateClean, arrays[0].State())
+
+	assert.Equal(t, "md126", arrays[1].Device)
+	assert.Equal(t, "raid1", arrays[1].Level)
+	assert.Equal(t, []string{"sda3"}, arrays[1].Devices)
+	assert.Equal(t, []string{"sdb3"}, arrays[1].FailedDevices)
+	assert.Equal(t, 2, arrays[1].TotalDevices)
+	assert.Equal(t, 1, arrays[1].ActiveDevices)
+	assert.Equal(t, raid.StateDegraded, arrays[1].State())
+
+	assert.Equal(t, "md127", arrays[2].Device)
+	assert.Equal(t, "recovery", arrays[2].SyncAction)
+	assert.InDelta(t, 12.6, arrays[2].SyncProgress, 0.01)
+	assert.Equal(t, raid.StateRecovering, arrays[2].State())
+
+	assert.Equal(t, "md0", arrays[3].Device)
+	assert.Equal(t, "raid0", arrays[3].Level)
+	assert.Equal(t, 2, arrays[3].TotalDevices)
+	assert.Equal(t, 2, arrays[3].ActiveDevices)
+	assert.Equal(t, raid.StateClean, arrays[3].State())
+
+	assert.Equal(t, "md1", arrays[4].Device)
+	assert.Equal(t, []string{"sdi"}, arrays[4].SpareDevices)
+	assert.Equal(t, raid.StateInactive, arrays[4].State())
+}
@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package raid provides helpers to manage Linux software RAID (md) arrays.
+package raid
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/talos-systems/go-cmd/pkg/cmd"
+
+	machineryraid "github.com/talos-systems/talos/pkg/machinery/raid"
+)
+
+// Available checks whether mdadm is available.
+//
+// Talos doesn't ship mdadm in the rootfs, it should be installed via the system extension.
+func Available() bool {
+	_, err := exec.LookPath("mdadm")
+
+	return err == nil
+}
+
+// CheckAvailable returns an error if mdadm is not available.
+func CheckAvailable() error {
+	if !Available() {
+		return fmt.Errorf("software RAID requires the mdadm binary, which is not found")
+	}
+
+	return nil
+}
+
+// DevicePath returns the path to the array device with the specified name.
+func DevicePath(name string) string {
+	return filepath.Join("/dev/md", name)
+}
+
+// Exists checks whether the array with the specified name is assembled.
+func Exists(name string) bool {
+	_, err := os.Stat(DevicePath(name))
+
+	return err == nil
+}
+
+// Resolve returns the path to the kernel device (e.g. /dev/md127) for the array path (/dev/md/<name>).
+//
+// If the path is not a symlink, it is returned as is.
+func Resolve(path string) string {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return path
+	}
+
+	return resolved
+}
+
+// Names returns the names of the assembled arrays keyed by the kernel device name (e.g. md127).
+func Names() map[string]string {
+	names := map[string]string{}
+
+	entries, err := os.ReadDir("/dev/md")
+	if err != nil {
+		return names
+	}
+
+	for _, entry := range entries {
+		if resolved, evalErr := filepath.EvalSymlinks(DevicePath(entry.Name())); evalErr == nil {
+			names[filepath.Base(resolved)] = entry.Name()
+		}
+	}
+
+	return names
+}
+
+// Options for creating the array.
+type Options struct {
+	Metadata string
+}
+
+// Option configures array creation.
+type Option func(*Options)
+
+// WithMetadata sets the superblock metadata version.
+func WithMetadata(metadata string) Option {
+	return func(o *Options) {
+		o.Metadata = metadata
+	}
+}
+
+// Create creates a new array with the specified name from the member devices.
+//
+// Any data on the member devices is lost.
+func Create(name string, level machineryraid.Level, devices []string, setters ...Option) error {
+	if len(devices) < level.MinDevices() {
+		return fmt.Errorf("RAID level %q requires at least %d devices, got %d", level, level.MinDevices(), len(devices))
+	}
+
+	opts := Options{
+		Metadata: "1.2",
+	}
+
+	for _, setter := range setters {
+		setter(&opts)
+	}
+
+	args := []string{
+		"--create", DevicePath(name),
+		"--run",
+		"--name=" + name,
+		"--homehost=any",
+		"--metadata=" + opts.Metadata,
+		"--level=" + string(level),
+		"--raid-devices=" + strconv.Itoa(len(devices)),
+	}
+
+	args = append(args, devices...)
+
+	log.Printf("creating RAID array %q (%s) from %v", name, level, devices)
+
+	if _, err := cmd.Run("mdadm", args...); err != nil {
+		return fmt.Errorf("error creating RAID array %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// Assemble assembles an existing array with the specified name from the member devices.
+func Assemble(name string, devices []string) error {
+	args := append([]string{"--assemble", DevicePath(name), "--run", "--homehost=any"}, devices...)
+
+	if _, err := cmd.Run("mdadm", args...); err != nil {
+		return fmt.Errorf("error assembling RAID array %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// AssembleAll assembles all arrays which can be found on the block devices.
+func AssembleAll() error {
+	if _, err := cmd.Run("mdadm", "--assemble", "--scan", "--run", "--homehost=any"); err != nil {
+		return fmt.Errorf("error assembling RAID arrays: %w", err)
+	}
+
+	return nil
+}
+
+// Stop stops the array with the specified name.
+func Stop(name string) error {
+	if _, err := cmd.Run("mdadm", "--stop", DevicePath(name)); err != nil {
+		return fmt.Errorf("error stopping RAID array %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// HasSuperblock checks whether the device contains md superblock.
+func HasSuperblock(device string) bool {
+	_, err := cmd.Run("mdadm", "--examine", device)
+
+	return err == nil
+}
+
+// Ensure makes sure the array with the specified name is assembled.
+//
+// If the array is not assembled, Ensure tries to assemble it. The array is created
+// only if none of the member devices contains md superblock, so existing arrays are never
+// overwritten.
+func Ensure(name string, level machineryraid.Level, devices []string, setters ...Option) error {
+	if Exists(name) {
+		return nil
+	}
+
+	for _, device := range devices {
+		if HasSuperblock(device) {
+			return Assemble(name, devices)
+		}
+	}
+
+	return Create(name, level, devices, setters...)
+}
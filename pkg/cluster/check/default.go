@@ -103,6 +103,22 @@ func DefaultClusterChecks() []ClusterCheck {
 			}, 3*time.Minute, 5*time.Second)
 		},
 
+		// wait for node-local-dns to report ready
+		func(cluster ClusterInfo) conditions.Condition {
+			return conditions.PollingCondition("node-local-dns to report ready", func(ctx context.Context) error {
+				present, err := DaemonSetPresent(ctx, cluster, "kube-system", "k8s-app=node-local-dns")
+				if err != nil {
+					return err
+				}
+
+				if !present {
+					return conditions.ErrSkipAssertion
+				}
+
+				return K8sPodReadyAssertion(ctx, cluster, "kube-system", "k8s-app=node-local-dns")
+			}, 3*time.Minute, 5*time.Second)
+		},
+
 		// wait for all the nodes to be schedulable
 		func(cluster ClusterInfo) conditions.Condition {
 			return conditions.PollingCondition("all k8s nodes to report schedulable", func(ctx context.Context) error {
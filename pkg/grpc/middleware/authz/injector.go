@@ -31,6 +31,9 @@ const (
 
 	// Enabled is used when RBAC is enabled in the machine configuration. Roles are extracted normally.
 	Enabled
+
+	// PeerCredentials is used for the local API. Roles are derived from the peer identity by PeerRoles.
+	PeerCredentials
 )
 
 // Injector sets roles to the context.
@@ -38,6 +41,9 @@ type Injector struct {
 	// Mode.
 	Mode InjectorMode
 
+	// PeerRoles returns roles for the peer in PeerCredentials mode.
+	PeerRoles func(p *peer.Peer) role.Set
+
 	// Logger.
 	Logger func(format string, v ...interface{})
 }
@@ -108,6 +114,17 @@ func (i *Injector) extractRoles(ctx context.Context) role.Set {
 			i.logf("no roles in metadadata, returning parsed roles")
 		}
 
+		return roles
+
+	case PeerCredentials:
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			panic("can't get peer information")
+		}
+
+		roles := i.PeerRoles(p)
+		i.logf("peer %v has roles %v", p.AuthInfo, roles.Strings())
+
 		return roles
 	}
 
@@ -6,19 +6,23 @@ package images
 
 import (
 	"fmt"
+	"strings"
 
 	criconfig "github.com/containerd/containerd/pkg/cri/config"
+	hashiversion "github.com/hashicorp/go-version"
 
 	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/version"
 )
 
 // Versions holds all the images (and their versions) that are used in Talos.
 type Versions struct {
-	Etcd       string
-	Flannel    string
-	FlannelCNI string
-	CoreDNS    string
+	Etcd         string
+	Flannel      string
+	FlannelCNI   string
+	CoreDNS      string
+	NodeLocalDNS string
 
 	Kubelet               string
 	KubeAPIServer         string
@@ -26,6 +30,9 @@ type Versions struct {
 	KubeProxy             string
 	KubeScheduler         string
 
+	CloudControllerManager string
+	CloudNodeManager       string
+
 	Installer string
 
 	Pause string
@@ -37,6 +44,7 @@ func List(config config.Provider) Versions {
 
 	images.Etcd = config.Cluster().Etcd().Image()
 	images.CoreDNS = config.Cluster().CoreDNS().Image()
+	images.NodeLocalDNS = config.Cluster().CoreDNS().NodeLocalCache().Image()
 	images.Flannel = "ghcr.io/siderolabs/flannel:v0.18.0" // mirrored from docker.io/flannelcni/flannel
 	images.FlannelCNI = fmt.Sprintf("ghcr.io/siderolabs/install-cni:%s", version.ExtrasVersion)
 	images.Kubelet = config.Machine().Kubelet().Image()
@@ -45,9 +53,58 @@ func List(config config.Provider) Versions {
 	images.KubeProxy = config.Cluster().Proxy().Image()
 	images.KubeScheduler = config.Cluster().Scheduler().Image()
 
+	images.CloudControllerManager, images.CloudNodeManager = cloudProviderImages(config.Cluster().ExternalCloudProvider(), images.KubeAPIServer)
+
 	images.Installer = DefaultInstallerImage
 
 	images.Pause = criconfig.DefaultConfig().SandboxImage
 
 	return images
 }
+
+// cloudProviderImages returns built-in cloud controller manager and cloud node manager images.
+//
+// AWS, Azure and OpenStack cloud controller managers are released for each Kubernetes minor version,
+// so the default image tag is derived from the Kubernetes version of the API server image.
+func cloudProviderImages(cloudProvider config.ExternalCloudProvider, apiServerImage string) (controllerManager, nodeManager string) {
+	if !cloudProvider.Enabled() || cloudProvider.Name() == "" {
+		return "", ""
+	}
+
+	kubernetesVersion := apiServerImage
+
+	if idx := strings.Index(kubernetesVersion, "@"); idx != -1 {
+		kubernetesVersion = kubernetesVersion[:idx]
+	}
+
+	if idx := strings.LastIndex(kubernetesVersion, ":"); idx != -1 {
+		kubernetesVersion = kubernetesVersion[idx+1:]
+	}
+
+	v, err := hashiversion.NewVersion(kubernetesVersion)
+	if err != nil {
+		v = hashiversion.Must(hashiversion.NewVersion(constants.DefaultKubernetesVersion))
+	}
+
+	tag := fmt.Sprintf("v%d.%d.0", v.Segments()[0], v.Segments()[1])
+
+	switch cloudProvider.Name() {
+	case constants.CloudProviderAWS:
+		controllerManager = fmt.Sprintf("%s:%s", constants.AWSCloudControllerManagerImage, tag)
+	case constants.CloudProviderAzure:
+		controllerManager = fmt.Sprintf("%s:%s", constants.AzureCloudControllerManagerImage, tag)
+		nodeManager = fmt.Sprintf("%s:%s", constants.AzureCloudNodeManagerImage, tag)
+	case constants.CloudProviderGCP:
+		controllerManager = fmt.Sprintf("%s:%s", constants.GCPCloudControllerManagerImage, constants.DefaultGCPCloudControllerManagerVersion)
+	case constants.CloudProviderHCloud:
+		controllerManager = fmt.Sprintf("%s:%s", constants.HCloudCloudControllerManagerImage, constants.DefaultHCloudCloudControllerManagerVersion)
+	case constants.CloudProviderOpenStack:
+		controllerManager = fmt.Sprintf("%s:%s", constants.OpenStackCloudControllerManagerImage, tag)
+	}
+
+	if cloudProvider.Image() != "" {
+		controllerManager = cloudProvider.Image()
+	}
+
+	return controllerManager, nodeManager
+}
@@ -5,8 +5,12 @@
 package kernel
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/talos-systems/talos/pkg/machinery/kernel"
 )
@@ -25,3 +29,66 @@ func ReadParam(prop *kernel.Param) ([]byte, error) {
 func DeleteParam(prop *kernel.Param) error {
 	return os.Remove(prop.Path())
 }
+
+// ValidateParam checks that the key exists and the value matches the type of the current value.
+//
+// If the key doesn't exist, the returned error matches os.ErrNotExist.
+func ValidateParam(prop *kernel.Param) error {
+	current, err := ReadParam(prop)
+	if err != nil {
+		// write-only params can't be checked
+		if errors.Is(err, os.ErrPermission) {
+			return nil
+		}
+
+		return err
+	}
+
+	return ValidateValue(strings.TrimSpace(string(current)), prop.Value)
+}
+
+// ValidateValue checks that the value matches the type of the current value.
+//
+// If the current value is numeric (or a list of numbers), the value should be numeric with at most
+// the same number of fields, string values are not checked.
+func ValidateValue(current, value string) error {
+	currentFields := strings.Fields(current)
+
+	if len(currentFields) == 0 {
+		return nil
+	}
+
+	for _, field := range currentFields {
+		if !isNumber(field) {
+			return nil
+		}
+	}
+
+	fields := strings.Fields(value)
+
+	if len(fields) == 0 {
+		return fmt.Errorf("expected a numeric value, got empty value")
+	}
+
+	if len(fields) > len(currentFields) {
+		return fmt.Errorf("expected at most %d numeric values, got %q", len(currentFields), value)
+	}
+
+	for _, field := range fields {
+		if !isNumber(field) {
+			return fmt.Errorf("expected a numeric value, got %q", value)
+		}
+	}
+
+	return nil
+}
+
+func isNumber(s string) bool {
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return true
+	}
+
+	_, err := strconv.ParseUint(s, 10, 64)
+
+	return err == nil
+}
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kernel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/kernel"
+)
+
+func TestValidateValue(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		current string
+		value   string
+
+		expectedError string
+	}{
+		{
+			name:    "number",
+			current: "1",
+			value:   "0",
+		},
+		{
+			name:    "negative number",
+			current: "0",
+			value:   "-1",
+		},
+		{
+			name:    "large number",
+			current: "9223372036854775807",
+			value:   "18446744073709551615",
+		},
+		{
+			name:    "list of numbers",
+			current: "4096\t131072\t6291456",
+			value:   "4096 87380 16777216",
+		},
+		{
+			name:    "string",
+			current: "performance",
+			value:   "powersave",
+		},
+		{
+			name:    "selection",
+			current: "[mq-deadline] none",
+			value:   "none",
+		},
+		{
+			name:          "not a number",
+			current:       "1",
+			value:         "yes",
+			expectedError: `expected a numeric value, got "yes"`,
+		},
+		{
+			name:          "empty",
+			current:       "1",
+			value:         " ",
+			expectedError: "expected a numeric value, got empty value",
+		},
+		{
+			name:          "too many fields",
+			current:       "32768\t60999",
+			value:         "1024 65535 1",
+			expectedError: `expected at most 2 numeric values, got "1024 65535 1"`,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := kernel.ValidateValue(tt.current, tt.value)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type InspectServiceClient interface {
 	ControllerRuntimeDependencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControllerRuntimeDependenciesResponse, error)
+	ControllerRuntimeStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControllerRuntimeStatsResponse, error)
 }
 
 type inspectServiceClient struct {
@@ -44,11 +45,21 @@ func (c *inspectServiceClient) ControllerRuntimeDependencies(ctx context.Context
 	return out, nil
 }
 
+func (c *inspectServiceClient) ControllerRuntimeStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControllerRuntimeStatsResponse, error) {
+	out := new(ControllerRuntimeStatsResponse)
+	err := c.cc.Invoke(ctx, "/inspect.InspectService/ControllerRuntimeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InspectServiceServer is the server API for InspectService service.
 // All implementations must embed UnimplementedInspectServiceServer
 // for forward compatibility
 type InspectServiceServer interface {
 	ControllerRuntimeDependencies(context.Context, *emptypb.Empty) (*ControllerRuntimeDependenciesResponse, error)
+	ControllerRuntimeStats(context.Context, *emptypb.Empty) (*ControllerRuntimeStatsResponse, error)
 	mustEmbedUnimplementedInspectServiceServer()
 }
 
@@ -59,6 +70,9 @@ type UnimplementedInspectServiceServer struct {
 func (UnimplementedInspectServiceServer) ControllerRuntimeDependencies(context.Context, *emptypb.Empty) (*ControllerRuntimeDependenciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ControllerRuntimeDependencies not implemented")
 }
+func (UnimplementedInspectServiceServer) ControllerRuntimeStats(context.Context, *emptypb.Empty) (*ControllerRuntimeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControllerRuntimeStats not implemented")
+}
 func (UnimplementedInspectServiceServer) mustEmbedUnimplementedInspectServiceServer() {}
 
 // UnsafeInspectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +104,24 @@ func _InspectService_ControllerRuntimeDependencies_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InspectService_ControllerRuntimeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InspectServiceServer).ControllerRuntimeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspect.InspectService/ControllerRuntimeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InspectServiceServer).ControllerRuntimeStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InspectService_ServiceDesc is the grpc.ServiceDesc for InspectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +133,10 @@ var InspectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControllerRuntimeDependencies",
 			Handler:    _InspectService_ControllerRuntimeDependencies_Handler,
 		},
+		{
+			MethodName: "ControllerRuntimeStats",
+			Handler:    _InspectService_ControllerRuntimeStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspect/inspect.proto",
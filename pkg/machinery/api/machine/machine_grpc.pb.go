@@ -81,6 +81,10 @@ type MachineServiceClient interface {
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
 	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
 	GenerateClientConfiguration(ctx context.Context, in *GenerateClientConfigurationRequest, opts ...grpc.CallOption) (*GenerateClientConfigurationResponse, error)
+	// GenerateKubeconfig generates Kubernetes client configuration (kubeconfig) for the specified user.
+	//
+	// This method is available only on control plane nodes.
+	GenerateKubeconfig(ctx context.Context, in *GenerateKubeconfigRequest, opts ...grpc.CallOption) (*GenerateKubeconfigResponse, error)
 }
 
 type machineServiceClient struct {
@@ -683,6 +687,15 @@ func (c *machineServiceClient) GenerateClientConfiguration(ctx context.Context,
 	return out, nil
 }
 
+func (c *machineServiceClient) GenerateKubeconfig(ctx context.Context, in *GenerateKubeconfigRequest, opts ...grpc.CallOption) (*GenerateKubeconfigResponse, error) {
+	out := new(GenerateKubeconfigResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/GenerateKubeconfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility
@@ -742,6 +755,10 @@ type MachineServiceServer interface {
 	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
 	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
 	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
+	// GenerateKubeconfig generates Kubernetes client configuration (kubeconfig) for the specified user.
+	//
+	// This method is available only on control plane nodes.
+	GenerateKubeconfig(context.Context, *GenerateKubeconfigRequest) (*GenerateKubeconfigResponse, error)
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -869,6 +886,9 @@ func (UnimplementedMachineServiceServer) Version(context.Context, *emptypb.Empty
 func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientConfiguration not implemented")
 }
+func (UnimplementedMachineServiceServer) GenerateKubeconfig(context.Context, *GenerateKubeconfigRequest) (*GenerateKubeconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateKubeconfig not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
 // UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1637,6 +1657,24 @@ func _MachineService_GenerateClientConfiguration_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_GenerateKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateKubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GenerateKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/GenerateKubeconfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GenerateKubeconfig(ctx, req.(*GenerateKubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1764,6 +1802,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateClientConfiguration",
 			Handler:    _MachineService_GenerateClientConfiguration_Handler,
 		},
+		{
+			MethodName: "GenerateKubeconfig",
+			Handler:    _MachineService_GenerateKubeconfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
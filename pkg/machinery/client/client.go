@@ -124,7 +124,7 @@ func (c *Client) GetEndpoints() []string {
 			return nil
 		}
 
-		return c.options.configContext.Endpoints
+		return c.options.configContext.ResolveEndpoints()
 	}
 
 	return nil
@@ -224,30 +224,47 @@ func (c *Client) GetConn(ctx context.Context, opts ...grpc.DialOption) (*grpc.Cl
 }
 
 // CredentialsFromConfigContext constructs the client Credentials from the given configuration Context.
-func CredentialsFromConfigContext(context *clientconfig.Context) (*Credentials, error) {
-	caBytes, err := base64.StdEncoding.DecodeString(context.CA)
+//
+// If the context has the credential helper configured, credentials are acquired by running the helper,
+// the helper is killed if it doesn't finish within clientconfig.ExecCredentialTimeout.
+func CredentialsFromConfigContext(configContext *clientconfig.Context) (*Credentials, error) {
+	ca, crt, key := configContext.CA, configContext.Crt, configContext.Key
+
+	if configContext.Exec != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), clientconfig.ExecCredentialTimeout)
+		defer cancel()
+
+		output, err := configContext.Exec.Run(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ca, crt, key = output.CA, output.Crt, output.Key
+	}
+
+	caBytes, err := base64.StdEncoding.DecodeString(ca)
 	if err != nil {
 		return nil, fmt.Errorf("error decoding CA: %w", err)
 	}
 
-	crtBytes, err := base64.StdEncoding.DecodeString(context.Crt)
+	crtBytes, err := base64.StdEncoding.DecodeString(crt)
 	if err != nil {
 		return nil, fmt.Errorf("error decoding certificate: %w", err)
 	}
 
-	keyBytes, err := base64.StdEncoding.DecodeString(context.Key)
+	keyBytes, err := base64.StdEncoding.DecodeString(key)
 	if err != nil {
 		return nil, fmt.Errorf("error decoding key: %w", err)
 	}
 
-	crt, err := tls.X509KeyPair(crtBytes, keyBytes)
+	keyPair, err := tls.X509KeyPair(crtBytes, keyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("could not load client key pair: %s", err)
 	}
 
 	return &Credentials{
 		CA:  caBytes,
-		Crt: crt,
+		Crt: keyPair,
 	}, nil
 }
 
@@ -839,6 +856,28 @@ func (c *Client) ClusterHealthCheck(ctx context.Context, waitTimeout time.Durati
 	})
 }
 
+// ClusterRemoveMember removes the member from the cluster and blocks it from joining with the same node ID.
+func (c *Client) ClusterRemoveMember(ctx context.Context, req *clusterapi.RemoveMemberRequest, callOptions ...grpc.CallOption) (resp *clusterapi.RemoveMemberResponse, err error) {
+	resp, err = c.ClusterClient.RemoveMember(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*clusterapi.RemoveMemberResponse) //nolint:errcheck
+
+	return
+}
+
+// ClusterClearRemovedMember allows the removed member to join the cluster again.
+func (c *Client) ClusterClearRemovedMember(ctx context.Context, req *clusterapi.ClearRemovedMemberRequest, callOptions ...grpc.CallOption) error {
+	resp, err := c.ClusterClient.ClearRemovedMember(ctx, req, callOptions...)
+
+	if err == nil {
+		_, err = FilterMessages(resp, err)
+	}
+
+	return err
+}
+
 // EtcdRemoveMember removes a node from etcd cluster.
 func (c *Client) EtcdRemoveMember(ctx context.Context, req *machineapi.EtcdRemoveMemberRequest, callOptions ...grpc.CallOption) error {
 	resp, err := c.MachineClient.EtcdRemoveMember(ctx, req, callOptions...)
@@ -951,6 +990,17 @@ func (c *Client) GenerateClientConfiguration(ctx context.Context, req *machineap
 	return
 }
 
+// GenerateKubeconfig implements proto.MachineServiceClient interface.
+func (c *Client) GenerateKubeconfig(ctx context.Context, req *machineapi.GenerateKubeconfigRequest, callOptions ...grpc.CallOption) (resp *machineapi.GenerateKubeconfigResponse, err error) {
+	resp, err = c.MachineClient.GenerateKubeconfig(ctx, req, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.GenerateKubeconfigResponse) //nolint:errcheck
+
+	return
+}
+
 // MachineStream is a common interface for streams returned by streaming APIs.
 type MachineStream interface {
 	Recv() (*common.Data, error)
@@ -12,6 +12,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/talos-systems/crypto/x509"
 	yaml "gopkg.in/yaml.v3"
@@ -46,12 +48,64 @@ func (c *Config) upgrade() {
 
 // Context represents the set of credentials required to talk to a target.
 type Context struct {
-	DeprecatedTarget string   `yaml:"target,omitempty"` // Field deprecated in favor of Endpoints
-	Endpoints        []string `yaml:"endpoints"`
-	Nodes            []string `yaml:"nodes,omitempty"`
-	CA               string   `yaml:"ca"`
-	Crt              string   `yaml:"crt"`
-	Key              string   `yaml:"key"`
+	DeprecatedTarget  string             `yaml:"target,omitempty"` // Field deprecated in favor of Endpoints
+	Endpoints         []string           `yaml:"endpoints"`
+	Nodes             []string           `yaml:"nodes,omitempty"`
+	CA                string             `yaml:"ca"`
+	Crt               string             `yaml:"crt"`
+	Key               string             `yaml:"key"`
+	ClusterID         string             `yaml:"clusterId,omitempty"`
+	EndpointDiscovery *EndpointDiscovery `yaml:"endpointDiscovery,omitempty"`
+	Exec              *ExecCredential    `yaml:"exec,omitempty"`
+}
+
+// EndpointDiscoveryRefreshInterval is the interval after which the discovered endpoints are refreshed.
+const EndpointDiscoveryRefreshInterval = time.Hour
+
+// EndpointDiscovery configures refreshing the endpoints from the cluster members.
+//
+// Discovered endpoints are cached in the config.
+type EndpointDiscovery struct {
+	Enabled     bool      `yaml:"enabled"`
+	Endpoints   []string  `yaml:"endpoints,omitempty"`
+	RefreshedAt time.Time `yaml:"refreshedAt,omitempty"`
+}
+
+// NeedsRefresh returns true if the discovered endpoints should be refreshed.
+func (d *EndpointDiscovery) NeedsRefresh(now time.Time) bool {
+	if d == nil || !d.Enabled {
+		return false
+	}
+
+	return len(d.Endpoints) == 0 || now.Sub(d.RefreshedAt) > EndpointDiscoveryRefreshInterval
+}
+
+// Update stores the discovered endpoints.
+func (d *EndpointDiscovery) Update(endpoints []string, now time.Time) {
+	d.Endpoints = endpoints
+	d.RefreshedAt = now
+}
+
+// ResolveEndpoints returns the discovered endpoints (if endpoint discovery is enabled) followed by the static endpoints.
+func (c *Context) ResolveEndpoints() []string {
+	if c.EndpointDiscovery == nil || !c.EndpointDiscovery.Enabled || len(c.EndpointDiscovery.Endpoints) == 0 {
+		return c.Endpoints
+	}
+
+	seen := map[string]struct{}{}
+	endpoints := make([]string, 0, len(c.EndpointDiscovery.Endpoints)+len(c.Endpoints))
+
+	for _, endpoint := range append(append([]string(nil), c.EndpointDiscovery.Endpoints...), c.Endpoints...) {
+		if _, ok := seen[endpoint]; ok {
+			continue
+		}
+
+		seen[endpoint] = struct{}{}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	return endpoints
 }
 
 func (c *Context) upgrade() {
@@ -138,6 +192,8 @@ func (r *Rename) String() string {
 
 // Merge in additional contexts from another Config.
 //
+// Contexts with the same cluster ID replace the existing context keeping its name,
+// other contexts are renamed on conflict.
 // Current context is overridden from passed in config.
 func (c *Config) Merge(cfg *Config) []Rename {
 	if c.Contexts == nil {
@@ -150,7 +206,14 @@ func (c *Config) Merge(cfg *Config) []Rename {
 	for name, ctx := range cfg.Contexts {
 		mergedName := name
 
-		if _, exists := c.Contexts[mergedName]; exists {
+		if existingName := c.findCluster(ctx.ClusterID); existingName != "" {
+			mergedName = existingName
+
+			// keep endpoint discovery settings, as the cluster is the same
+			if ctx.EndpointDiscovery == nil {
+				ctx.EndpointDiscovery = c.Contexts[existingName].EndpointDiscovery
+			}
+		} else if _, exists := c.Contexts[mergedName]; exists {
 			for i := 1; ; i++ {
 				mergedName = fmt.Sprintf("%s-%d", name, i)
 
@@ -176,6 +239,29 @@ func (c *Config) Merge(cfg *Config) []Rename {
 	return renames
 }
 
+// findCluster returns the name of the context for the specified cluster ID.
+func (c *Config) findCluster(clusterID string) string {
+	if clusterID == "" {
+		return ""
+	}
+
+	names := make([]string, 0, len(c.Contexts))
+
+	for name, ctx := range c.Contexts {
+		if ctx.ClusterID == clusterID {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	sort.Strings(names)
+
+	return names[0]
+}
+
 func ensure(filename string) (err error) {
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
 		config := &Config{
@@ -6,6 +6,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -15,6 +16,8 @@ import (
 func TestConfigMerge(t *testing.T) {
 	context1 := &clientconfig.Context{}
 	context2 := &clientconfig.Context{}
+	context3 := &clientconfig.Context{ClusterID: "cluster1", Endpoints: []string{"10.5.0.2"}}
+	context4 := &clientconfig.Context{ClusterID: "cluster1", Endpoints: []string{"10.5.0.3"}}
 
 	for _, tt := range []struct {
 		name          string
@@ -81,6 +84,28 @@ func TestConfigMerge(t *testing.T) {
 				"bar":   context2,
 			},
 		},
+		{
+			name: "SameCluster",
+			config: &clientconfig.Config{
+				Context: "bar",
+				Contexts: map[string]*clientconfig.Context{
+					"bar":     context2,
+					"cluster": context3,
+				},
+			},
+			configToMerge: &clientconfig.Config{
+				Context: "cluster1",
+				Contexts: map[string]*clientconfig.Context{
+					"cluster1": context4,
+				},
+			},
+
+			expectedContext: "cluster",
+			expectedContexts: map[string]*clientconfig.Context{
+				"bar":     context2,
+				"cluster": context4,
+			},
+		},
 	} {
 		tt := tt
 
@@ -93,3 +118,28 @@ func TestConfigMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveEndpoints(t *testing.T) {
+	now := time.Now()
+
+	ctx := &clientconfig.Context{
+		Endpoints: []string{"10.5.0.2", "10.5.0.3"},
+	}
+
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3"}, ctx.ResolveEndpoints())
+	assert.False(t, ctx.EndpointDiscovery.NeedsRefresh(now))
+
+	ctx.EndpointDiscovery = &clientconfig.EndpointDiscovery{Enabled: true}
+
+	assert.True(t, ctx.EndpointDiscovery.NeedsRefresh(now))
+
+	ctx.EndpointDiscovery.Update([]string{"10.5.0.4", "10.5.0.3"}, now)
+
+	assert.False(t, ctx.EndpointDiscovery.NeedsRefresh(now))
+	assert.True(t, ctx.EndpointDiscovery.NeedsRefresh(now.Add(2*clientconfig.EndpointDiscoveryRefreshInterval)))
+	assert.Equal(t, []string{"10.5.0.4", "10.5.0.3", "10.5.0.2"}, ctx.ResolveEndpoints())
+
+	ctx.EndpointDiscovery.Enabled = false
+
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3"}, ctx.ResolveEndpoints())
+}
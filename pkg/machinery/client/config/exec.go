@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// ExecCredentialTimeout is the maximum time the credential helper is allowed to run.
+const ExecCredentialTimeout = 2 * time.Minute
+
+// ExecCredential configures an external command which provides the credentials.
+//
+// The command should print the JSON object with base64-encoded `ca`, `crt` and `key` fields
+// to the standard output, same as the fields of the context.
+type ExecCredential struct {
+	Command string            `yaml:"command"`
+	Args    []string          `yaml:"args,omitempty"`
+	Env     map[string]string `yaml:"env,omitempty"`
+}
+
+// ExecCredentialOutput is the output of the credential helper.
+type ExecCredentialOutput struct {
+	CA  string `json:"ca"`
+	Crt string `json:"crt"`
+	Key string `json:"key"`
+}
+
+// Run the credential helper and parse the output.
+func (e *ExecCredential) Run(ctx context.Context) (*ExecCredentialOutput, error) {
+	if e.Command == "" {
+		return nil, errors.New("credential helper command is not set")
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, e.Command, e.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+
+	for k, v := range e.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running credential helper %q: %w: %s", e.Command, err, stderr.String())
+	}
+
+	var output ExecCredentialOutput
+
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("error parsing credential helper %q output: %w", e.Command, err)
+	}
+
+	if output.CA == "" || output.Crt == "" || output.Key == "" {
+		return nil, fmt.Errorf("credential helper %q output is missing ca, crt or key", e.Command)
+	}
+
+	return &output, nil
+}
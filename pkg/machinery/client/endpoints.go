@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+	"github.com/talos-systems/talos/pkg/machinery/resources/cluster"
+)
+
+// DiscoverEndpoints returns the addresses of the control plane nodes from the cluster members.
+//
+// Cluster discovery should be enabled in the machine config for the cluster members to be available.
+func (c *Client) DiscoverEndpoints(ctx context.Context) ([]string, error) {
+	listClient, err := c.Resources.List(ctx, cluster.NamespaceName, cluster.MemberType)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []string
+
+	for {
+		msg, recvErr := listClient.Recv()
+		if recvErr != nil {
+			if recvErr == io.EOF || StatusCode(recvErr) == codes.Canceled {
+				break
+			}
+
+			return nil, recvErr
+		}
+
+		if msg.Metadata.GetError() != "" {
+			return nil, fmt.Errorf("error listing cluster members: %s", msg.Metadata.GetError())
+		}
+
+		if msg.Resource == nil {
+			continue
+		}
+
+		spec, marshalErr := yaml.Marshal(msg.Resource.Spec())
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+
+		var member struct {
+			Addresses   []string     `yaml:"addresses"`
+			MachineType machine.Type `yaml:"machineType"`
+		}
+
+		if err = yaml.Unmarshal(spec, &member); err != nil {
+			return nil, err
+		}
+
+		if member.MachineType != machine.TypeControlPlane && member.MachineType != machine.TypeInit {
+			continue
+		}
+
+		endpoints = append(endpoints, member.Addresses...)
+	}
+
+	if len(endpoints) == 0 {
+		return nil, errors.New("no control plane members discovered")
+	}
+
+	return endpoints, nil
+}
@@ -28,3 +28,14 @@ func (c *InspectClient) ControllerRuntimeDependencies(ctx context.Context, callO
 
 	return resp, err
 }
+
+// ControllerRuntimeStats returns reconcile loop statistics of the controllers.
+func (c *InspectClient) ControllerRuntimeStats(ctx context.Context, callOptions ...grpc.CallOption) (*inspectapi.ControllerRuntimeStatsResponse, error) {
+	resp, err := c.client.ControllerRuntimeStats(ctx, &emptypb.Empty{}, callOptions...)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*inspectapi.ControllerRuntimeStatsResponse) //nolint:errcheck
+
+	return resp, err
+}
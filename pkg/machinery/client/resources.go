@@ -147,6 +147,8 @@ func (client *ResourceWatchClient) Recv() (WatchResponse, error) {
 		return watchResp, err
 	}
 
+	watchResp.Metadata = msg.GetMetadata()
+
 	if msg.GetMetadata().GetError() != "" {
 		if msg.GetMetadata().Status != nil {
 			return watchResp, status.ErrorProto(msg.GetMetadata().GetStatus())
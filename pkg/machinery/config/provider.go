@@ -13,6 +13,7 @@ import (
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/talos-systems/crypto/x509"
+	"github.com/talos-systems/go-blockdevice/blockdevice/util/disk"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/encoder"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
@@ -48,6 +49,7 @@ type MachineConfig interface {
 	Security() Security
 	Network() MachineNetwork
 	Disks() []Disk
+	VolumeGroups() []VolumeGroup
 	Time() Time
 	Env() Env
 	Files() ([]File, error)
@@ -57,6 +59,7 @@ type MachineConfig interface {
 	Kubelet() Kubelet
 	Sysctls() map[string]string
 	Sysfs() map[string]string
+	SysctlProfiles() []string
 	Registries() Registries
 	SystemDiskEncryption() SystemDiskEncryption
 	Features() Features
@@ -70,6 +73,35 @@ type MachineConfig interface {
 type Disk interface {
 	Device() string
 	Partitions() []Partition
+	RAID() DiskRAID
+}
+
+// DiskRAID represents the options for a software RAID array.
+type DiskRAID interface {
+	Level() string
+	Devices() []string
+}
+
+// VolumeGroup represents the options for an LVM volume group.
+type VolumeGroup interface {
+	Name() string
+	PhysicalVolumes() []PhysicalVolume
+	LogicalVolumes() []LogicalVolume
+}
+
+// PhysicalVolume represents the options for an LVM physical volume.
+type PhysicalVolume interface {
+	Device() string
+	DiskMatchers() []disk.Matcher
+}
+
+// LogicalVolume represents the options for an LVM logical volume.
+type LogicalVolume interface {
+	Name() string
+	Size() uint64
+	Percent() string
+	Filesystem() string
+	MountPoint() string
 }
 
 // Partition represents the options for a device partition.
@@ -99,6 +131,9 @@ type Install interface {
 	Zero() bool
 	LegacyBIOSSupport() bool
 	WithBootloader() bool
+	EphemeralMaxSize() uint64
+	ExtraPartitions() []InstallPartition
+	RAIDDevices() []string
 }
 
 // Extension defines the system extension.
@@ -106,6 +141,12 @@ type Extension interface {
 	Image() string
 }
 
+// InstallPartition defines an additional partition on the installation disk.
+type InstallPartition interface {
+	Label() string
+	Size() uint64
+}
+
 // Security defines the requirements for a config that pertains to security
 // related options.
 type Security interface {
@@ -137,6 +178,7 @@ type MachineScheduler interface {
 // related options.
 type MachineNetwork interface {
 	Hostname() string
+	HostnameTemplate() string
 	Resolvers() []string
 	Devices() []Device
 	ExtraHosts() []ExtraHost
@@ -454,6 +496,32 @@ type Token interface {
 type CoreDNS interface {
 	Enabled() bool
 	Image() string
+	Upstreams() []string
+	StubDomains() []CoreDNSStubDomain
+	Cache() CoreDNSCache
+	Hosts() []ExtraHost
+	ExtraServerBlocks() []string
+	NodeLocalCache() CoreDNSNodeLocalCache
+}
+
+// CoreDNSStubDomain defines a CoreDNS stub domain.
+type CoreDNSStubDomain interface {
+	Domain() string
+	Servers() []string
+}
+
+// CoreDNSCache defines CoreDNS cache settings.
+type CoreDNSCache interface {
+	TTL() int
+	SuccessCapacity() int
+	DenialCapacity() int
+}
+
+// CoreDNSNodeLocalCache defines NodeLocal DNSCache settings.
+type CoreDNSNodeLocalCache interface {
+	Enabled() bool
+	IP() string
+	Image() string
 }
 
 // ExternalCloudProvider defines settings for external cloud provider.
@@ -462,6 +530,12 @@ type ExternalCloudProvider interface {
 	Enabled() bool
 	// ManifestURLs returns external cloud provider manifest URLs if it is enabled.
 	ManifestURLs() []string
+	// Name returns the name of the built-in cloud controller manager to deploy (if any).
+	Name() string
+	// Image returns the built-in cloud controller manager image override.
+	Image() string
+	// CloudConfig returns the cloud configuration for the built-in cloud controller manager.
+	CloudConfig() string
 }
 
 // AdminKubeconfig defines settings for admin kubeconfig.
@@ -502,6 +576,48 @@ type SystemDiskEncryption interface {
 // Features describe individual Talos features that can be switched on or off.
 type Features interface {
 	RBACEnabled() bool
+	APIServerLoadBalancer() APIServerLoadBalancer
+	LocalAPIAccess() LocalAPIAccess
+	KubernetesTalosAPIAccess() KubernetesTalosAPIAccess
+	ControllerTracing() ControllerTracing
+}
+
+// ControllerTracing describes the export of the controller runtime traces.
+type ControllerTracing interface {
+	Endpoint() *url.URL
+}
+
+// KubernetesTalosAPIAccess describes the Talos API access from Kubernetes pods.
+type KubernetesTalosAPIAccess interface {
+	Enabled() bool
+	AllowedRoles() []string
+	AllowedKubernetesNamespaces() []string
+}
+
+// LocalAPIAccess describes the local Talos API access.
+type LocalAPIAccess interface {
+	Services() []LocalAPIService
+	Service(name string) LocalAPIService
+	Vsock() LocalAPIVsock
+}
+
+// LocalAPIService describes the local Talos API access for the extension service.
+type LocalAPIService interface {
+	Name() string
+	AllowedRoles() []string
+}
+
+// LocalAPIVsock describes the Talos API access over virtio-vsock.
+type LocalAPIVsock interface {
+	Enabled() bool
+	Port() uint32
+	AllowedRoles() []string
+}
+
+// APIServerLoadBalancer describes the node-local Kubernetes API server load balancer.
+type APIServerLoadBalancer interface {
+	Enabled() bool
+	Port() int
 }
 
 // VolumeMount describes extra volume mount for the static pods.
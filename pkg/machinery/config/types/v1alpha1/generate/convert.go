@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/talos-systems/crypto/x509"
+
+	v1alpha1 "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// ConvertMachineType converts the machine config to the specified machine type.
+//
+// Conversion to the control plane fills in the control plane secrets from the secrets bundle,
+// conversion to the worker strips the control plane secrets from the config.
+//
+//nolint:gocyclo
+func ConvertMachineType(cfg *v1alpha1.Config, machineType machine.Type, secrets *SecretsBundle) (*v1alpha1.Config, error) {
+	if cfg.MachineConfig == nil || cfg.ClusterConfig == nil {
+		return nil, errors.New("machine and cluster config sections are required")
+	}
+
+	cfg = cfg.DeepCopy()
+
+	switch machineType { //nolint:exhaustive
+	case machine.TypeControlPlane:
+		if secrets == nil || secrets.Certs == nil || secrets.Secrets == nil {
+			return nil, errors.New("cluster secrets are required to convert the machine to the control plane")
+		}
+
+		if secrets.Certs.K8s == nil || len(secrets.Certs.K8s.Key) == 0 || secrets.Certs.Etcd == nil || len(secrets.Certs.Etcd.Key) == 0 ||
+			secrets.Certs.OS == nil || len(secrets.Certs.OS.Key) == 0 {
+			return nil, errors.New("cluster secrets don't contain the control plane CAs")
+		}
+
+		if cfg.ClusterConfig.ClusterCA != nil && !bytes.Equal(cfg.ClusterConfig.ClusterCA.Crt, secrets.Certs.K8s.Crt) {
+			return nil, errors.New("cluster secrets don't match the machine config: Kubernetes CA mismatch")
+		}
+
+		cfg.MachineConfig.MachineCA = secrets.Certs.OS
+		cfg.ClusterConfig.ClusterCA = secrets.Certs.K8s
+		cfg.ClusterConfig.ClusterAggregatorCA = secrets.Certs.K8sAggregator
+		cfg.ClusterConfig.ClusterServiceAccount = secrets.Certs.K8sServiceAccount
+		cfg.ClusterConfig.ClusterAESCBCEncryptionSecret = secrets.Secrets.AESCBCEncryptionSecret
+
+		if cfg.ClusterConfig.EtcdConfig == nil {
+			cfg.ClusterConfig.EtcdConfig = &v1alpha1.EtcdConfig{}
+		}
+
+		cfg.ClusterConfig.EtcdConfig.RootCA = secrets.Certs.Etcd
+	case machine.TypeWorker:
+		if cfg.MachineConfig.MachineCA != nil {
+			cfg.MachineConfig.MachineCA = &x509.PEMEncodedCertificateAndKey{Crt: cfg.MachineConfig.MachineCA.Crt}
+		}
+
+		if cfg.ClusterConfig.ClusterCA != nil {
+			cfg.ClusterConfig.ClusterCA = &x509.PEMEncodedCertificateAndKey{Crt: cfg.ClusterConfig.ClusterCA.Crt}
+		}
+
+		cfg.ClusterConfig.ClusterAggregatorCA = nil
+		cfg.ClusterConfig.ClusterServiceAccount = nil
+		cfg.ClusterConfig.ClusterAESCBCEncryptionSecret = ""
+		cfg.ClusterConfig.EtcdConfig = nil
+	default:
+		return nil, fmt.Errorf("conversion to the machine type %q is not supported", machineType)
+	}
+
+	cfg.MachineConfig.MachineType = machineType.String()
+
+	return cfg, nil
+}
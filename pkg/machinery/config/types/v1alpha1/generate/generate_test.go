@@ -5,6 +5,7 @@
 package generate_test
 
 import (
+	"bytes"
 	"crypto/x509"
 	"fmt"
 	"testing"
@@ -23,6 +24,7 @@ type GenerateSuite struct {
 	suite.Suite
 
 	input      *genv1alpha1.Input
+	secrets    *genv1alpha1.SecretsBundle
 	genOptions []genv1alpha1.GenOption
 
 	versionContract *config.VersionContract
@@ -65,9 +67,9 @@ func TestGenerateSuite(t *testing.T) {
 
 func (suite *GenerateSuite) SetupSuite() {
 	var err error
-	secrets, err := genv1alpha1.NewSecretsBundle(genv1alpha1.NewClock(), suite.genOptions...)
+	suite.secrets, err = genv1alpha1.NewSecretsBundle(genv1alpha1.NewClock(), suite.genOptions...)
 	suite.Require().NoError(err)
-	suite.input, err = genv1alpha1.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion, secrets, suite.genOptions...)
+	suite.input, err = genv1alpha1.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion, suite.secrets, suite.genOptions...)
 	suite.Require().NoError(err)
 
 	var opts genv1alpha1.GenOptions
@@ -118,6 +120,81 @@ func (suite *GenerateSuite) TestGenerateWorkerSuccess() {
 	}
 }
 
+func (suite *GenerateSuite) TestConvertMachineType() {
+	worker, err := genv1alpha1.Config(machine.TypeWorker, suite.input)
+	suite.Require().NoError(err)
+
+	_, err = genv1alpha1.ConvertMachineType(worker, machine.TypeControlPlane, nil)
+	suite.Require().Error(err)
+
+	controlPlane, err := genv1alpha1.ConvertMachineType(worker, machine.TypeControlPlane, suite.secrets)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(machine.TypeWorker.String(), worker.MachineConfig.MachineType)
+	suite.Assert().Equal(machine.TypeControlPlane, controlPlane.Machine().Type())
+	suite.Assert().Equal(suite.secrets.Certs.K8s, controlPlane.Cluster().CA())
+	suite.Assert().Equal(suite.secrets.Certs.Etcd, controlPlane.Cluster().Etcd().CA())
+	suite.Assert().Equal(suite.secrets.Certs.OS, controlPlane.Machine().Security().CA())
+
+	_, err = controlPlane.Validate(runtimeMode{false})
+	suite.Require().NoError(err)
+
+	demoted, err := genv1alpha1.ConvertMachineType(controlPlane, machine.TypeWorker, nil)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(machine.TypeWorker, demoted.Machine().Type())
+	suite.Assert().Empty(demoted.Cluster().CA().Key)
+	suite.Assert().Empty(demoted.Machine().Security().CA().Key)
+	suite.Assert().Nil(demoted.ClusterConfig.EtcdConfig)
+
+	// secrets from another cluster
+	otherSecrets, err := genv1alpha1.NewSecretsBundle(genv1alpha1.NewClock(), suite.genOptions...)
+	suite.Require().NoError(err)
+
+	_, err = genv1alpha1.ConvertMachineType(worker, machine.TypeControlPlane, otherSecrets)
+	suite.Require().Error(err)
+
+	_, err = genv1alpha1.ConvertMachineType(worker, machine.TypeInit, suite.secrets)
+	suite.Require().Error(err)
+}
+
+func (suite *GenerateSuite) TestMigrateConfig() {
+	cfg, err := genv1alpha1.Config(machine.TypeControlPlane, suite.input)
+	suite.Require().NoError(err)
+
+	source, err := cfg.Bytes()
+	suite.Require().NoError(err)
+
+	source = bytes.Replace(source, []byte("\ncluster:\n"), []byte("\ncluster:\n    podCheckpointer:\n        image: pod-checkpointer\n"), 1)
+
+	migrated, changes, err := genv1alpha1.MigrateConfig(source, config.TalosVersionCurrent)
+	suite.Require().NoError(err)
+
+	paths := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+
+	suite.Assert().Contains(paths, "cluster.podCheckpointer")
+
+	suite.Assert().True(migrated.Machine().Features().RBACEnabled())
+	suite.Assert().True(migrated.Cluster().Discovery().Enabled())
+	suite.Assert().True(migrated.Cluster().APIServer().DisablePodSecurityPolicy())
+	suite.Assert().Len(migrated.Cluster().APIServer().AdmissionControl(), 1)
+
+	_, err = migrated.Validate(runtimeMode{false})
+	suite.Require().NoError(err)
+
+	// migration is idempotent
+	source, err = migrated.Bytes()
+	suite.Require().NoError(err)
+
+	_, changes, err = genv1alpha1.MigrateConfig(source, config.TalosVersionCurrent)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(changes)
+}
+
 func (suite *GenerateSuite) TestGenerateTalosconfigSuccess() {
 	cfg, err := genv1alpha1.Talosconfig(suite.input)
 	suite.Require().NoError(err)
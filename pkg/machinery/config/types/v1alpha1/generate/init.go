@@ -69,30 +69,7 @@ func initUd(in *Input) (*v1alpha1.Config, error) {
 	var admissionControlConfig []*v1alpha1.AdmissionPluginConfig
 
 	if in.VersionContract.PodSecurityAdmissionEnabled() {
-		admissionControlConfig = append(admissionControlConfig,
-			&v1alpha1.AdmissionPluginConfig{
-				PluginName: "PodSecurity",
-				PluginConfiguration: v1alpha1.Unstructured{
-					Object: map[string]interface{}{
-						"apiVersion": "pod-security.admission.config.k8s.io/v1alpha1",
-						"kind":       "PodSecurityConfiguration",
-						"defaults": map[string]interface{}{
-							"enforce":         "baseline",
-							"enforce-version": "latest",
-							"audit":           "restricted",
-							"audit-version":   "latest",
-							"warn":            "restricted",
-							"warn-version":    "latest",
-						},
-						"exemptions": map[string]interface{}{
-							"usernames":      []interface{}{},
-							"runtimeClasses": []interface{}{},
-							"namespaces":     []interface{}{"kube-system"},
-						},
-					},
-				},
-			},
-		)
+		admissionControlConfig = append(admissionControlConfig, podSecurityAdmissionConfig())
 	}
 
 	cluster := &v1alpha1.ClusterConfig{
@@ -144,3 +121,29 @@ func initUd(in *Input) (*v1alpha1.Config, error) {
 
 	return config, nil
 }
+
+// podSecurityAdmissionConfig returns the default configuration of the PodSecurity admission plugin.
+func podSecurityAdmissionConfig() *v1alpha1.AdmissionPluginConfig {
+	return &v1alpha1.AdmissionPluginConfig{
+		PluginName: "PodSecurity",
+		PluginConfiguration: v1alpha1.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "pod-security.admission.config.k8s.io/v1alpha1",
+				"kind":       "PodSecurityConfiguration",
+				"defaults": map[string]interface{}{
+					"enforce":         "baseline",
+					"enforce-version": "latest",
+					"audit":           "restricted",
+					"audit-version":   "latest",
+					"warn":            "restricted",
+					"warn-version":    "latest",
+				},
+				"exemptions": map[string]interface{}{
+					"usernames":      []interface{}{},
+					"runtimeClasses": []interface{}{},
+					"namespaces":     []interface{}{"kube-system"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package generate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/siderolabs/go-pointer"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/config/configloader"
+	v1alpha1 "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
+)
+
+// MigrationChange describes a single change made to the config by the migration.
+type MigrationChange struct {
+	Path        string
+	Description string
+}
+
+// String implements fmt.Stringer.
+func (c MigrationChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Path, c.Description)
+}
+
+// removedField describes a config field which is no longer supported by Talos.
+type removedField struct {
+	path        []string
+	description string
+}
+
+// removedFields are dropped from the config before it is decoded, as decoding fails on unknown fields.
+var removedFields = []removedField{
+	{
+		path:        []string{"cluster", "podCheckpointer"},
+		description: "pod-checkpointer is no longer supported, field removed",
+	},
+}
+
+// MigrateConfig rewrites the machine config to the target version contract.
+//
+// Fields no longer supported by Talos are removed, deprecated fields are replaced with their successors,
+// and the defaults enabled by the target version contract are applied if the config doesn't set them explicitly.
+// The only feature which gets disabled is PodSecurityPolicy: if the target version contract doesn't enable it,
+// it's disabled on control plane nodes in favor of the PodSecurity admission plugin.
+// Migrating to the older version contract only cleans up the deprecated fields.
+//
+//nolint:gocyclo,cyclop
+func MigrateConfig(source []byte, contract *config.VersionContract) (*v1alpha1.Config, []MigrationChange, error) {
+	var (
+		raw     map[string]interface{}
+		changes []MigrationChange
+	)
+
+	if err := yaml.Unmarshal(source, &raw); err != nil {
+		return nil, nil, fmt.Errorf("error parsing config: %w", err)
+	}
+
+	for _, field := range removedFields {
+		if deleteField(raw, field.path) {
+			changes = append(changes, MigrationChange{Path: strings.Join(field.path, "."), Description: field.description})
+		}
+	}
+
+	if machineType, ok := lookupField(raw, []string{"machine", "type"}); ok && machineType == "join" {
+		changes = append(changes, MigrationChange{Path: "machine.type", Description: `deprecated type "join" replaced with "worker"`})
+	}
+
+	_, discoveryConfigured := lookupField(raw, []string{"cluster", "discovery"})
+
+	cleaned, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider, err := configloader.NewFromBytes(cleaned)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg, ok := provider.Raw().(*v1alpha1.Config)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported config type %T", provider.Raw())
+	}
+
+	cfg = cfg.DeepCopy()
+
+	if cfg.MachineConfig == nil || cfg.ClusterConfig == nil {
+		return nil, nil, fmt.Errorf("machine and cluster config sections are required")
+	}
+
+	if cfg.MachineConfig.MachineType == "join" {
+		cfg.MachineConfig.MachineType = "worker"
+	}
+
+	if cfg.MachineConfig.MachineNetwork != nil {
+		for _, device := range cfg.MachineConfig.MachineNetwork.NetworkInterfaces {
+			if device.DeviceCIDR != "" {
+				path := fmt.Sprintf("machine.network.interfaces[%s].cidr", device.DeviceInterface)
+
+				device.DeviceAddresses = append(device.DeviceAddresses, device.DeviceCIDR)
+				device.DeviceCIDR = ""
+
+				changes = append(changes, MigrationChange{Path: path, Description: "deprecated field replaced with addresses"})
+			}
+
+			for _, vlan := range device.DeviceVlans {
+				if vlan.VlanCIDR != "" {
+					path := fmt.Sprintf("machine.network.interfaces[%s].vlans[%d].cidr", device.DeviceInterface, vlan.VlanID)
+
+					vlan.VlanAddresses = append(vlan.VlanAddresses, vlan.VlanCIDR)
+					vlan.VlanCIDR = ""
+
+					changes = append(changes, MigrationChange{Path: path, Description: "deprecated field replaced with addresses"})
+				}
+			}
+		}
+	}
+
+	if contract.SupportsRBACFeature() {
+		if cfg.MachineConfig.MachineFeatures == nil {
+			cfg.MachineConfig.MachineFeatures = &v1alpha1.FeaturesConfig{}
+		}
+
+		if cfg.MachineConfig.MachineFeatures.RBAC == nil {
+			cfg.MachineConfig.MachineFeatures.RBAC = pointer.To(true)
+
+			changes = append(changes, MigrationChange{Path: "machine.features.rbac", Description: "Talos API RBAC enabled"})
+		}
+	}
+
+	if contract.ClusterDiscoveryEnabled() && !discoveryConfigured {
+		cfg.ClusterConfig.ClusterDiscoveryConfig.DiscoveryEnabled = true
+
+		changes = append(changes, MigrationChange{Path: "cluster.discovery.enabled", Description: "cluster discovery enabled"})
+	}
+
+	if cfg.Machine().Type() != machine.TypeWorker {
+		if cfg.ClusterConfig.APIServerConfig == nil {
+			cfg.ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{}
+		}
+
+		apiServer := cfg.ClusterConfig.APIServerConfig
+
+		if !contract.PodSecurityPolicyEnabled() && !apiServer.DisablePodSecurityPolicyConfig {
+			apiServer.DisablePodSecurityPolicyConfig = true
+
+			changes = append(changes, MigrationChange{
+				Path:        "cluster.apiServer.disablePodSecurityPolicy",
+				Description: "PodSecurityPolicy admission plugin and default policies disabled",
+			})
+		}
+
+		if contract.PodSecurityAdmissionEnabled() && !hasAdmissionPlugin(apiServer, "PodSecurity") {
+			apiServer.AdmissionControlConfig = append(apiServer.AdmissionControlConfig, podSecurityAdmissionConfig())
+
+			changes = append(changes, MigrationChange{
+				Path:        "cluster.apiServer.admissionControl",
+				Description: "PodSecurity admission plugin enabled with the baseline policy enforced",
+			})
+		}
+	}
+
+	return cfg, changes, nil
+}
+
+func hasAdmissionPlugin(apiServer *v1alpha1.APIServerConfig, name string) bool {
+	for _, plugin := range apiServer.AdmissionControlConfig {
+		if plugin.PluginName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lookupField(raw map[string]interface{}, path []string) (interface{}, bool) {
+	var current interface{} = raw
+
+	for _, key := range path {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func deleteField(raw map[string]interface{}, path []string) bool {
+	parent, ok := lookupField(raw, path[:len(path)-1])
+	if !ok {
+		return false
+	}
+
+	m, ok := parent.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	if _, ok = m[path[len(path)-1]]; !ok {
+		return false
+	}
+
+	delete(m, path[len(path)-1])
+
+	return true
+}
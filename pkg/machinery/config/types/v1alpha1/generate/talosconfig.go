@@ -18,5 +18,8 @@ func Talosconfig(in *Input, opts ...GenOption) (*clientconfig.Config, error) {
 		}
 	}
 
-	return clientconfig.NewConfig(in.ClusterName, options.EndpointList, in.Certs.OS.Crt, in.Certs.Admin), nil
+	config := clientconfig.NewConfig(in.ClusterName, options.EndpointList, in.Certs.OS.Crt, in.Certs.Admin)
+	config.Contexts[in.ClusterName].ClusterID = in.ClusterID
+
+	return config, nil
 }
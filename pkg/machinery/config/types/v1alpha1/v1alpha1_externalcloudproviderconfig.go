@@ -13,3 +13,18 @@ func (ecp *ExternalCloudProviderConfig) Enabled() bool {
 func (ecp *ExternalCloudProviderConfig) ManifestURLs() []string {
 	return ecp.ExternalManifests
 }
+
+// Name implements the config.ExternalCloudProvider interface.
+func (ecp *ExternalCloudProviderConfig) Name() string {
+	return ecp.ExternalName
+}
+
+// Image implements the config.ExternalCloudProvider interface.
+func (ecp *ExternalCloudProviderConfig) Image() string {
+	return ecp.ExternalImage
+}
+
+// CloudConfig implements the config.ExternalCloudProvider interface.
+func (ecp *ExternalCloudProviderConfig) CloudConfig() string {
+	return ecp.ExternalCloudConfig
+}
@@ -4,6 +4,13 @@
 
 package v1alpha1
 
+import (
+	"net/url"
+
+	"github.com/talos-systems/talos/pkg/machinery/config"
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
 // RBACEnabled implements config.Features interface.
 func (f *FeaturesConfig) RBACEnabled() bool {
 	if f.RBAC == nil {
@@ -12,3 +19,137 @@ func (f *FeaturesConfig) RBACEnabled() bool {
 
 	return *f.RBAC
 }
+
+// APIServerLoadBalancer implements config.Features interface.
+func (f *FeaturesConfig) APIServerLoadBalancer() config.APIServerLoadBalancer {
+	if f.APIServerLoadBalancerConfig == nil {
+		return &APIServerLoadBalancerConfig{}
+	}
+
+	return f.APIServerLoadBalancerConfig
+}
+
+// Enabled implements config.APIServerLoadBalancer interface.
+func (c *APIServerLoadBalancerConfig) Enabled() bool {
+	return c.LoadBalancerEnabled
+}
+
+// Port implements config.APIServerLoadBalancer interface.
+func (c *APIServerLoadBalancerConfig) Port() int {
+	if c.LoadBalancerPort == 0 {
+		return constants.DefaultAPIServerLoadBalancerPort
+	}
+
+	return c.LoadBalancerPort
+}
+
+// LocalAPIAccess implements config.Features interface.
+func (f *FeaturesConfig) LocalAPIAccess() config.LocalAPIAccess {
+	if f.LocalAPIAccessConfig == nil {
+		return &LocalAPIAccessConfig{}
+	}
+
+	return f.LocalAPIAccessConfig
+}
+
+// Services implements config.LocalAPIAccess interface.
+func (c *LocalAPIAccessConfig) Services() []config.LocalAPIService {
+	services := make([]config.LocalAPIService, len(c.LocalAPIServices))
+
+	for i := range c.LocalAPIServices {
+		services[i] = c.LocalAPIServices[i]
+	}
+
+	return services
+}
+
+// Service implements config.LocalAPIAccess interface.
+func (c *LocalAPIAccessConfig) Service(name string) config.LocalAPIService {
+	for _, service := range c.LocalAPIServices {
+		if service.LocalAPIServiceName == name {
+			return service
+		}
+	}
+
+	return nil
+}
+
+// Vsock implements config.LocalAPIAccess interface.
+func (c *LocalAPIAccessConfig) Vsock() config.LocalAPIVsock {
+	if c.LocalAPIVsock == nil {
+		return &LocalAPIVsockConfig{}
+	}
+
+	return c.LocalAPIVsock
+}
+
+// Name implements config.LocalAPIService interface.
+func (c *LocalAPIServiceConfig) Name() string {
+	return c.LocalAPIServiceName
+}
+
+// AllowedRoles implements config.LocalAPIService interface.
+func (c *LocalAPIServiceConfig) AllowedRoles() []string {
+	return c.LocalAPIServiceAllowedRoles
+}
+
+// Enabled implements config.LocalAPIVsock interface.
+func (c *LocalAPIVsockConfig) Enabled() bool {
+	return c.LocalAPIVsockEnabled
+}
+
+// Port implements config.LocalAPIVsock interface.
+func (c *LocalAPIVsockConfig) Port() uint32 {
+	if c.LocalAPIVsockPort == 0 {
+		return constants.ApidPort
+	}
+
+	return c.LocalAPIVsockPort
+}
+
+// AllowedRoles implements config.LocalAPIVsock interface.
+func (c *LocalAPIVsockConfig) AllowedRoles() []string {
+	return c.LocalAPIVsockAllowedRoles
+}
+
+// KubernetesTalosAPIAccess implements config.Features interface.
+func (f *FeaturesConfig) KubernetesTalosAPIAccess() config.KubernetesTalosAPIAccess {
+	if f.KubernetesTalosAPIAccessConfig == nil {
+		return &KubernetesTalosAPIAccessConfig{}
+	}
+
+	return f.KubernetesTalosAPIAccessConfig
+}
+
+// Enabled implements config.KubernetesTalosAPIAccess interface.
+func (c *KubernetesTalosAPIAccessConfig) Enabled() bool {
+	return c.AccessEnabled
+}
+
+// AllowedRoles implements config.KubernetesTalosAPIAccess interface.
+func (c *KubernetesTalosAPIAccessConfig) AllowedRoles() []string {
+	return c.AccessAllowedRoles
+}
+
+// AllowedKubernetesNamespaces implements config.KubernetesTalosAPIAccess interface.
+func (c *KubernetesTalosAPIAccessConfig) AllowedKubernetesNamespaces() []string {
+	return c.AccessAllowedKubernetesNamespaces
+}
+
+// ControllerTracing implements config.Features interface.
+func (f *FeaturesConfig) ControllerTracing() config.ControllerTracing {
+	if f.ControllerTracingConfig == nil {
+		return &ControllerTracingConfig{}
+	}
+
+	return f.ControllerTracingConfig
+}
+
+// Endpoint implements config.ControllerTracing interface.
+func (c *ControllerTracingConfig) Endpoint() *url.URL {
+	if c.TracingEndpoint == nil {
+		return nil
+	}
+
+	return c.TracingEndpoint.URL
+}
@@ -110,6 +110,17 @@ func (m *MachineConfig) Disks() []config.Disk {
 	return disks
 }
 
+// VolumeGroups implements the config.Provider interface.
+func (m *MachineConfig) VolumeGroups() []config.VolumeGroup {
+	vgs := make([]config.VolumeGroup, len(m.MachineVolumeGroups))
+
+	for i := 0; i < len(m.MachineVolumeGroups); i++ {
+		vgs[i] = m.MachineVolumeGroups[i]
+	}
+
+	return vgs
+}
+
 // Network implements the config.Provider interface.
 func (m *MachineConfig) Network() config.MachineNetwork {
 	if m.MachineNetwork == nil {
@@ -226,6 +237,11 @@ func (m *MachineConfig) Sysctls() map[string]string {
 	return m.MachineSysctls
 }
 
+// SysctlProfiles implements the config.Provider interface.
+func (m *MachineConfig) SysctlProfiles() []string {
+	return m.MachineSysctlProfiles
+}
+
 // Sysfs implements the config.Provider interface.
 func (m *MachineConfig) Sysfs() map[string]string {
 	if m.MachineSysfs == nil {
@@ -469,6 +485,11 @@ func (n *NetworkConfig) Hostname() string {
 	return n.NetworkHostname
 }
 
+// HostnameTemplate implements the config.Provider interface.
+func (n *NetworkConfig) HostnameTemplate() string {
+	return n.NetworkHostnameTemplate
+}
+
 // DisableSearchDomain implements the config.Provider interface.
 func (n *NetworkConfig) DisableSearchDomain() bool {
 	return n.NetworkDisableSearchDomain
@@ -1030,6 +1051,10 @@ func (i *InstallConfig) Extensions() []config.Extension {
 
 // Disk implements the config.Provider interface.
 func (i *InstallConfig) Disk() (string, error) {
+	if len(i.RAIDDevices()) > 0 {
+		return "/dev/md/" + constants.SystemRAIDArrayName, nil
+	}
+
 	matchers := i.DiskMatchers()
 	if len(matchers) > 0 {
 		d, err := disk.Find(matchers...)
@@ -1048,52 +1073,54 @@ func (i *InstallConfig) Disk() (string, error) {
 }
 
 // DiskMatchers implements the config.Provider interface.
-//nolint:gocyclo
 func (i *InstallConfig) DiskMatchers() []disk.Matcher {
 	if i.InstallDiskSelector != nil {
-		selector := i.InstallDiskSelector
+		return i.InstallDiskSelector.matchers()
+	}
 
-		matchers := []disk.Matcher{}
-		if selector.Size != nil {
-			matchers = append(matchers, selector.Size.Matcher)
-		}
+	return nil
+}
 
-		if selector.UUID != "" {
-			matchers = append(matchers, disk.WithUUID(selector.UUID))
-		}
+//nolint:gocyclo
+func (selector *InstallDiskSelector) matchers() []disk.Matcher {
+	matchers := []disk.Matcher{}
+	if selector.Size != nil {
+		matchers = append(matchers, selector.Size.Matcher)
+	}
 
-		if selector.WWID != "" {
-			matchers = append(matchers, disk.WithWWID(selector.WWID))
-		}
+	if selector.UUID != "" {
+		matchers = append(matchers, disk.WithUUID(selector.UUID))
+	}
 
-		if selector.Model != "" {
-			matchers = append(matchers, disk.WithModel(selector.Model))
-		}
+	if selector.WWID != "" {
+		matchers = append(matchers, disk.WithWWID(selector.WWID))
+	}
 
-		if selector.Name != "" {
-			matchers = append(matchers, disk.WithName(selector.Name))
-		}
+	if selector.Model != "" {
+		matchers = append(matchers, disk.WithModel(selector.Model))
+	}
 
-		if selector.Serial != "" {
-			matchers = append(matchers, disk.WithSerial(selector.Serial))
-		}
+	if selector.Name != "" {
+		matchers = append(matchers, disk.WithName(selector.Name))
+	}
 
-		if selector.Modalias != "" {
-			matchers = append(matchers, disk.WithModalias(selector.Modalias))
-		}
+	if selector.Serial != "" {
+		matchers = append(matchers, disk.WithSerial(selector.Serial))
+	}
 
-		if disk.Type(selector.Type) != disk.TypeUnknown {
-			matchers = append(matchers, disk.WithType(disk.Type(selector.Type)))
-		}
+	if selector.Modalias != "" {
+		matchers = append(matchers, disk.WithModalias(selector.Modalias))
+	}
 
-		if selector.BusPath != "" {
-			matchers = append(matchers, disk.WithBusPath(selector.BusPath))
-		}
+	if disk.Type(selector.Type) != disk.TypeUnknown {
+		matchers = append(matchers, disk.WithType(disk.Type(selector.Type)))
+	}
 
-		return matchers
+	if selector.BusPath != "" {
+		matchers = append(matchers, disk.WithBusPath(selector.BusPath))
 	}
 
-	return nil
+	return matchers
 }
 
 // ExtraKernelArgs implements the config.Provider interface.
@@ -1116,11 +1143,50 @@ func (i *InstallConfig) WithBootloader() bool {
 	return i.InstallBootloader
 }
 
+// EphemeralMaxSize implements the config.Provider interface.
+func (i *InstallConfig) EphemeralMaxSize() uint64 {
+	return uint64(i.InstallEphemeralMaxSize)
+}
+
+// ExtraPartitions implements the config.Provider interface.
+func (i *InstallConfig) ExtraPartitions() []config.InstallPartition {
+	if len(i.InstallExtraPartitions) == 0 {
+		return nil
+	}
+
+	partitions := make([]config.InstallPartition, 0, len(i.InstallExtraPartitions))
+
+	for _, partition := range i.InstallExtraPartitions {
+		partitions = append(partitions, partition)
+	}
+
+	return partitions
+}
+
+// RAIDDevices implements the config.Provider interface.
+func (i *InstallConfig) RAIDDevices() []string {
+	if i.InstallRAID == nil {
+		return nil
+	}
+
+	return i.InstallRAID.RAIDDevices
+}
+
 // Image implements the config.Provider interface.
 func (i InstallExtensionConfig) Image() string {
 	return i.ExtensionImage
 }
 
+// Label implements the config.Provider interface.
+func (p *InstallExtraPartition) Label() string {
+	return p.PartitionLabel
+}
+
+// Size implements the config.Provider interface.
+func (p *InstallExtraPartition) Size() uint64 {
+	return uint64(p.PartitionSize)
+}
+
 // Enabled implements the config.Provider interface.
 func (c *CoreDNS) Enabled() bool {
 	return !c.CoreDNSDisabled
@@ -1137,6 +1203,108 @@ func (c *CoreDNS) Image() string {
 	return coreDNSImage
 }
 
+// Upstreams implements the config.Provider interface.
+func (c *CoreDNS) Upstreams() []string {
+	return c.CoreDNSUpstreams
+}
+
+// StubDomains implements the config.Provider interface.
+func (c *CoreDNS) StubDomains() []config.CoreDNSStubDomain {
+	stubDomains := make([]config.CoreDNSStubDomain, len(c.CoreDNSStubDomains))
+
+	for i := range c.CoreDNSStubDomains {
+		stubDomains[i] = c.CoreDNSStubDomains[i]
+	}
+
+	return stubDomains
+}
+
+// Cache implements the config.Provider interface.
+func (c *CoreDNS) Cache() config.CoreDNSCache {
+	if c.CoreDNSCacheConfig == nil {
+		return &CoreDNSCacheConfig{}
+	}
+
+	return c.CoreDNSCacheConfig
+}
+
+// Hosts implements the config.Provider interface.
+func (c *CoreDNS) Hosts() []config.ExtraHost {
+	hosts := make([]config.ExtraHost, len(c.CoreDNSHosts))
+
+	for i := range c.CoreDNSHosts {
+		hosts[i] = c.CoreDNSHosts[i]
+	}
+
+	return hosts
+}
+
+// ExtraServerBlocks implements the config.Provider interface.
+func (c *CoreDNS) ExtraServerBlocks() []string {
+	return c.CoreDNSExtraServerBlocks
+}
+
+// NodeLocalCache implements the config.Provider interface.
+func (c *CoreDNS) NodeLocalCache() config.CoreDNSNodeLocalCache {
+	if c.CoreDNSNodeLocalCache == nil {
+		return &CoreDNSNodeLocalCacheConfig{}
+	}
+
+	return c.CoreDNSNodeLocalCache
+}
+
+// Domain implements the config.Provider interface.
+func (s *CoreDNSStubDomain) Domain() string {
+	return s.StubDomainName
+}
+
+// Servers implements the config.Provider interface.
+func (s *CoreDNSStubDomain) Servers() []string {
+	return s.StubDomainServers
+}
+
+// TTL implements the config.Provider interface.
+func (c *CoreDNSCacheConfig) TTL() int {
+	if c.CacheTTL == 0 {
+		return constants.DefaultCoreDNSCacheTTL
+	}
+
+	return c.CacheTTL
+}
+
+// SuccessCapacity implements the config.Provider interface.
+func (c *CoreDNSCacheConfig) SuccessCapacity() int {
+	return c.CacheSuccessCapacity
+}
+
+// DenialCapacity implements the config.Provider interface.
+func (c *CoreDNSCacheConfig) DenialCapacity() int {
+	return c.CacheDenialCapacity
+}
+
+// Enabled implements the config.Provider interface.
+func (c *CoreDNSNodeLocalCacheConfig) Enabled() bool {
+	return c.NodeLocalCacheEnabled
+}
+
+// IP implements the config.Provider interface.
+func (c *CoreDNSNodeLocalCacheConfig) IP() string {
+	if c.NodeLocalCacheIP == "" {
+		return constants.DefaultNodeLocalDNSIP
+	}
+
+	return c.NodeLocalCacheIP
+}
+
+// Image implements the config.Provider interface.
+func (c *CoreDNSNodeLocalCacheConfig) Image() string {
+	if c.NodeLocalCacheImage == "" {
+		return fmt.Sprintf("%s:%s", constants.NodeLocalDNSImage, constants.DefaultNodeLocalDNSVersion)
+	}
+
+	return c.NodeLocalCacheImage
+}
+
 // CertLifetime implements the config.Provider interface.
 func (a *AdminKubeconfigConfig) CertLifetime() time.Duration {
 	if a.AdminKubeconfigCertLifetime == 0 {
@@ -1187,6 +1355,95 @@ func (d *MachineDisk) Partitions() []config.Partition {
 	return partitions
 }
 
+// RAID implements the config.Provider interface.
+func (d *MachineDisk) RAID() config.DiskRAID {
+	if d.DiskRAID == nil {
+		return nil
+	}
+
+	return d.DiskRAID
+}
+
+// Level implements the config.Provider interface.
+func (r *DiskRAIDConfig) Level() string {
+	return r.RAIDLevel
+}
+
+// Devices implements the config.Provider interface.
+func (r *DiskRAIDConfig) Devices() []string {
+	return r.RAIDDevices
+}
+
+// Name implements the config.Provider interface.
+func (v *VolumeGroupConfig) Name() string {
+	return v.VolumeGroupName
+}
+
+// PhysicalVolumes implements the config.Provider interface.
+func (v *VolumeGroupConfig) PhysicalVolumes() []config.PhysicalVolume {
+	pvs := make([]config.PhysicalVolume, len(v.VolumeGroupPhysicalVolumes))
+
+	for i := 0; i < len(v.VolumeGroupPhysicalVolumes); i++ {
+		pvs[i] = v.VolumeGroupPhysicalVolumes[i]
+	}
+
+	return pvs
+}
+
+// LogicalVolumes implements the config.Provider interface.
+func (v *VolumeGroupConfig) LogicalVolumes() []config.LogicalVolume {
+	lvs := make([]config.LogicalVolume, len(v.VolumeGroupLogicalVolumes))
+
+	for i := 0; i < len(v.VolumeGroupLogicalVolumes); i++ {
+		lvs[i] = v.VolumeGroupLogicalVolumes[i]
+	}
+
+	return lvs
+}
+
+// Device implements the config.Provider interface.
+func (p *PhysicalVolumeConfig) Device() string {
+	return p.PhysicalVolumeDevice
+}
+
+// DiskMatchers implements the config.Provider interface.
+func (p *PhysicalVolumeConfig) DiskMatchers() []disk.Matcher {
+	if p.PhysicalVolumeDiskSelector != nil {
+		return p.PhysicalVolumeDiskSelector.matchers()
+	}
+
+	return nil
+}
+
+// Name implements the config.Provider interface.
+func (l *LogicalVolumeConfig) Name() string {
+	return l.LogicalVolumeName
+}
+
+// Size implements the config.Provider interface.
+func (l *LogicalVolumeConfig) Size() uint64 {
+	return uint64(l.LogicalVolumeSize)
+}
+
+// Percent implements the config.Provider interface.
+func (l *LogicalVolumeConfig) Percent() string {
+	return l.LogicalVolumePercent
+}
+
+// Filesystem implements the config.Provider interface.
+func (l *LogicalVolumeConfig) Filesystem() string {
+	if l.LogicalVolumeFilesystem == "" {
+		return "xfs"
+	}
+
+	return l.LogicalVolumeFilesystem
+}
+
+// MountPoint implements the config.Provider interface.
+func (l *LogicalVolumeConfig) MountPoint() string {
+	return l.LogicalVolumeMountPoint
+}
+
 // Size implements the config.Provider interface.
 func (p *DiskPartition) Size() uint64 {
 	return uint64(p.DiskSize)
@@ -179,6 +179,46 @@ var (
 		},
 	}
 
+	machineDisksRAIDExample = []*MachineDisk{
+		{
+			DeviceName: "/dev/md/data",
+			DiskRAID: &DiskRAIDConfig{
+				RAIDLevel:   "raid10",
+				RAIDDevices: []string{"/dev/sdb", "/dev/sdc", "/dev/sdd", "/dev/sde"},
+			},
+			DiskPartitions: []*DiskPartition{
+				{
+					DiskMountPoint: "/var/mnt/data",
+				},
+			},
+		},
+	}
+
+	machineVolumeGroupsExample = []*VolumeGroupConfig{
+		{
+			VolumeGroupName: "data",
+			VolumeGroupPhysicalVolumes: []*PhysicalVolumeConfig{
+				{
+					PhysicalVolumeDiskSelector: &InstallDiskSelector{
+						Type: InstallDiskType(disk.TypeHDD),
+					},
+				},
+			},
+			VolumeGroupLogicalVolumes: []*LogicalVolumeConfig{
+				{
+					LogicalVolumeName:       "local-pv",
+					LogicalVolumeSize:       100 * 1024 * 1024 * 1024,
+					LogicalVolumeMountPoint: "/var/mnt/local-pv",
+				},
+				{
+					LogicalVolumeName:       "scratch",
+					LogicalVolumePercent:    "50%",
+					LogicalVolumeMountPoint: "/var/mnt/scratch",
+				},
+			},
+		},
+	}
+
 	machineInstallExample = &InstallConfig{
 		InstallDisk:            "/dev/sda",
 		InstallExtraKernelArgs: []string{"console=ttyS1", "panic=10"},
@@ -194,6 +234,16 @@ var (
 		},
 	}
 
+	machineInstallRAIDExample = &InstallRAIDConfig{
+		RAIDDevices: []string{"/dev/sda", "/dev/sdb"},
+	}
+
+	machineInstallExtraPartitionsExample = []*InstallExtraPartition{
+		{
+			PartitionLabel: "LOCAL-PV",
+		},
+	}
+
 	machineInstallDiskSizeMatcherExamples = []*InstallDiskSizeMatcher{
 		{
 			condition: "4GB",
@@ -244,6 +294,8 @@ var (
 		"devices.system.cpu.cpu0.cpufreq.scaling_governor": "performance",
 	}
 
+	machineSysctlProfilesExample = []string{"network-throughput"}
+
 	machineSystemDiskEncryptionExample = &SystemDiskEncryptionConfig{
 		EphemeralPartition: &EncryptionConfig{
 			EncryptionProvider: "luks2",
@@ -260,6 +312,42 @@ var (
 		RBAC: pointer.To(true),
 	}
 
+	machineAPIServerLoadBalancerExample = &APIServerLoadBalancerConfig{
+		LoadBalancerEnabled: true,
+		LoadBalancerPort:    7445,
+	}
+
+	machineLocalAPIAccessExample = &LocalAPIAccessConfig{
+		LocalAPIServices: []*LocalAPIServiceConfig{
+			{
+				LocalAPIServiceName:         "monitoring-agent",
+				LocalAPIServiceAllowedRoles: []string{"os:reader"},
+			},
+		},
+		LocalAPIVsock: &LocalAPIVsockConfig{
+			LocalAPIVsockEnabled:      true,
+			LocalAPIVsockAllowedRoles: []string{"os:reader"},
+		},
+	}
+
+	machineKubernetesTalosAPIAccessExample = &KubernetesTalosAPIAccessConfig{
+		AccessEnabled: true,
+		AccessAllowedRoles: []string{
+			"os:reader",
+		},
+		AccessAllowedKubernetesNamespaces: []string{
+			"kube-system",
+		},
+	}
+
+	controllerTracingEndpointExample = &Endpoint{
+		mustParseURL("http://otel-collector:4318"),
+	}
+
+	machineControllerTracingExample = &ControllerTracingConfig{
+		TracingEndpoint: controllerTracingEndpointExample,
+	}
+
 	machineUdevExample = &UdevConfig{
 		UdevRules: []string{"SUBSYSTEM==\"drm\", KERNEL==\"renderD*\", GROUP=\"44\", MODE=\"0660\""},
 	}
@@ -351,6 +439,28 @@ var (
 		CoreDNSImage: (&CoreDNS{}).Image(),
 	}
 
+	clusterCoreDNSStubDomainsExample = []*CoreDNSStubDomain{
+		{
+			StubDomainName:    "corp.example.com",
+			StubDomainServers: []string{"10.150.0.1", "10.150.0.2"},
+		},
+	}
+
+	clusterCoreDNSCacheExample = &CoreDNSCacheConfig{
+		CacheTTL:             60,
+		CacheSuccessCapacity: 20000,
+		CacheDenialCapacity:  5000,
+	}
+
+	clusterCoreDNSExtraServerBlocksExample = []string{
+		"consul.local:53 {\n    errors\n    cache 30\n    forward . 10.150.0.1\n}\n",
+	}
+
+	clusterCoreDNSNodeLocalCacheExample = &CoreDNSNodeLocalCacheConfig{
+		NodeLocalCacheEnabled: true,
+		NodeLocalCacheIP:      constants.DefaultNodeLocalDNSIP,
+	}
+
 	clusterExternalCloudProviderConfigExample = &ExternalCloudProviderConfig{
 		ExternalEnabled: true,
 		ExternalManifests: []string{
@@ -691,8 +801,18 @@ type MachineConfig struct {
 	//   examples:
 	//     - name: MachineDisks list example.
 	//       value: machineDisksExample
+	//     - name: MachineDisks on top of software RAID array.
+	//       value: machineDisksRAIDExample
 	MachineDisks []*MachineDisk `yaml:"disks,omitempty"` // Note: `size` is in units of bytes.
 	//   description: |
+	//     Used to create LVM volume groups and logical volumes, and to format and mount the logical volumes.
+	//     Physical volumes, volume groups and logical volumes are created only if they don't exist.
+	//     Physical volumes picked by the disk selector should be empty (no partition table).
+	//     Since the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.
+	//   examples:
+	//     - value: machineVolumeGroupsExample
+	MachineVolumeGroups []*VolumeGroupConfig `yaml:"volumeGroups,omitempty"`
+	//   description: |
 	//     Used to provide instructions for installations.
 	//   examples:
 	//     - name: MachineInstall config usage example.
@@ -743,6 +863,18 @@ type MachineConfig struct {
 	//       value: machineSysfsExample
 	MachineSysfs map[string]string `yaml:"sysfs,omitempty"`
 	//   description: |
+	//     Sysctl tuning profiles to apply.
+	//
+	//     Profiles expand into the set of sysctls, explicit `sysctls` take precedence over the profile values.
+	//     Sysctls not supported by the running kernel are skipped.
+	//   values:
+	//     - network-throughput
+	//     - low-latency
+	//     - etcd
+	//   examples:
+	//     - value: machineSysctlProfilesExample
+	MachineSysctlProfiles []string `yaml:"sysctlProfiles,omitempty"`
+	//   description: |
 	//     Used to configure the machine's container image registry mirrors.
 	//
 	//     Automatically generates matching CRI configuration for registry mirrors.
@@ -1040,6 +1172,19 @@ type NetworkConfig struct {
 	//     Used to statically set the hostname for the machine.
 	NetworkHostname string `yaml:"hostname,omitempty"`
 	//   description: |
+	//     Template to generate the hostname for the machine.
+	//
+	//     The template is evaluated with Go `text/template`, the generated hostname doesn't change when the node addresses change.
+	//     Available fields are `.SMBIOS.UUID`, `.SMBIOS.SerialNumber`, `.SMBIOS.Manufacturer`, `.SMBIOS.ProductName`, `.SMBIOS.SKUNumber`,
+	//     the hardware address of the link without separators `.Hardware.MAC "eth0"`,
+	//     and the platform metadata `.Platform.Name`, `.Platform.InstanceID` (empty if not reported by the platform).
+	//     Function `hash` returns a stable short hash of the value, and `lower` converts the value to lower case.
+	//     Hostname is not generated if the template refers to a missing link or hashes an empty value.
+	//     Can't be used together with `hostname`.
+	//   examples:
+	//     - value: '"talos-{{ hash .SMBIOS.UUID }}"'
+	NetworkHostnameTemplate string `yaml:"hostnameTemplate,omitempty"`
+	//   description: |
 	//     `interfaces` is used to define the network interface configuration.
 	//     By default all network interfaces will attempt a DHCP discovery.
 	//     This can be further tuned through this configuration parameter.
@@ -1126,6 +1271,37 @@ type InstallConfig struct {
 	//     Indicates if MBR partition should be marked as bootable (active).
 	//     Should be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme.
 	InstallLegacyBIOSSupport bool `yaml:"legacyBIOSSupport,omitempty"`
+	//   description: |
+	//     Limits the size of the EPHEMERAL partition.
+	//     By default EPHEMERAL partition occupies all the remaining space on the installation disk.
+	//     If set, EPHEMERAL partition is created with the specified size, and the remaining space
+	//     might be used for `extraPartitions`.
+	//   examples:
+	//     - name: Human readable representation.
+	//       value: DiskSize(100000000000)
+	InstallEphemeralMaxSize DiskSize `yaml:"ephemeralMaxSize,omitempty"`
+	//   description: |
+	//     Additional partitions to create on the installation disk after the EPHEMERAL partition.
+	//     Partitions are created without a filesystem, and they are preserved across upgrades.
+	//     Extra partitions require `ephemeralMaxSize` to be set.
+	//   examples:
+	//     - value: machineInstallExtraPartitionsExample
+	InstallExtraPartitions []*InstallExtraPartition `yaml:"extraPartitions,omitempty"`
+	//   description: |
+	//     Install Talos on a software RAID 1 (mirror) array built from the specified disks.
+	//     EFI and BIOS boot partitions are created on each disk, while BOOT, META, STATE and EPHEMERAL partitions
+	//     are created on the array.
+	//     If set, `disk` and `diskSelector` are ignored.
+	//     Software RAID requires `mdadm` binary to be available (e.g. via system extension).
+	//   examples:
+	//     - value: machineInstallRAIDExample
+	InstallRAID *InstallRAIDConfig `yaml:"raid,omitempty"`
+}
+
+// InstallRAIDConfig describes the software RAID array for the system disk.
+type InstallRAIDConfig struct {
+	//   description: Disks used as the array members.
+	RAIDDevices []string `yaml:"devices"`
 }
 
 // InstallDiskSizeMatcher disk size condition parser.
@@ -1266,6 +1442,25 @@ type InstallDiskSelector struct {
 	BusPath string `yaml:"busPath,omitempty"`
 }
 
+// InstallExtraPartition represents an additional partition on the installation disk.
+type InstallExtraPartition struct {
+	//   description: |
+	//     The partition label (GPT partition name).
+	//   examples:
+	//     - value: '"LOCAL-PV"'
+	PartitionLabel string `yaml:"label"`
+	//   description: >
+	//     The size of the partition: either bytes or human readable representation.
+	//     If `size:` is omitted, the partition occupies all the remaining space on the disk
+	//     (allowed only for the last partition).
+	//   examples:
+	//     - name: Human readable representation.
+	//       value: DiskSize(100000000)
+	//     - name: Precise value in bytes.
+	//       value: 1024 * 1024 * 1024
+	PartitionSize DiskSize `yaml:"size,omitempty"`
+}
+
 // InstallExtensionConfig represents a configuration for a system extension.
 type InstallExtensionConfig struct {
 	//   description: System extension image.
@@ -1327,6 +1522,75 @@ type CoreDNS struct {
 	//   description: |
 	//     The `image` field is an override to the default coredns image.
 	CoreDNSImage string `yaml:"image,omitempty"`
+	//   description: |
+	//     Upstream DNS servers for the queries outside of the cluster domain and stub domains.
+	//     By default, queries are forwarded to the resolvers of the node (`/etc/resolv.conf`).
+	//     Servers can be specified as `IP`, `IP:port`, or `tls://IP` for DNS-over-TLS.
+	//   examples:
+	//     - value: '[]string{"1.1.1.1", "8.8.8.8:53"}'
+	CoreDNSUpstreams []string `yaml:"upstreams,omitempty"`
+	//   description: |
+	//     Stub domains forward the queries for a domain to a specific set of DNS servers.
+	//   examples:
+	//     - value: clusterCoreDNSStubDomainsExample
+	CoreDNSStubDomains []*CoreDNSStubDomain `yaml:"stubDomains,omitempty"`
+	//   description: |
+	//     CoreDNS cache settings.
+	//   examples:
+	//     - value: clusterCoreDNSCacheExample
+	CoreDNSCacheConfig *CoreDNSCacheConfig `yaml:"cache,omitempty"`
+	//   description: |
+	//     Static host entries served by CoreDNS for all cluster workloads.
+	//   examples:
+	//     - value: networkConfigExtraHostsExample
+	CoreDNSHosts []*ExtraHost `yaml:"hosts,omitempty"`
+	//   description: |
+	//     Extra Corefile server blocks appended to the CoreDNS configuration.
+	//   examples:
+	//     - value: clusterCoreDNSExtraServerBlocksExample
+	CoreDNSExtraServerBlocks []string `yaml:"extraServerBlocks,omitempty"`
+	//   description: |
+	//     NodeLocal DNSCache runs a DNS caching agent on every node.
+	//     When enabled, kubelet `clusterDNS` defaults to the link-local IP of the caching agent.
+	//   examples:
+	//     - value: clusterCoreDNSNodeLocalCacheExample
+	CoreDNSNodeLocalCache *CoreDNSNodeLocalCacheConfig `yaml:"nodeLocalCache,omitempty"`
+}
+
+// CoreDNSStubDomain represents a CoreDNS stub domain.
+type CoreDNSStubDomain struct {
+	//   description: |
+	//     The DNS domain name.
+	StubDomainName string `yaml:"domain"`
+	//   description: |
+	//     DNS servers to forward the queries for the domain to.
+	StubDomainServers []string `yaml:"servers"`
+}
+
+// CoreDNSCacheConfig represents CoreDNS cache settings.
+type CoreDNSCacheConfig struct {
+	//   description: |
+	//     Maximum TTL of the cached responses in seconds (default is 30).
+	CacheTTL int `yaml:"ttl,omitempty"`
+	//   description: |
+	//     Maximum number of the cached successful responses (CoreDNS default is 9984).
+	CacheSuccessCapacity int `yaml:"successCapacity,omitempty"`
+	//   description: |
+	//     Maximum number of the cached denial responses (CoreDNS default is 9984).
+	CacheDenialCapacity int `yaml:"denialCapacity,omitempty"`
+}
+
+// CoreDNSNodeLocalCacheConfig represents NodeLocal DNSCache settings.
+type CoreDNSNodeLocalCacheConfig struct {
+	//   description: |
+	//     Enable NodeLocal DNSCache deployment on cluster bootstrap.
+	NodeLocalCacheEnabled bool `yaml:"enabled"`
+	//   description: |
+	//     Link-local IP address the caching agent listens on (default is `169.254.20.10`).
+	NodeLocalCacheIP string `yaml:"ip,omitempty"`
+	//   description: |
+	//     The `image` field is an override to the default NodeLocal DNSCache image.
+	NodeLocalCacheImage string `yaml:"image,omitempty"`
 }
 
 // Endpoint represents the endpoint URL parsed out of the machine config.
@@ -1605,6 +1869,29 @@ type ExternalCloudProviderConfig struct {
 	//         "https://raw.githubusercontent.com/kubernetes/cloud-provider-aws/v1.20.0-alpha.0/manifests/aws-cloud-controller-manager-daemonset.yaml",
 	//        }
 	ExternalManifests []string `yaml:"manifests,omitempty"`
+	//   description: |
+	//     Name of the built-in cloud controller manager to deploy.
+	//     Talos renders the cloud controller manager manifests for the selected cloud provider
+	//     with the cluster settings, and `talosctl upgrade-k8s` keeps them up to date.
+	//   values:
+	//     - aws
+	//     - azure
+	//     - gcp
+	//     - hcloud
+	//     - openstack
+	//   examples:
+	//     - value: '"aws"'
+	ExternalName string `yaml:"name,omitempty"`
+	//   description: |
+	//     The `image` field is an override to the default built-in cloud controller manager image.
+	//     By default, the image version follows the Kubernetes version for `aws`, `azure` and `openstack`.
+	ExternalImage string `yaml:"image,omitempty"`
+	//   description: |
+	//     Cloud configuration file contents passed to the built-in cloud controller manager via `--cloud-config`.
+	//     Required for `azure` and `openstack`, optional for `aws` and `gcp`.
+	//     Not supported for `hcloud`: the Hetzner Cloud API token is read from the `token` key
+	//     of the `hcloud` secret in the `kube-system` namespace.
+	ExternalCloudConfig string `yaml:"cloudConfig,omitempty"`
 }
 
 // AdminKubeconfigConfig contains admin kubeconfig settings.
@@ -1622,6 +1909,79 @@ type MachineDisk struct {
 	DeviceName string `yaml:"device,omitempty"`
 	//   description: A list of partitions to create on the disk.
 	DiskPartitions []*DiskPartition `yaml:"partitions,omitempty"`
+	//   description: |
+	//     Software RAID (md) array to be used as the disk.
+	//     The array is assembled from the member devices, or created if none of the member devices contains RAID metadata.
+	//     The `device` should be set to the array path `/dev/md/<name>`.
+	DiskRAID *DiskRAIDConfig `yaml:"raid,omitempty"`
+}
+
+// DiskRAIDConfig describes a software RAID array.
+type DiskRAIDConfig struct {
+	//   description: RAID level.
+	//   values:
+	//     - raid0
+	//     - raid1
+	//     - raid10
+	RAIDLevel string `yaml:"level"`
+	//   description: |
+	//     Member devices of the array.
+	//     RAID 0 and RAID 1 require at least 2 devices, RAID 10 requires at least 4 devices.
+	//   examples:
+	//     - value: '[]string{"/dev/sdb", "/dev/sdc"}'
+	RAIDDevices []string `yaml:"devices"`
+}
+
+// VolumeGroupConfig describes an LVM volume group.
+type VolumeGroupConfig struct {
+	//   description: Volume group name.
+	VolumeGroupName string `yaml:"name"`
+	//   description: Physical volumes of the volume group.
+	VolumeGroupPhysicalVolumes []*PhysicalVolumeConfig `yaml:"physicalVolumes"`
+	//   description: Logical volumes to create in the volume group.
+	VolumeGroupLogicalVolumes []*LogicalVolumeConfig `yaml:"logicalVolumes,omitempty"`
+}
+
+// PhysicalVolumeConfig describes an LVM physical volume.
+type PhysicalVolumeConfig struct {
+	//   description: |
+	//     Block device to use as the physical volume.
+	//   examples:
+	//     - value: '"/dev/sdb"'
+	PhysicalVolumeDevice string `yaml:"device,omitempty"`
+	//   description: |
+	//     Disk selector to pick block devices for the physical volumes.
+	//     All matching empty disks are used as physical volumes.
+	PhysicalVolumeDiskSelector *InstallDiskSelector `yaml:"diskSelector,omitempty"`
+}
+
+// LogicalVolumeConfig describes an LVM logical volume.
+type LogicalVolumeConfig struct {
+	//   description: Logical volume name.
+	LogicalVolumeName string `yaml:"name"`
+	//   description: |
+	//     The size of the logical volume: either bytes or human readable representation.
+	//   examples:
+	//     - value: DiskSize(100000000)
+	LogicalVolumeSize DiskSize `yaml:"size,omitempty"`
+	//   description: |
+	//     The size of the logical volume as a percentage of the volume group size.
+	//     If neither `size` nor `percent` is set, the logical volume occupies all the free space.
+	//   examples:
+	//     - value: '"50%"'
+	LogicalVolumePercent string `yaml:"percent,omitempty"`
+	//   description: |
+	//     Filesystem to format the logical volume with.
+	//   values:
+	//     - xfs
+	//     - none
+	LogicalVolumeFilesystem string `yaml:"filesystem,omitempty"`
+	//   description: |
+	//     Where to mount the logical volume.
+	//     The logical volume is not mounted if the mountpoint is not set.
+	//   examples:
+	//     - value: '"/var/mnt/local-pv"'
+	LogicalVolumeMountPoint string `yaml:"mountpoint,omitempty"`
 }
 
 // DiskSize partition size in bytes.
@@ -2148,6 +2508,120 @@ type FeaturesConfig struct {
 	//   description: |
 	//     Enable role-based access control (RBAC).
 	RBAC *bool `yaml:"rbac,omitempty"`
+	//   description: |
+	//     Configure the node-local load balancer for the Kubernetes API server.
+	//
+	//     When enabled, Talos runs a TCP load balancer on `localhost` which balances the connections
+	//     across healthy control plane nodes, and kubelet and kube-proxy use it instead of the cluster endpoint.
+	//   examples:
+	//     - value: machineAPIServerLoadBalancerExample
+	APIServerLoadBalancerConfig *APIServerLoadBalancerConfig `yaml:"apiServerLoadBalancer,omitempty"`
+	//   description: |
+	//     Configure the local Talos API access for the extension services and the VM host.
+	//
+	//     Local Talos API is served over the Unix socket which is mounted into the extension services listed in the config,
+	//     the identity of the extension service is derived from the peer credentials of the connection.
+	//     Talos API might also be exposed over the virtio-vsock to the VM host.
+	//   examples:
+	//     - value: machineLocalAPIAccessExample
+	LocalAPIAccessConfig *LocalAPIAccessConfig `yaml:"localAPIAccess,omitempty"`
+	//   description: |
+	//     Configure Talos API access from Kubernetes pods.
+	//
+	//     This feature is only supported on control plane nodes.
+	//     When enabled, Talos issues short-lived Talos API client certificates to the `talos.dev/v1alpha1` `ServiceAccount`
+	//     resources and stores them as Kubernetes secrets with the same name which can be mounted into the pods.
+	//   examples:
+	//     - value: machineKubernetesTalosAPIAccessExample
+	KubernetesTalosAPIAccessConfig *KubernetesTalosAPIAccessConfig `yaml:"kubernetesTalosAPIAccess,omitempty"`
+	//   description: |
+	//     Configure the export of the controller runtime traces.
+	//
+	//     When enabled, Talos exports a span for each controller reconcile loop
+	//     to the OpenTelemetry collector using the OTLP/HTTP protocol.
+	//   examples:
+	//     - value: machineControllerTracingExample
+	ControllerTracingConfig *ControllerTracingConfig `yaml:"controllerTracing,omitempty"`
+}
+
+// ControllerTracingConfig describes the export of the controller runtime traces.
+type ControllerTracingConfig struct {
+	//   description: |
+	//     OTLP/HTTP endpoint of the OpenTelemetry collector.
+	//
+	//     Traces are sent to the `/v1/traces` path of the endpoint.
+	//     Supported protocols are "http" and "https".
+	//   examples:
+	//     - value: controllerTracingEndpointExample
+	TracingEndpoint *Endpoint `yaml:"endpoint"`
+}
+
+// KubernetesTalosAPIAccessConfig describes the Talos API access from Kubernetes pods.
+type KubernetesTalosAPIAccessConfig struct {
+	//   description: |
+	//     Enable Talos API access from Kubernetes pods.
+	AccessEnabled bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The list of Talos API roles which can be granted for access from Kubernetes pods.
+	//
+	//     Empty list means that no roles can be granted, so access is blocked.
+	//   examples:
+	//     - value: '[]string{"os:reader"}'
+	AccessAllowedRoles []string `yaml:"allowedRoles,omitempty"`
+	//   description: |
+	//     The list of Kubernetes namespaces Talos API access is available from.
+	//   examples:
+	//     - value: '[]string{"kube-system"}'
+	AccessAllowedKubernetesNamespaces []string `yaml:"allowedKubernetesNamespaces,omitempty"`
+}
+
+// LocalAPIAccessConfig describes the local Talos API access.
+type LocalAPIAccessConfig struct {
+	//   description: |
+	//     List of extension services which are granted access to the local Talos API.
+	LocalAPIServices []*LocalAPIServiceConfig `yaml:"services,omitempty"`
+	//   description: |
+	//     Expose the Talos API over the virtio-vsock to the VM host.
+	LocalAPIVsock *LocalAPIVsockConfig `yaml:"vsock,omitempty"`
+}
+
+// LocalAPIServiceConfig describes the local Talos API access for the extension service.
+type LocalAPIServiceConfig struct {
+	//   description: |
+	//     Name of the extension service.
+	LocalAPIServiceName string `yaml:"name"`
+	//   description: |
+	//     List of roles granted to the extension service.
+	//   examples:
+	//     - value: '[]string{"os:reader"}'
+	LocalAPIServiceAllowedRoles []string `yaml:"allowedRoles"`
+}
+
+// LocalAPIVsockConfig describes the Talos API access over virtio-vsock.
+type LocalAPIVsockConfig struct {
+	//   description: |
+	//     Enable the virtio-vsock listener.
+	LocalAPIVsockEnabled bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The vsock port to listen on.
+	//     Default value is 50000.
+	LocalAPIVsockPort uint32 `yaml:"port,omitempty"`
+	//   description: |
+	//     List of roles granted to the VM host.
+	//   examples:
+	//     - value: '[]string{"os:reader"}'
+	LocalAPIVsockAllowedRoles []string `yaml:"allowedRoles,omitempty"`
+}
+
+// APIServerLoadBalancerConfig describes the node-local Kubernetes API server load balancer.
+type APIServerLoadBalancerConfig struct {
+	//   description: |
+	//     Enable the node-local load balancer.
+	LoadBalancerEnabled bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The port the load balancer listens on.
+	//     Default value is 7445.
+	LoadBalancerPort int `yaml:"port,omitempty"`
 }
 
 // VolumeMountConfig struct describes extra volume mount for the static pods.
@@ -2257,7 +2731,9 @@ type RegistryServiceConfig struct {
 // UdevConfig describes how the udev system should be configured.
 type UdevConfig struct {
 	//   description: |
-	//     List of udev rules to apply to the udev system
+	//     List of udev rules to apply to the udev system.
+	//
+	//     Rules are applied without a reboot: on change, udev rules are reloaded and matching devices are re-triggered.
 	UdevRules []string `yaml:"rules,omitempty"`
 }
 
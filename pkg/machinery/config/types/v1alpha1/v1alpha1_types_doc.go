@@ -23,12 +23,17 @@ var (
 	KubeletNodeIPConfigDoc            encoder.Doc
 	NetworkConfigDoc                  encoder.Doc
 	InstallConfigDoc                  encoder.Doc
+	InstallRAIDConfigDoc              encoder.Doc
 	InstallDiskSelectorDoc            encoder.Doc
+	InstallExtraPartitionDoc          encoder.Doc
 	InstallExtensionConfigDoc         encoder.Doc
 	TimeConfigDoc                     encoder.Doc
 	RegistriesConfigDoc               encoder.Doc
 	PodCheckpointerDoc                encoder.Doc
 	CoreDNSDoc                        encoder.Doc
+	CoreDNSStubDomainDoc              encoder.Doc
+	CoreDNSCacheConfigDoc             encoder.Doc
+	CoreDNSNodeLocalCacheConfigDoc    encoder.Doc
 	EndpointDoc                       encoder.Doc
 	ControlPlaneConfigDoc             encoder.Doc
 	APIServerConfigDoc                encoder.Doc
@@ -42,6 +47,10 @@ var (
 	ExternalCloudProviderConfigDoc    encoder.Doc
 	AdminKubeconfigConfigDoc          encoder.Doc
 	MachineDiskDoc                    encoder.Doc
+	DiskRAIDConfigDoc                 encoder.Doc
+	VolumeGroupConfigDoc              encoder.Doc
+	PhysicalVolumeConfigDoc           encoder.Doc
+	LogicalVolumeConfigDoc            encoder.Doc
 	DiskPartitionDoc                  encoder.Doc
 	EncryptionConfigDoc               encoder.Doc
 	EncryptionKeyDoc                  encoder.Doc
@@ -65,6 +74,12 @@ var (
 	RegistryTLSConfigDoc              encoder.Doc
 	SystemDiskEncryptionConfigDoc     encoder.Doc
 	FeaturesConfigDoc                 encoder.Doc
+	ControllerTracingConfigDoc        encoder.Doc
+	KubernetesTalosAPIAccessConfigDoc encoder.Doc
+	LocalAPIAccessConfigDoc           encoder.Doc
+	LocalAPIServiceConfigDoc          encoder.Doc
+	LocalAPIVsockConfigDoc            encoder.Doc
+	APIServerLoadBalancerConfigDoc    encoder.Doc
 	VolumeMountConfigDoc              encoder.Doc
 	ClusterInlineManifestDoc          encoder.Doc
 	NetworkKubeSpanDoc                encoder.Doc
@@ -139,7 +154,7 @@ func init() {
 			FieldName: "machine",
 		},
 	}
-	MachineConfigDoc.Fields = make([]encoder.Doc, 21)
+	MachineConfigDoc.Fields = make([]encoder.Doc, 23)
 	MachineConfigDoc.Fields[0].Name = "type"
 	MachineConfigDoc.Fields[0].Type = "string"
 	MachineConfigDoc.Fields[0].Note = ""
@@ -205,101 +220,122 @@ func init() {
 	MachineConfigDoc.Fields[8].Comments[encoder.LineComment] = "Used to partition, format and mount additional disks."
 
 	MachineConfigDoc.Fields[8].AddExample("MachineDisks list example.", machineDisksExample)
-	MachineConfigDoc.Fields[9].Name = "install"
-	MachineConfigDoc.Fields[9].Type = "InstallConfig"
-	MachineConfigDoc.Fields[9].Note = ""
-	MachineConfigDoc.Fields[9].Description = "Used to provide instructions for installations."
-	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "Used to provide instructions for installations."
-
-	MachineConfigDoc.Fields[9].AddExample("MachineInstall config usage example.", machineInstallExample)
-	MachineConfigDoc.Fields[10].Name = "files"
-	MachineConfigDoc.Fields[10].Type = "[]MachineFile"
-	MachineConfigDoc.Fields[10].Note = "Note: The specified `path` is relative to `/var`.\n"
-	MachineConfigDoc.Fields[10].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
-	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Allows the addition of user specified files."
 
-	MachineConfigDoc.Fields[10].AddExample("MachineFiles usage example.", machineFilesExample)
-	MachineConfigDoc.Fields[11].Name = "env"
-	MachineConfigDoc.Fields[11].Type = "Env"
-	MachineConfigDoc.Fields[11].Note = ""
-	MachineConfigDoc.Fields[11].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
-	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
+	MachineConfigDoc.Fields[8].AddExample("MachineDisks on top of software RAID array.", machineDisksRAIDExample)
+	MachineConfigDoc.Fields[9].Name = "volumeGroups"
+	MachineConfigDoc.Fields[9].Type = "[]VolumeGroupConfig"
+	MachineConfigDoc.Fields[9].Note = ""
+	MachineConfigDoc.Fields[9].Description = "Used to create LVM volume groups and logical volumes, and to format and mount the logical volumes.\nPhysical volumes, volume groups and logical volumes are created only if they don't exist.\nPhysical volumes picked by the disk selector should be empty (no partition table).\nSince the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`."
+	MachineConfigDoc.Fields[9].Comments[encoder.LineComment] = "Used to create LVM volume groups and logical volumes, and to format and mount the logical volumes."
+
+	MachineConfigDoc.Fields[9].AddExample("", machineVolumeGroupsExample)
+	MachineConfigDoc.Fields[10].Name = "install"
+	MachineConfigDoc.Fields[10].Type = "InstallConfig"
+	MachineConfigDoc.Fields[10].Note = ""
+	MachineConfigDoc.Fields[10].Description = "Used to provide instructions for installations."
+	MachineConfigDoc.Fields[10].Comments[encoder.LineComment] = "Used to provide instructions for installations."
+
+	MachineConfigDoc.Fields[10].AddExample("MachineInstall config usage example.", machineInstallExample)
+	MachineConfigDoc.Fields[11].Name = "files"
+	MachineConfigDoc.Fields[11].Type = "[]MachineFile"
+	MachineConfigDoc.Fields[11].Note = "Note: The specified `path` is relative to `/var`.\n"
+	MachineConfigDoc.Fields[11].Description = "Allows the addition of user specified files.\nThe value of `op` can be `create`, `overwrite`, or `append`.\nIn the case of `create`, `path` must not exist.\nIn the case of `overwrite`, and `append`, `path` must be a valid file.\nIf an `op` value of `append` is used, the existing file will be appended.\nNote that the file contents are not required to be base64 encoded."
+	MachineConfigDoc.Fields[11].Comments[encoder.LineComment] = "Allows the addition of user specified files."
+
+	MachineConfigDoc.Fields[11].AddExample("MachineFiles usage example.", machineFilesExample)
+	MachineConfigDoc.Fields[12].Name = "env"
+	MachineConfigDoc.Fields[12].Type = "Env"
+	MachineConfigDoc.Fields[12].Note = ""
+	MachineConfigDoc.Fields[12].Description = "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service."
+	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "The `env` field allows for the addition of environment variables."
 
-	MachineConfigDoc.Fields[11].AddExample("Environment variables definition examples.", machineEnvExamples[0])
+	MachineConfigDoc.Fields[12].AddExample("Environment variables definition examples.", machineEnvExamples[0])
 
-	MachineConfigDoc.Fields[11].AddExample("", machineEnvExamples[1])
+	MachineConfigDoc.Fields[12].AddExample("", machineEnvExamples[1])
 
-	MachineConfigDoc.Fields[11].AddExample("", machineEnvExamples[2])
-	MachineConfigDoc.Fields[11].Values = []string{
+	MachineConfigDoc.Fields[12].AddExample("", machineEnvExamples[2])
+	MachineConfigDoc.Fields[12].Values = []string{
 		"`GRPC_GO_LOG_VERBOSITY_LEVEL`",
 		"`GRPC_GO_LOG_SEVERITY_LEVEL`",
 		"`http_proxy`",
 		"`https_proxy`",
 		"`no_proxy`",
 	}
-	MachineConfigDoc.Fields[12].Name = "time"
-	MachineConfigDoc.Fields[12].Type = "TimeConfig"
-	MachineConfigDoc.Fields[12].Note = ""
-	MachineConfigDoc.Fields[12].Description = "Used to configure the machine's time settings."
-	MachineConfigDoc.Fields[12].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
-
-	MachineConfigDoc.Fields[12].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
-	MachineConfigDoc.Fields[13].Name = "sysctls"
-	MachineConfigDoc.Fields[13].Type = "map[string]string"
+	MachineConfigDoc.Fields[13].Name = "time"
+	MachineConfigDoc.Fields[13].Type = "TimeConfig"
 	MachineConfigDoc.Fields[13].Note = ""
-	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's sysctls."
-	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[13].Description = "Used to configure the machine's time settings."
+	MachineConfigDoc.Fields[13].Comments[encoder.LineComment] = "Used to configure the machine's time settings."
 
-	MachineConfigDoc.Fields[13].AddExample("MachineSysctls usage example.", machineSysctlsExample)
-	MachineConfigDoc.Fields[14].Name = "sysfs"
+	MachineConfigDoc.Fields[13].AddExample("Example configuration for cloudflare ntp server.", machineTimeExample)
+	MachineConfigDoc.Fields[14].Name = "sysctls"
 	MachineConfigDoc.Fields[14].Type = "map[string]string"
 	MachineConfigDoc.Fields[14].Note = ""
-	MachineConfigDoc.Fields[14].Description = "Used to configure the machine's sysfs."
-	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure the machine's sysfs."
+	MachineConfigDoc.Fields[14].Description = "Used to configure the machine's sysctls."
+	MachineConfigDoc.Fields[14].Comments[encoder.LineComment] = "Used to configure the machine's sysctls."
 
-	MachineConfigDoc.Fields[14].AddExample("MachineSysfs usage example.", machineSysfsExample)
-	MachineConfigDoc.Fields[15].Name = "registries"
-	MachineConfigDoc.Fields[15].Type = "RegistriesConfig"
+	MachineConfigDoc.Fields[14].AddExample("MachineSysctls usage example.", machineSysctlsExample)
+	MachineConfigDoc.Fields[15].Name = "sysfs"
+	MachineConfigDoc.Fields[15].Type = "map[string]string"
 	MachineConfigDoc.Fields[15].Note = ""
-	MachineConfigDoc.Fields[15].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
-	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
+	MachineConfigDoc.Fields[15].Description = "Used to configure the machine's sysfs."
+	MachineConfigDoc.Fields[15].Comments[encoder.LineComment] = "Used to configure the machine's sysfs."
 
-	MachineConfigDoc.Fields[15].AddExample("", machineConfigRegistriesExample)
-	MachineConfigDoc.Fields[16].Name = "systemDiskEncryption"
-	MachineConfigDoc.Fields[16].Type = "SystemDiskEncryptionConfig"
+	MachineConfigDoc.Fields[15].AddExample("MachineSysfs usage example.", machineSysfsExample)
+	MachineConfigDoc.Fields[16].Name = "sysctlProfiles"
+	MachineConfigDoc.Fields[16].Type = "[]string"
 	MachineConfigDoc.Fields[16].Note = ""
-	MachineConfigDoc.Fields[16].Description = "Machine system disk encryption configuration.\nDefines each system partition encryption parameters."
-	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Machine system disk encryption configuration."
+	MachineConfigDoc.Fields[16].Description = "Sysctl tuning profiles to apply.\n\nProfiles expand into the set of sysctls, explicit `sysctls` take precedence over the profile values.\nSysctls not supported by the running kernel are skipped."
+	MachineConfigDoc.Fields[16].Comments[encoder.LineComment] = "Sysctl tuning profiles to apply."
 
-	MachineConfigDoc.Fields[16].AddExample("", machineSystemDiskEncryptionExample)
-	MachineConfigDoc.Fields[17].Name = "features"
-	MachineConfigDoc.Fields[17].Type = "FeaturesConfig"
+	MachineConfigDoc.Fields[16].AddExample("", machineSysctlProfilesExample)
+	MachineConfigDoc.Fields[16].Values = []string{
+		"network-throughput",
+		"low-latency",
+		"etcd",
+	}
+	MachineConfigDoc.Fields[17].Name = "registries"
+	MachineConfigDoc.Fields[17].Type = "RegistriesConfig"
 	MachineConfigDoc.Fields[17].Note = ""
-	MachineConfigDoc.Fields[17].Description = "Features describe individual Talos features that can be switched on or off."
-	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
+	MachineConfigDoc.Fields[17].Description = "Used to configure the machine's container image registry mirrors.\n\nAutomatically generates matching CRI configuration for registry mirrors.\n\nThe `mirrors` section allows to redirect requests for images to non-default registry,\nwhich might be local registry or caching mirror.\n\nThe `config` section provides a way to authenticate to the registry with TLS client\nidentity, provide registry CA, or authentication information.\nAuthentication information has same meaning with the corresponding field in `.docker/config.json`.\n\nSee also matching configuration for [CRI containerd plugin](https://github.com/containerd/cri/blob/master/docs/registry.md)."
+	MachineConfigDoc.Fields[17].Comments[encoder.LineComment] = "Used to configure the machine's container image registry mirrors."
 
-	MachineConfigDoc.Fields[17].AddExample("", machineFeaturesExample)
-	MachineConfigDoc.Fields[18].Name = "udev"
-	MachineConfigDoc.Fields[18].Type = "UdevConfig"
+	MachineConfigDoc.Fields[17].AddExample("", machineConfigRegistriesExample)
+	MachineConfigDoc.Fields[18].Name = "systemDiskEncryption"
+	MachineConfigDoc.Fields[18].Type = "SystemDiskEncryptionConfig"
 	MachineConfigDoc.Fields[18].Note = ""
-	MachineConfigDoc.Fields[18].Description = "Configures the udev system."
-	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Configures the udev system."
+	MachineConfigDoc.Fields[18].Description = "Machine system disk encryption configuration.\nDefines each system partition encryption parameters."
+	MachineConfigDoc.Fields[18].Comments[encoder.LineComment] = "Machine system disk encryption configuration."
 
-	MachineConfigDoc.Fields[18].AddExample("", machineUdevExample)
-	MachineConfigDoc.Fields[19].Name = "logging"
-	MachineConfigDoc.Fields[19].Type = "LoggingConfig"
+	MachineConfigDoc.Fields[18].AddExample("", machineSystemDiskEncryptionExample)
+	MachineConfigDoc.Fields[19].Name = "features"
+	MachineConfigDoc.Fields[19].Type = "FeaturesConfig"
 	MachineConfigDoc.Fields[19].Note = ""
-	MachineConfigDoc.Fields[19].Description = "Configures the logging system."
-	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "Configures the logging system."
+	MachineConfigDoc.Fields[19].Description = "Features describe individual Talos features that can be switched on or off."
+	MachineConfigDoc.Fields[19].Comments[encoder.LineComment] = "Features describe individual Talos features that can be switched on or off."
 
-	MachineConfigDoc.Fields[19].AddExample("", machineLoggingExample)
-	MachineConfigDoc.Fields[20].Name = "kernel"
-	MachineConfigDoc.Fields[20].Type = "KernelConfig"
+	MachineConfigDoc.Fields[19].AddExample("", machineFeaturesExample)
+	MachineConfigDoc.Fields[20].Name = "udev"
+	MachineConfigDoc.Fields[20].Type = "UdevConfig"
 	MachineConfigDoc.Fields[20].Note = ""
-	MachineConfigDoc.Fields[20].Description = "Configures the kernel."
-	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "Configures the kernel."
+	MachineConfigDoc.Fields[20].Description = "Configures the udev system."
+	MachineConfigDoc.Fields[20].Comments[encoder.LineComment] = "Configures the udev system."
+
+	MachineConfigDoc.Fields[20].AddExample("", machineUdevExample)
+	MachineConfigDoc.Fields[21].Name = "logging"
+	MachineConfigDoc.Fields[21].Type = "LoggingConfig"
+	MachineConfigDoc.Fields[21].Note = ""
+	MachineConfigDoc.Fields[21].Description = "Configures the logging system."
+	MachineConfigDoc.Fields[21].Comments[encoder.LineComment] = "Configures the logging system."
 
-	MachineConfigDoc.Fields[20].AddExample("", machineKernelExample)
+	MachineConfigDoc.Fields[21].AddExample("", machineLoggingExample)
+	MachineConfigDoc.Fields[22].Name = "kernel"
+	MachineConfigDoc.Fields[22].Type = "KernelConfig"
+	MachineConfigDoc.Fields[22].Note = ""
+	MachineConfigDoc.Fields[22].Description = "Configures the kernel."
+	MachineConfigDoc.Fields[22].Comments[encoder.LineComment] = "Configures the kernel."
+
+	MachineConfigDoc.Fields[22].AddExample("", machineKernelExample)
 
 	ClusterConfigDoc.Type = "ClusterConfig"
 	ClusterConfigDoc.Comments[encoder.LineComment] = "ClusterConfig represents the cluster-wide config values."
@@ -644,46 +680,53 @@ func init() {
 			FieldName: "network",
 		},
 	}
-	NetworkConfigDoc.Fields = make([]encoder.Doc, 6)
+	NetworkConfigDoc.Fields = make([]encoder.Doc, 7)
 	NetworkConfigDoc.Fields[0].Name = "hostname"
 	NetworkConfigDoc.Fields[0].Type = "string"
 	NetworkConfigDoc.Fields[0].Note = ""
 	NetworkConfigDoc.Fields[0].Description = "Used to statically set the hostname for the machine."
 	NetworkConfigDoc.Fields[0].Comments[encoder.LineComment] = "Used to statically set the hostname for the machine."
-	NetworkConfigDoc.Fields[1].Name = "interfaces"
-	NetworkConfigDoc.Fields[1].Type = "[]Device"
+	NetworkConfigDoc.Fields[1].Name = "hostnameTemplate"
+	NetworkConfigDoc.Fields[1].Type = "string"
 	NetworkConfigDoc.Fields[1].Note = ""
-	NetworkConfigDoc.Fields[1].Description = "`interfaces` is used to define the network interface configuration.\nBy default all network interfaces will attempt a DHCP discovery.\nThis can be further tuned through this configuration parameter."
-	NetworkConfigDoc.Fields[1].Comments[encoder.LineComment] = "`interfaces` is used to define the network interface configuration."
+	NetworkConfigDoc.Fields[1].Description = "Template to generate the hostname for the machine.\n\nThe template is evaluated with Go `text/template`, the generated hostname doesn't change when the node addresses change.\nAvailable fields are `.SMBIOS.UUID`, `.SMBIOS.SerialNumber`, `.SMBIOS.Manufacturer`, `.SMBIOS.ProductName`, `.SMBIOS.SKUNumber`,\nthe hardware address of the link without separators `.Hardware.MAC \"eth0\"`,\nand the platform metadata `.Platform.Name`, `.Platform.InstanceID` (empty if not reported by the platform).\nFunction `hash` returns a stable short hash of the value, and `lower` converts the value to lower case.\nHostname is not generated if the template refers to a missing link or hashes an empty value.\nCan't be used together with `hostname`."
+	NetworkConfigDoc.Fields[1].Comments[encoder.LineComment] = "Template to generate the hostname for the machine."
 
-	NetworkConfigDoc.Fields[1].AddExample("", machineNetworkConfigExample.NetworkInterfaces)
-	NetworkConfigDoc.Fields[2].Name = "nameservers"
-	NetworkConfigDoc.Fields[2].Type = "[]string"
+	NetworkConfigDoc.Fields[1].AddExample("", "talos-{{ hash .SMBIOS.UUID }}")
+	NetworkConfigDoc.Fields[2].Name = "interfaces"
+	NetworkConfigDoc.Fields[2].Type = "[]Device"
 	NetworkConfigDoc.Fields[2].Note = ""
-	NetworkConfigDoc.Fields[2].Description = "Used to statically set the nameservers for the machine.\nDefaults to `1.1.1.1` and `8.8.8.8`"
-	NetworkConfigDoc.Fields[2].Comments[encoder.LineComment] = "Used to statically set the nameservers for the machine."
+	NetworkConfigDoc.Fields[2].Description = "`interfaces` is used to define the network interface configuration.\nBy default all network interfaces will attempt a DHCP discovery.\nThis can be further tuned through this configuration parameter."
+	NetworkConfigDoc.Fields[2].Comments[encoder.LineComment] = "`interfaces` is used to define the network interface configuration."
 
-	NetworkConfigDoc.Fields[2].AddExample("", []string{"8.8.8.8", "1.1.1.1"})
-	NetworkConfigDoc.Fields[3].Name = "extraHostEntries"
-	NetworkConfigDoc.Fields[3].Type = "[]ExtraHost"
+	NetworkConfigDoc.Fields[2].AddExample("", machineNetworkConfigExample.NetworkInterfaces)
+	NetworkConfigDoc.Fields[3].Name = "nameservers"
+	NetworkConfigDoc.Fields[3].Type = "[]string"
 	NetworkConfigDoc.Fields[3].Note = ""
-	NetworkConfigDoc.Fields[3].Description = "Allows for extra entries to be added to the `/etc/hosts` file"
-	NetworkConfigDoc.Fields[3].Comments[encoder.LineComment] = "Allows for extra entries to be added to the `/etc/hosts` file"
+	NetworkConfigDoc.Fields[3].Description = "Used to statically set the nameservers for the machine.\nDefaults to `1.1.1.1` and `8.8.8.8`"
+	NetworkConfigDoc.Fields[3].Comments[encoder.LineComment] = "Used to statically set the nameservers for the machine."
 
-	NetworkConfigDoc.Fields[3].AddExample("", networkConfigExtraHostsExample)
-	NetworkConfigDoc.Fields[4].Name = "kubespan"
-	NetworkConfigDoc.Fields[4].Type = "NetworkKubeSpan"
+	NetworkConfigDoc.Fields[3].AddExample("", []string{"8.8.8.8", "1.1.1.1"})
+	NetworkConfigDoc.Fields[4].Name = "extraHostEntries"
+	NetworkConfigDoc.Fields[4].Type = "[]ExtraHost"
 	NetworkConfigDoc.Fields[4].Note = ""
-	NetworkConfigDoc.Fields[4].Description = "Configures KubeSpan feature."
-	NetworkConfigDoc.Fields[4].Comments[encoder.LineComment] = "Configures KubeSpan feature."
+	NetworkConfigDoc.Fields[4].Description = "Allows for extra entries to be added to the `/etc/hosts` file"
+	NetworkConfigDoc.Fields[4].Comments[encoder.LineComment] = "Allows for extra entries to be added to the `/etc/hosts` file"
 
-	NetworkConfigDoc.Fields[4].AddExample("", networkKubeSpanExample)
-	NetworkConfigDoc.Fields[5].Name = "disableSearchDomain"
-	NetworkConfigDoc.Fields[5].Type = "bool"
+	NetworkConfigDoc.Fields[4].AddExample("", networkConfigExtraHostsExample)
+	NetworkConfigDoc.Fields[5].Name = "kubespan"
+	NetworkConfigDoc.Fields[5].Type = "NetworkKubeSpan"
 	NetworkConfigDoc.Fields[5].Note = ""
-	NetworkConfigDoc.Fields[5].Description = "Disable generating a default search domain in /etc/resolv.conf\nbased on the machine hostname.\nDefaults to `false`."
-	NetworkConfigDoc.Fields[5].Comments[encoder.LineComment] = "Disable generating a default search domain in /etc/resolv.conf"
-	NetworkConfigDoc.Fields[5].Values = []string{
+	NetworkConfigDoc.Fields[5].Description = "Configures KubeSpan feature."
+	NetworkConfigDoc.Fields[5].Comments[encoder.LineComment] = "Configures KubeSpan feature."
+
+	NetworkConfigDoc.Fields[5].AddExample("", networkKubeSpanExample)
+	NetworkConfigDoc.Fields[6].Name = "disableSearchDomain"
+	NetworkConfigDoc.Fields[6].Type = "bool"
+	NetworkConfigDoc.Fields[6].Note = ""
+	NetworkConfigDoc.Fields[6].Description = "Disable generating a default search domain in /etc/resolv.conf\nbased on the machine hostname.\nDefaults to `false`."
+	NetworkConfigDoc.Fields[6].Comments[encoder.LineComment] = "Disable generating a default search domain in /etc/resolv.conf"
+	NetworkConfigDoc.Fields[6].Values = []string{
 		"true",
 		"yes",
 		"false",
@@ -701,7 +744,7 @@ func init() {
 			FieldName: "install",
 		},
 	}
-	InstallConfigDoc.Fields = make([]encoder.Doc, 8)
+	InstallConfigDoc.Fields = make([]encoder.Doc, 11)
 	InstallConfigDoc.Fields[0].Name = "disk"
 	InstallConfigDoc.Fields[0].Type = "string"
 	InstallConfigDoc.Fields[0].Note = ""
@@ -766,6 +809,45 @@ func init() {
 	InstallConfigDoc.Fields[7].Note = ""
 	InstallConfigDoc.Fields[7].Description = "Indicates if MBR partition should be marked as bootable (active).\nShould be enabled only for the systems with legacy BIOS that doesn't support GPT partitioning scheme."
 	InstallConfigDoc.Fields[7].Comments[encoder.LineComment] = "Indicates if MBR partition should be marked as bootable (active)."
+	InstallConfigDoc.Fields[8].Name = "ephemeralMaxSize"
+	InstallConfigDoc.Fields[8].Type = "DiskSize"
+	InstallConfigDoc.Fields[8].Note = ""
+	InstallConfigDoc.Fields[8].Description = "Limits the size of the EPHEMERAL partition.\nBy default EPHEMERAL partition occupies all the remaining space on the installation disk.\nIf set, EPHEMERAL partition is created with the specified size, and the remaining space\nmight be used for `extraPartitions`."
+	InstallConfigDoc.Fields[8].Comments[encoder.LineComment] = "Limits the size of the EPHEMERAL partition."
+
+	InstallConfigDoc.Fields[8].AddExample("Human readable representation.", DiskSize(100000000000))
+	InstallConfigDoc.Fields[9].Name = "extraPartitions"
+	InstallConfigDoc.Fields[9].Type = "[]InstallExtraPartition"
+	InstallConfigDoc.Fields[9].Note = ""
+	InstallConfigDoc.Fields[9].Description = "Additional partitions to create on the installation disk after the EPHEMERAL partition.\nPartitions are created without a filesystem, and they are preserved across upgrades.\nExtra partitions require `ephemeralMaxSize` to be set."
+	InstallConfigDoc.Fields[9].Comments[encoder.LineComment] = "Additional partitions to create on the installation disk after the EPHEMERAL partition."
+
+	InstallConfigDoc.Fields[9].AddExample("", machineInstallExtraPartitionsExample)
+	InstallConfigDoc.Fields[10].Name = "raid"
+	InstallConfigDoc.Fields[10].Type = "InstallRAIDConfig"
+	InstallConfigDoc.Fields[10].Note = ""
+	InstallConfigDoc.Fields[10].Description = "Install Talos on a software RAID 1 (mirror) array built from the specified disks.\nEFI and BIOS boot partitions are created on each disk, while BOOT, META, STATE and EPHEMERAL partitions\nare created on the array.\nIf set, `disk` and `diskSelector` are ignored.\nSoftware RAID requires `mdadm` binary to be available (e.g. via system extension)."
+	InstallConfigDoc.Fields[10].Comments[encoder.LineComment] = "Install Talos on a software RAID 1 (mirror) array built from the specified disks."
+
+	InstallConfigDoc.Fields[10].AddExample("", machineInstallRAIDExample)
+
+	InstallRAIDConfigDoc.Type = "InstallRAIDConfig"
+	InstallRAIDConfigDoc.Comments[encoder.LineComment] = "InstallRAIDConfig describes the software RAID array for the system disk."
+	InstallRAIDConfigDoc.Description = "InstallRAIDConfig describes the software RAID array for the system disk."
+
+	InstallRAIDConfigDoc.AddExample("", machineInstallRAIDExample)
+	InstallRAIDConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "raid",
+		},
+	}
+	InstallRAIDConfigDoc.Fields = make([]encoder.Doc, 1)
+	InstallRAIDConfigDoc.Fields[0].Name = "devices"
+	InstallRAIDConfigDoc.Fields[0].Type = "[]string"
+	InstallRAIDConfigDoc.Fields[0].Note = ""
+	InstallRAIDConfigDoc.Fields[0].Description = "Disks used as the array members."
+	InstallRAIDConfigDoc.Fields[0].Comments[encoder.LineComment] = "Disks used as the array members."
 
 	InstallDiskSelectorDoc.Type = "InstallDiskSelector"
 	InstallDiskSelectorDoc.Comments[encoder.LineComment] = "InstallDiskSelector represents a disk query parameters for the install disk lookup."
@@ -777,6 +859,10 @@ func init() {
 			TypeName:  "InstallConfig",
 			FieldName: "diskSelector",
 		},
+		{
+			TypeName:  "PhysicalVolumeConfig",
+			FieldName: "diskSelector",
+		},
 	}
 	InstallDiskSelectorDoc.Fields = make([]encoder.Doc, 9)
 	InstallDiskSelectorDoc.Fields[0].Name = "size"
@@ -841,6 +927,35 @@ func init() {
 
 	InstallDiskSelectorDoc.Fields[8].AddExample("", "/pci0000:00/*")
 
+	InstallExtraPartitionDoc.Type = "InstallExtraPartition"
+	InstallExtraPartitionDoc.Comments[encoder.LineComment] = "InstallExtraPartition represents an additional partition on the installation disk."
+	InstallExtraPartitionDoc.Description = "InstallExtraPartition represents an additional partition on the installation disk."
+
+	InstallExtraPartitionDoc.AddExample("", machineInstallExtraPartitionsExample)
+	InstallExtraPartitionDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "InstallConfig",
+			FieldName: "extraPartitions",
+		},
+	}
+	InstallExtraPartitionDoc.Fields = make([]encoder.Doc, 2)
+	InstallExtraPartitionDoc.Fields[0].Name = "label"
+	InstallExtraPartitionDoc.Fields[0].Type = "string"
+	InstallExtraPartitionDoc.Fields[0].Note = ""
+	InstallExtraPartitionDoc.Fields[0].Description = "The partition label (GPT partition name)."
+	InstallExtraPartitionDoc.Fields[0].Comments[encoder.LineComment] = "The partition label (GPT partition name)."
+
+	InstallExtraPartitionDoc.Fields[0].AddExample("", "LOCAL-PV")
+	InstallExtraPartitionDoc.Fields[1].Name = "size"
+	InstallExtraPartitionDoc.Fields[1].Type = "DiskSize"
+	InstallExtraPartitionDoc.Fields[1].Note = ""
+	InstallExtraPartitionDoc.Fields[1].Description = "The size of the partition: either bytes or human readable representation. If `size:` is omitted, the partition occupies all the remaining space on the disk (allowed only for the last partition)."
+	InstallExtraPartitionDoc.Fields[1].Comments[encoder.LineComment] = "The size of the partition: either bytes or human readable representation. If `size:` is omitted, the partition occupies all the remaining space on the disk (allowed only for the last partition)."
+
+	InstallExtraPartitionDoc.Fields[1].AddExample("Human readable representation.", DiskSize(100000000))
+
+	InstallExtraPartitionDoc.Fields[1].AddExample("Precise value in bytes.", 1024*1024*1024)
+
 	InstallExtensionConfigDoc.Type = "InstallExtensionConfig"
 	InstallExtensionConfigDoc.Comments[encoder.LineComment] = "InstallExtensionConfig represents a configuration for a system extension."
 	InstallExtensionConfigDoc.Description = "InstallExtensionConfig represents a configuration for a system extension."
@@ -935,7 +1050,7 @@ func init() {
 			FieldName: "coreDNS",
 		},
 	}
-	CoreDNSDoc.Fields = make([]encoder.Doc, 2)
+	CoreDNSDoc.Fields = make([]encoder.Doc, 8)
 	CoreDNSDoc.Fields[0].Name = "disabled"
 	CoreDNSDoc.Fields[0].Type = "bool"
 	CoreDNSDoc.Fields[0].Note = ""
@@ -946,6 +1061,127 @@ func init() {
 	CoreDNSDoc.Fields[1].Note = ""
 	CoreDNSDoc.Fields[1].Description = "The `image` field is an override to the default coredns image."
 	CoreDNSDoc.Fields[1].Comments[encoder.LineComment] = "The `image` field is an override to the default coredns image."
+	CoreDNSDoc.Fields[2].Name = "upstreams"
+	CoreDNSDoc.Fields[2].Type = "[]string"
+	CoreDNSDoc.Fields[2].Note = ""
+	CoreDNSDoc.Fields[2].Description = "Upstream DNS servers for the queries outside of the cluster domain and stub domains.\nBy default, queries are forwarded to the resolvers of the node (`/etc/resolv.conf`).\nServers can be specified as `IP`, `IP:port`, or `tls://IP` for DNS-over-TLS."
+	CoreDNSDoc.Fields[2].Comments[encoder.LineComment] = "Upstream DNS servers for the queries outside of the cluster domain and stub domains."
+
+	CoreDNSDoc.Fields[2].AddExample("", []string{"1.1.1.1", "8.8.8.8:53"})
+	CoreDNSDoc.Fields[3].Name = "stubDomains"
+	CoreDNSDoc.Fields[3].Type = "[]CoreDNSStubDomain"
+	CoreDNSDoc.Fields[3].Note = ""
+	CoreDNSDoc.Fields[3].Description = "Stub domains forward the queries for a domain to a specific set of DNS servers."
+	CoreDNSDoc.Fields[3].Comments[encoder.LineComment] = "Stub domains forward the queries for a domain to a specific set of DNS servers."
+
+	CoreDNSDoc.Fields[3].AddExample("", clusterCoreDNSStubDomainsExample)
+	CoreDNSDoc.Fields[4].Name = "cache"
+	CoreDNSDoc.Fields[4].Type = "CoreDNSCacheConfig"
+	CoreDNSDoc.Fields[4].Note = ""
+	CoreDNSDoc.Fields[4].Description = "CoreDNS cache settings."
+	CoreDNSDoc.Fields[4].Comments[encoder.LineComment] = "CoreDNS cache settings."
+
+	CoreDNSDoc.Fields[4].AddExample("", clusterCoreDNSCacheExample)
+	CoreDNSDoc.Fields[5].Name = "hosts"
+	CoreDNSDoc.Fields[5].Type = "[]ExtraHost"
+	CoreDNSDoc.Fields[5].Note = ""
+	CoreDNSDoc.Fields[5].Description = "Static host entries served by CoreDNS for all cluster workloads."
+	CoreDNSDoc.Fields[5].Comments[encoder.LineComment] = "Static host entries served by CoreDNS for all cluster workloads."
+
+	CoreDNSDoc.Fields[5].AddExample("", networkConfigExtraHostsExample)
+	CoreDNSDoc.Fields[6].Name = "extraServerBlocks"
+	CoreDNSDoc.Fields[6].Type = "[]string"
+	CoreDNSDoc.Fields[6].Note = ""
+	CoreDNSDoc.Fields[6].Description = "Extra Corefile server blocks appended to the CoreDNS configuration."
+	CoreDNSDoc.Fields[6].Comments[encoder.LineComment] = "Extra Corefile server blocks appended to the CoreDNS configuration."
+
+	CoreDNSDoc.Fields[6].AddExample("", clusterCoreDNSExtraServerBlocksExample)
+	CoreDNSDoc.Fields[7].Name = "nodeLocalCache"
+	CoreDNSDoc.Fields[7].Type = "CoreDNSNodeLocalCacheConfig"
+	CoreDNSDoc.Fields[7].Note = ""
+	CoreDNSDoc.Fields[7].Description = "NodeLocal DNSCache runs a DNS caching agent on every node.\nWhen enabled, kubelet `clusterDNS` defaults to the link-local IP of the caching agent."
+	CoreDNSDoc.Fields[7].Comments[encoder.LineComment] = "NodeLocal DNSCache runs a DNS caching agent on every node."
+
+	CoreDNSDoc.Fields[7].AddExample("", clusterCoreDNSNodeLocalCacheExample)
+
+	CoreDNSStubDomainDoc.Type = "CoreDNSStubDomain"
+	CoreDNSStubDomainDoc.Comments[encoder.LineComment] = "CoreDNSStubDomain represents a CoreDNS stub domain."
+	CoreDNSStubDomainDoc.Description = "CoreDNSStubDomain represents a CoreDNS stub domain."
+
+	CoreDNSStubDomainDoc.AddExample("", clusterCoreDNSStubDomainsExample)
+	CoreDNSStubDomainDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "CoreDNS",
+			FieldName: "stubDomains",
+		},
+	}
+	CoreDNSStubDomainDoc.Fields = make([]encoder.Doc, 2)
+	CoreDNSStubDomainDoc.Fields[0].Name = "domain"
+	CoreDNSStubDomainDoc.Fields[0].Type = "string"
+	CoreDNSStubDomainDoc.Fields[0].Note = ""
+	CoreDNSStubDomainDoc.Fields[0].Description = "The DNS domain name."
+	CoreDNSStubDomainDoc.Fields[0].Comments[encoder.LineComment] = "The DNS domain name."
+	CoreDNSStubDomainDoc.Fields[1].Name = "servers"
+	CoreDNSStubDomainDoc.Fields[1].Type = "[]string"
+	CoreDNSStubDomainDoc.Fields[1].Note = ""
+	CoreDNSStubDomainDoc.Fields[1].Description = "DNS servers to forward the queries for the domain to."
+	CoreDNSStubDomainDoc.Fields[1].Comments[encoder.LineComment] = "DNS servers to forward the queries for the domain to."
+
+	CoreDNSCacheConfigDoc.Type = "CoreDNSCacheConfig"
+	CoreDNSCacheConfigDoc.Comments[encoder.LineComment] = "CoreDNSCacheConfig represents CoreDNS cache settings."
+	CoreDNSCacheConfigDoc.Description = "CoreDNSCacheConfig represents CoreDNS cache settings."
+
+	CoreDNSCacheConfigDoc.AddExample("", clusterCoreDNSCacheExample)
+	CoreDNSCacheConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "CoreDNS",
+			FieldName: "cache",
+		},
+	}
+	CoreDNSCacheConfigDoc.Fields = make([]encoder.Doc, 3)
+	CoreDNSCacheConfigDoc.Fields[0].Name = "ttl"
+	CoreDNSCacheConfigDoc.Fields[0].Type = "int"
+	CoreDNSCacheConfigDoc.Fields[0].Note = ""
+	CoreDNSCacheConfigDoc.Fields[0].Description = "Maximum TTL of the cached responses in seconds (default is 30)."
+	CoreDNSCacheConfigDoc.Fields[0].Comments[encoder.LineComment] = "Maximum TTL of the cached responses in seconds (default is 30)."
+	CoreDNSCacheConfigDoc.Fields[1].Name = "successCapacity"
+	CoreDNSCacheConfigDoc.Fields[1].Type = "int"
+	CoreDNSCacheConfigDoc.Fields[1].Note = ""
+	CoreDNSCacheConfigDoc.Fields[1].Description = "Maximum number of the cached successful responses (CoreDNS default is 9984)."
+	CoreDNSCacheConfigDoc.Fields[1].Comments[encoder.LineComment] = "Maximum number of the cached successful responses (CoreDNS default is 9984)."
+	CoreDNSCacheConfigDoc.Fields[2].Name = "denialCapacity"
+	CoreDNSCacheConfigDoc.Fields[2].Type = "int"
+	CoreDNSCacheConfigDoc.Fields[2].Note = ""
+	CoreDNSCacheConfigDoc.Fields[2].Description = "Maximum number of the cached denial responses (CoreDNS default is 9984)."
+	CoreDNSCacheConfigDoc.Fields[2].Comments[encoder.LineComment] = "Maximum number of the cached denial responses (CoreDNS default is 9984)."
+
+	CoreDNSNodeLocalCacheConfigDoc.Type = "CoreDNSNodeLocalCacheConfig"
+	CoreDNSNodeLocalCacheConfigDoc.Comments[encoder.LineComment] = "CoreDNSNodeLocalCacheConfig represents NodeLocal DNSCache settings."
+	CoreDNSNodeLocalCacheConfigDoc.Description = "CoreDNSNodeLocalCacheConfig represents NodeLocal DNSCache settings."
+
+	CoreDNSNodeLocalCacheConfigDoc.AddExample("", clusterCoreDNSNodeLocalCacheExample)
+	CoreDNSNodeLocalCacheConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "CoreDNS",
+			FieldName: "nodeLocalCache",
+		},
+	}
+	CoreDNSNodeLocalCacheConfigDoc.Fields = make([]encoder.Doc, 3)
+	CoreDNSNodeLocalCacheConfigDoc.Fields[0].Name = "enabled"
+	CoreDNSNodeLocalCacheConfigDoc.Fields[0].Type = "bool"
+	CoreDNSNodeLocalCacheConfigDoc.Fields[0].Note = ""
+	CoreDNSNodeLocalCacheConfigDoc.Fields[0].Description = "Enable NodeLocal DNSCache deployment on cluster bootstrap."
+	CoreDNSNodeLocalCacheConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable NodeLocal DNSCache deployment on cluster bootstrap."
+	CoreDNSNodeLocalCacheConfigDoc.Fields[1].Name = "ip"
+	CoreDNSNodeLocalCacheConfigDoc.Fields[1].Type = "string"
+	CoreDNSNodeLocalCacheConfigDoc.Fields[1].Note = ""
+	CoreDNSNodeLocalCacheConfigDoc.Fields[1].Description = "Link-local IP address the caching agent listens on (default is `169.254.20.10`)."
+	CoreDNSNodeLocalCacheConfigDoc.Fields[1].Comments[encoder.LineComment] = "Link-local IP address the caching agent listens on (default is `169.254.20.10`)."
+	CoreDNSNodeLocalCacheConfigDoc.Fields[2].Name = "image"
+	CoreDNSNodeLocalCacheConfigDoc.Fields[2].Type = "string"
+	CoreDNSNodeLocalCacheConfigDoc.Fields[2].Note = ""
+	CoreDNSNodeLocalCacheConfigDoc.Fields[2].Description = "The `image` field is an override to the default NodeLocal DNSCache image."
+	CoreDNSNodeLocalCacheConfigDoc.Fields[2].Comments[encoder.LineComment] = "The `image` field is an override to the default NodeLocal DNSCache image."
 
 	EndpointDoc.Type = "Endpoint"
 	EndpointDoc.Comments[encoder.LineComment] = "Endpoint represents the endpoint URL parsed out of the machine config."
@@ -955,6 +1191,8 @@ func init() {
 
 	EndpointDoc.AddExample("", clusterEndpointExample2)
 
+	EndpointDoc.AddExample("", controllerTracingEndpointExample)
+
 	EndpointDoc.AddExample("", loggingEndpointExample1)
 
 	EndpointDoc.AddExample("", loggingEndpointExample2)
@@ -963,6 +1201,10 @@ func init() {
 			TypeName:  "ControlPlaneConfig",
 			FieldName: "endpoint",
 		},
+		{
+			TypeName:  "ControllerTracingConfig",
+			FieldName: "endpoint",
+		},
 		{
 			TypeName:  "LoggingDestination",
 			FieldName: "endpoint",
@@ -1299,7 +1541,7 @@ func init() {
 			FieldName: "externalCloudProvider",
 		},
 	}
-	ExternalCloudProviderConfigDoc.Fields = make([]encoder.Doc, 2)
+	ExternalCloudProviderConfigDoc.Fields = make([]encoder.Doc, 5)
 	ExternalCloudProviderConfigDoc.Fields[0].Name = "enabled"
 	ExternalCloudProviderConfigDoc.Fields[0].Type = "bool"
 	ExternalCloudProviderConfigDoc.Fields[0].Note = ""
@@ -1321,6 +1563,30 @@ func init() {
 		"https://raw.githubusercontent.com/kubernetes/cloud-provider-aws/v1.20.0-alpha.0/manifests/rbac.yaml",
 		"https://raw.githubusercontent.com/kubernetes/cloud-provider-aws/v1.20.0-alpha.0/manifests/aws-cloud-controller-manager-daemonset.yaml",
 	})
+	ExternalCloudProviderConfigDoc.Fields[2].Name = "name"
+	ExternalCloudProviderConfigDoc.Fields[2].Type = "string"
+	ExternalCloudProviderConfigDoc.Fields[2].Note = ""
+	ExternalCloudProviderConfigDoc.Fields[2].Description = "Name of the built-in cloud controller manager to deploy.\nTalos renders the cloud controller manager manifests for the selected cloud provider\nwith the cluster settings, and `talosctl upgrade-k8s` keeps them up to date."
+	ExternalCloudProviderConfigDoc.Fields[2].Comments[encoder.LineComment] = "Name of the built-in cloud controller manager to deploy."
+
+	ExternalCloudProviderConfigDoc.Fields[2].AddExample("", "aws")
+	ExternalCloudProviderConfigDoc.Fields[2].Values = []string{
+		"aws",
+		"azure",
+		"gcp",
+		"hcloud",
+		"openstack",
+	}
+	ExternalCloudProviderConfigDoc.Fields[3].Name = "image"
+	ExternalCloudProviderConfigDoc.Fields[3].Type = "string"
+	ExternalCloudProviderConfigDoc.Fields[3].Note = ""
+	ExternalCloudProviderConfigDoc.Fields[3].Description = "The `image` field is an override to the default built-in cloud controller manager image.\nBy default, the image version follows the Kubernetes version for `aws`, `azure` and `openstack`."
+	ExternalCloudProviderConfigDoc.Fields[3].Comments[encoder.LineComment] = "The `image` field is an override to the default built-in cloud controller manager image."
+	ExternalCloudProviderConfigDoc.Fields[4].Name = "cloudConfig"
+	ExternalCloudProviderConfigDoc.Fields[4].Type = "string"
+	ExternalCloudProviderConfigDoc.Fields[4].Note = ""
+	ExternalCloudProviderConfigDoc.Fields[4].Description = "Cloud configuration file contents passed to the built-in cloud controller manager via `--cloud-config`.\nRequired for `azure` and `openstack`, optional for `aws` and `gcp`.\nNot supported for `hcloud`: the Hetzner Cloud API token is read from the `token` key\nof the `hcloud` secret in the `kube-system` namespace."
+	ExternalCloudProviderConfigDoc.Fields[4].Comments[encoder.LineComment] = "Cloud configuration file contents passed to the built-in cloud controller manager via `--cloud-config`."
 
 	AdminKubeconfigConfigDoc.Type = "AdminKubeconfigConfig"
 	AdminKubeconfigConfigDoc.Comments[encoder.LineComment] = "AdminKubeconfigConfig contains admin kubeconfig settings."
@@ -1345,13 +1611,15 @@ func init() {
 	MachineDiskDoc.Description = "MachineDisk represents the options available for partitioning, formatting, and\nmounting extra disks.\n"
 
 	MachineDiskDoc.AddExample("MachineDisks list example.", machineDisksExample)
+
+	MachineDiskDoc.AddExample("MachineDisks on top of software RAID array.", machineDisksRAIDExample)
 	MachineDiskDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "MachineConfig",
 			FieldName: "disks",
 		},
 	}
-	MachineDiskDoc.Fields = make([]encoder.Doc, 2)
+	MachineDiskDoc.Fields = make([]encoder.Doc, 3)
 	MachineDiskDoc.Fields[0].Name = "device"
 	MachineDiskDoc.Fields[0].Type = "string"
 	MachineDiskDoc.Fields[0].Note = ""
@@ -1362,6 +1630,136 @@ func init() {
 	MachineDiskDoc.Fields[1].Note = ""
 	MachineDiskDoc.Fields[1].Description = "A list of partitions to create on the disk."
 	MachineDiskDoc.Fields[1].Comments[encoder.LineComment] = "A list of partitions to create on the disk."
+	MachineDiskDoc.Fields[2].Name = "raid"
+	MachineDiskDoc.Fields[2].Type = "DiskRAIDConfig"
+	MachineDiskDoc.Fields[2].Note = ""
+	MachineDiskDoc.Fields[2].Description = "Software RAID (md) array to be used as the disk.\nThe array is assembled from the member devices, or created if none of the member devices contains RAID metadata.\nThe `device` should be set to the array path `/dev/md/<name>`."
+	MachineDiskDoc.Fields[2].Comments[encoder.LineComment] = "Software RAID (md) array to be used as the disk."
+
+	DiskRAIDConfigDoc.Type = "DiskRAIDConfig"
+	DiskRAIDConfigDoc.Comments[encoder.LineComment] = "DiskRAIDConfig describes a software RAID array."
+	DiskRAIDConfigDoc.Description = "DiskRAIDConfig describes a software RAID array."
+	DiskRAIDConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineDisk",
+			FieldName: "raid",
+		},
+	}
+	DiskRAIDConfigDoc.Fields = make([]encoder.Doc, 2)
+	DiskRAIDConfigDoc.Fields[0].Name = "level"
+	DiskRAIDConfigDoc.Fields[0].Type = "string"
+	DiskRAIDConfigDoc.Fields[0].Note = ""
+	DiskRAIDConfigDoc.Fields[0].Description = "RAID level."
+	DiskRAIDConfigDoc.Fields[0].Comments[encoder.LineComment] = "RAID level."
+	DiskRAIDConfigDoc.Fields[0].Values = []string{
+		"raid0",
+		"raid1",
+		"raid10",
+	}
+	DiskRAIDConfigDoc.Fields[1].Name = "devices"
+	DiskRAIDConfigDoc.Fields[1].Type = "[]string"
+	DiskRAIDConfigDoc.Fields[1].Note = ""
+	DiskRAIDConfigDoc.Fields[1].Description = "Member devices of the array.\nRAID 0 and RAID 1 require at least 2 devices, RAID 10 requires at least 4 devices."
+	DiskRAIDConfigDoc.Fields[1].Comments[encoder.LineComment] = "Member devices of the array."
+
+	DiskRAIDConfigDoc.Fields[1].AddExample("", []string{"/dev/sdb", "/dev/sdc"})
+
+	VolumeGroupConfigDoc.Type = "VolumeGroupConfig"
+	VolumeGroupConfigDoc.Comments[encoder.LineComment] = "VolumeGroupConfig describes an LVM volume group."
+	VolumeGroupConfigDoc.Description = "VolumeGroupConfig describes an LVM volume group."
+
+	VolumeGroupConfigDoc.AddExample("", machineVolumeGroupsExample)
+	VolumeGroupConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "MachineConfig",
+			FieldName: "volumeGroups",
+		},
+	}
+	VolumeGroupConfigDoc.Fields = make([]encoder.Doc, 3)
+	VolumeGroupConfigDoc.Fields[0].Name = "name"
+	VolumeGroupConfigDoc.Fields[0].Type = "string"
+	VolumeGroupConfigDoc.Fields[0].Note = ""
+	VolumeGroupConfigDoc.Fields[0].Description = "Volume group name."
+	VolumeGroupConfigDoc.Fields[0].Comments[encoder.LineComment] = "Volume group name."
+	VolumeGroupConfigDoc.Fields[1].Name = "physicalVolumes"
+	VolumeGroupConfigDoc.Fields[1].Type = "[]PhysicalVolumeConfig"
+	VolumeGroupConfigDoc.Fields[1].Note = ""
+	VolumeGroupConfigDoc.Fields[1].Description = "Physical volumes of the volume group."
+	VolumeGroupConfigDoc.Fields[1].Comments[encoder.LineComment] = "Physical volumes of the volume group."
+	VolumeGroupConfigDoc.Fields[2].Name = "logicalVolumes"
+	VolumeGroupConfigDoc.Fields[2].Type = "[]LogicalVolumeConfig"
+	VolumeGroupConfigDoc.Fields[2].Note = ""
+	VolumeGroupConfigDoc.Fields[2].Description = "Logical volumes to create in the volume group."
+	VolumeGroupConfigDoc.Fields[2].Comments[encoder.LineComment] = "Logical volumes to create in the volume group."
+
+	PhysicalVolumeConfigDoc.Type = "PhysicalVolumeConfig"
+	PhysicalVolumeConfigDoc.Comments[encoder.LineComment] = "PhysicalVolumeConfig describes an LVM physical volume."
+	PhysicalVolumeConfigDoc.Description = "PhysicalVolumeConfig describes an LVM physical volume."
+	PhysicalVolumeConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "VolumeGroupConfig",
+			FieldName: "physicalVolumes",
+		},
+	}
+	PhysicalVolumeConfigDoc.Fields = make([]encoder.Doc, 2)
+	PhysicalVolumeConfigDoc.Fields[0].Name = "device"
+	PhysicalVolumeConfigDoc.Fields[0].Type = "string"
+	PhysicalVolumeConfigDoc.Fields[0].Note = ""
+	PhysicalVolumeConfigDoc.Fields[0].Description = "Block device to use as the physical volume."
+	PhysicalVolumeConfigDoc.Fields[0].Comments[encoder.LineComment] = "Block device to use as the physical volume."
+
+	PhysicalVolumeConfigDoc.Fields[0].AddExample("", "/dev/sdb")
+	PhysicalVolumeConfigDoc.Fields[1].Name = "diskSelector"
+	PhysicalVolumeConfigDoc.Fields[1].Type = "InstallDiskSelector"
+	PhysicalVolumeConfigDoc.Fields[1].Note = ""
+	PhysicalVolumeConfigDoc.Fields[1].Description = "Disk selector to pick block devices for the physical volumes.\nAll matching empty disks are used as physical volumes."
+	PhysicalVolumeConfigDoc.Fields[1].Comments[encoder.LineComment] = "Disk selector to pick block devices for the physical volumes."
+
+	LogicalVolumeConfigDoc.Type = "LogicalVolumeConfig"
+	LogicalVolumeConfigDoc.Comments[encoder.LineComment] = "LogicalVolumeConfig describes an LVM logical volume."
+	LogicalVolumeConfigDoc.Description = "LogicalVolumeConfig describes an LVM logical volume."
+	LogicalVolumeConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "VolumeGroupConfig",
+			FieldName: "logicalVolumes",
+		},
+	}
+	LogicalVolumeConfigDoc.Fields = make([]encoder.Doc, 5)
+	LogicalVolumeConfigDoc.Fields[0].Name = "name"
+	LogicalVolumeConfigDoc.Fields[0].Type = "string"
+	LogicalVolumeConfigDoc.Fields[0].Note = ""
+	LogicalVolumeConfigDoc.Fields[0].Description = "Logical volume name."
+	LogicalVolumeConfigDoc.Fields[0].Comments[encoder.LineComment] = "Logical volume name."
+	LogicalVolumeConfigDoc.Fields[1].Name = "size"
+	LogicalVolumeConfigDoc.Fields[1].Type = "DiskSize"
+	LogicalVolumeConfigDoc.Fields[1].Note = ""
+	LogicalVolumeConfigDoc.Fields[1].Description = "The size of the logical volume: either bytes or human readable representation."
+	LogicalVolumeConfigDoc.Fields[1].Comments[encoder.LineComment] = "The size of the logical volume: either bytes or human readable representation."
+
+	LogicalVolumeConfigDoc.Fields[1].AddExample("", DiskSize(100000000))
+	LogicalVolumeConfigDoc.Fields[2].Name = "percent"
+	LogicalVolumeConfigDoc.Fields[2].Type = "string"
+	LogicalVolumeConfigDoc.Fields[2].Note = ""
+	LogicalVolumeConfigDoc.Fields[2].Description = "The size of the logical volume as a percentage of the volume group size.\nIf neither `size` nor `percent` is set, the logical volume occupies all the free space."
+	LogicalVolumeConfigDoc.Fields[2].Comments[encoder.LineComment] = "The size of the logical volume as a percentage of the volume group size."
+
+	LogicalVolumeConfigDoc.Fields[2].AddExample("", "50%")
+	LogicalVolumeConfigDoc.Fields[3].Name = "filesystem"
+	LogicalVolumeConfigDoc.Fields[3].Type = "string"
+	LogicalVolumeConfigDoc.Fields[3].Note = ""
+	LogicalVolumeConfigDoc.Fields[3].Description = "Filesystem to format the logical volume with."
+	LogicalVolumeConfigDoc.Fields[3].Comments[encoder.LineComment] = "Filesystem to format the logical volume with."
+	LogicalVolumeConfigDoc.Fields[3].Values = []string{
+		"xfs",
+		"none",
+	}
+	LogicalVolumeConfigDoc.Fields[4].Name = "mountpoint"
+	LogicalVolumeConfigDoc.Fields[4].Type = "string"
+	LogicalVolumeConfigDoc.Fields[4].Note = ""
+	LogicalVolumeConfigDoc.Fields[4].Description = "Where to mount the logical volume.\nThe logical volume is not mounted if the mountpoint is not set."
+	LogicalVolumeConfigDoc.Fields[4].Comments[encoder.LineComment] = "Where to mount the logical volume."
+
+	LogicalVolumeConfigDoc.Fields[4].AddExample("", "/var/mnt/local-pv")
 
 	DiskPartitionDoc.Type = "DiskPartition"
 	DiskPartitionDoc.Comments[encoder.LineComment] = "DiskPartition represents the options for a disk partition."
@@ -1546,12 +1944,18 @@ func init() {
 	ExtraHostDoc.Comments[encoder.LineComment] = "ExtraHost represents a host entry in /etc/hosts."
 	ExtraHostDoc.Description = "ExtraHost represents a host entry in /etc/hosts."
 
+	ExtraHostDoc.AddExample("", networkConfigExtraHostsExample)
+
 	ExtraHostDoc.AddExample("", networkConfigExtraHostsExample)
 	ExtraHostDoc.AppearsIn = []encoder.Appearance{
 		{
 			TypeName:  "NetworkConfig",
 			FieldName: "extraHostEntries",
 		},
+		{
+			TypeName:  "CoreDNS",
+			FieldName: "hosts",
+		},
 	}
 	ExtraHostDoc.Fields = make([]encoder.Doc, 2)
 	ExtraHostDoc.Fields[0].Name = "ip"
@@ -2200,12 +2604,189 @@ func init() {
 			FieldName: "features",
 		},
 	}
-	FeaturesConfigDoc.Fields = make([]encoder.Doc, 1)
+	FeaturesConfigDoc.Fields = make([]encoder.Doc, 5)
 	FeaturesConfigDoc.Fields[0].Name = "rbac"
 	FeaturesConfigDoc.Fields[0].Type = "bool"
 	FeaturesConfigDoc.Fields[0].Note = ""
 	FeaturesConfigDoc.Fields[0].Description = "Enable role-based access control (RBAC)."
 	FeaturesConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable role-based access control (RBAC)."
+	FeaturesConfigDoc.Fields[1].Name = "apiServerLoadBalancer"
+	FeaturesConfigDoc.Fields[1].Type = "APIServerLoadBalancerConfig"
+	FeaturesConfigDoc.Fields[1].Note = ""
+	FeaturesConfigDoc.Fields[1].Description = "Configure the node-local load balancer for the Kubernetes API server.\n\nWhen enabled, Talos runs a TCP load balancer on `localhost` which balances the connections\nacross healthy control plane nodes, and kubelet and kube-proxy use it instead of the cluster endpoint."
+	FeaturesConfigDoc.Fields[1].Comments[encoder.LineComment] = "Configure the node-local load balancer for the Kubernetes API server."
+
+	FeaturesConfigDoc.Fields[1].AddExample("", machineAPIServerLoadBalancerExample)
+	FeaturesConfigDoc.Fields[2].Name = "localAPIAccess"
+	FeaturesConfigDoc.Fields[2].Type = "LocalAPIAccessConfig"
+	FeaturesConfigDoc.Fields[2].Note = ""
+	FeaturesConfigDoc.Fields[2].Description = "Configure the local Talos API access for the extension services and the VM host.\n\nLocal Talos API is served over the Unix socket which is mounted into the extension services listed in the config,\nthe identity of the extension service is derived from the peer credentials of the connection.\nTalos API might also be exposed over the virtio-vsock to the VM host."
+	FeaturesConfigDoc.Fields[2].Comments[encoder.LineComment] = "Configure the local Talos API access for the extension services and the VM host."
+
+	FeaturesConfigDoc.Fields[2].AddExample("", machineLocalAPIAccessExample)
+	FeaturesConfigDoc.Fields[3].Name = "kubernetesTalosAPIAccess"
+	FeaturesConfigDoc.Fields[3].Type = "KubernetesTalosAPIAccessConfig"
+	FeaturesConfigDoc.Fields[3].Note = ""
+	FeaturesConfigDoc.Fields[3].Description = "Configure Talos API access from Kubernetes pods.\n\nThis feature is only supported on control plane nodes.\nWhen enabled, Talos issues short-lived Talos API client certificates to the `talos.dev/v1alpha1` `ServiceAccount`\nresources and stores them as Kubernetes secrets with the same name which can be mounted into the pods."
+	FeaturesConfigDoc.Fields[3].Comments[encoder.LineComment] = "Configure Talos API access from Kubernetes pods."
+
+	FeaturesConfigDoc.Fields[3].AddExample("", machineKubernetesTalosAPIAccessExample)
+	FeaturesConfigDoc.Fields[4].Name = "controllerTracing"
+	FeaturesConfigDoc.Fields[4].Type = "ControllerTracingConfig"
+	FeaturesConfigDoc.Fields[4].Note = ""
+	FeaturesConfigDoc.Fields[4].Description = "Configure the export of the controller runtime traces.\n\nWhen enabled, Talos exports a span for each controller reconcile loop\nto the OpenTelemetry collector using the OTLP/HTTP protocol."
+	FeaturesConfigDoc.Fields[4].Comments[encoder.LineComment] = "Configure the export of the controller runtime traces."
+
+	FeaturesConfigDoc.Fields[4].AddExample("", machineControllerTracingExample)
+
+	ControllerTracingConfigDoc.Type = "ControllerTracingConfig"
+	ControllerTracingConfigDoc.Comments[encoder.LineComment] = "ControllerTracingConfig describes the export of the controller runtime traces."
+	ControllerTracingConfigDoc.Description = "ControllerTracingConfig describes the export of the controller runtime traces."
+
+	ControllerTracingConfigDoc.AddExample("", machineControllerTracingExample)
+	ControllerTracingConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "controllerTracing",
+		},
+	}
+	ControllerTracingConfigDoc.Fields = make([]encoder.Doc, 1)
+	ControllerTracingConfigDoc.Fields[0].Name = "endpoint"
+	ControllerTracingConfigDoc.Fields[0].Type = "Endpoint"
+	ControllerTracingConfigDoc.Fields[0].Note = ""
+	ControllerTracingConfigDoc.Fields[0].Description = "OTLP/HTTP endpoint of the OpenTelemetry collector.\n\nTraces are sent to the `/v1/traces` path of the endpoint.\nSupported protocols are \"http\" and \"https\"."
+	ControllerTracingConfigDoc.Fields[0].Comments[encoder.LineComment] = "OTLP/HTTP endpoint of the OpenTelemetry collector."
+
+	ControllerTracingConfigDoc.Fields[0].AddExample("", controllerTracingEndpointExample)
+
+	KubernetesTalosAPIAccessConfigDoc.Type = "KubernetesTalosAPIAccessConfig"
+	KubernetesTalosAPIAccessConfigDoc.Comments[encoder.LineComment] = "KubernetesTalosAPIAccessConfig describes the Talos API access from Kubernetes pods."
+	KubernetesTalosAPIAccessConfigDoc.Description = "KubernetesTalosAPIAccessConfig describes the Talos API access from Kubernetes pods."
+
+	KubernetesTalosAPIAccessConfigDoc.AddExample("", machineKubernetesTalosAPIAccessExample)
+	KubernetesTalosAPIAccessConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "kubernetesTalosAPIAccess",
+		},
+	}
+	KubernetesTalosAPIAccessConfigDoc.Fields = make([]encoder.Doc, 3)
+	KubernetesTalosAPIAccessConfigDoc.Fields[0].Name = "enabled"
+	KubernetesTalosAPIAccessConfigDoc.Fields[0].Type = "bool"
+	KubernetesTalosAPIAccessConfigDoc.Fields[0].Note = ""
+	KubernetesTalosAPIAccessConfigDoc.Fields[0].Description = "Enable Talos API access from Kubernetes pods."
+	KubernetesTalosAPIAccessConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable Talos API access from Kubernetes pods."
+	KubernetesTalosAPIAccessConfigDoc.Fields[1].Name = "allowedRoles"
+	KubernetesTalosAPIAccessConfigDoc.Fields[1].Type = "[]string"
+	KubernetesTalosAPIAccessConfigDoc.Fields[1].Note = ""
+	KubernetesTalosAPIAccessConfigDoc.Fields[1].Description = "The list of Talos API roles which can be granted for access from Kubernetes pods.\n\nEmpty list means that no roles can be granted, so access is blocked."
+	KubernetesTalosAPIAccessConfigDoc.Fields[1].Comments[encoder.LineComment] = "The list of Talos API roles which can be granted for access from Kubernetes pods."
+
+	KubernetesTalosAPIAccessConfigDoc.Fields[1].AddExample("", []string{"os:reader"})
+	KubernetesTalosAPIAccessConfigDoc.Fields[2].Name = "allowedKubernetesNamespaces"
+	KubernetesTalosAPIAccessConfigDoc.Fields[2].Type = "[]string"
+	KubernetesTalosAPIAccessConfigDoc.Fields[2].Note = ""
+	KubernetesTalosAPIAccessConfigDoc.Fields[2].Description = "The list of Kubernetes namespaces Talos API access is available from."
+	KubernetesTalosAPIAccessConfigDoc.Fields[2].Comments[encoder.LineComment] = "The list of Kubernetes namespaces Talos API access is available from."
+
+	KubernetesTalosAPIAccessConfigDoc.Fields[2].AddExample("", []string{"kube-system"})
+
+	LocalAPIAccessConfigDoc.Type = "LocalAPIAccessConfig"
+	LocalAPIAccessConfigDoc.Comments[encoder.LineComment] = "LocalAPIAccessConfig describes the local Talos API access."
+	LocalAPIAccessConfigDoc.Description = "LocalAPIAccessConfig describes the local Talos API access."
+
+	LocalAPIAccessConfigDoc.AddExample("", machineLocalAPIAccessExample)
+	LocalAPIAccessConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "localAPIAccess",
+		},
+	}
+	LocalAPIAccessConfigDoc.Fields = make([]encoder.Doc, 2)
+	LocalAPIAccessConfigDoc.Fields[0].Name = "services"
+	LocalAPIAccessConfigDoc.Fields[0].Type = "[]LocalAPIServiceConfig"
+	LocalAPIAccessConfigDoc.Fields[0].Note = ""
+	LocalAPIAccessConfigDoc.Fields[0].Description = "List of extension services which are granted access to the local Talos API."
+	LocalAPIAccessConfigDoc.Fields[0].Comments[encoder.LineComment] = "List of extension services which are granted access to the local Talos API."
+	LocalAPIAccessConfigDoc.Fields[1].Name = "vsock"
+	LocalAPIAccessConfigDoc.Fields[1].Type = "LocalAPIVsockConfig"
+	LocalAPIAccessConfigDoc.Fields[1].Note = ""
+	LocalAPIAccessConfigDoc.Fields[1].Description = "Expose the Talos API over the virtio-vsock to the VM host."
+	LocalAPIAccessConfigDoc.Fields[1].Comments[encoder.LineComment] = "Expose the Talos API over the virtio-vsock to the VM host."
+
+	LocalAPIServiceConfigDoc.Type = "LocalAPIServiceConfig"
+	LocalAPIServiceConfigDoc.Comments[encoder.LineComment] = "LocalAPIServiceConfig describes the local Talos API access for the extension service."
+	LocalAPIServiceConfigDoc.Description = "LocalAPIServiceConfig describes the local Talos API access for the extension service."
+	LocalAPIServiceConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LocalAPIAccessConfig",
+			FieldName: "services",
+		},
+	}
+	LocalAPIServiceConfigDoc.Fields = make([]encoder.Doc, 2)
+	LocalAPIServiceConfigDoc.Fields[0].Name = "name"
+	LocalAPIServiceConfigDoc.Fields[0].Type = "string"
+	LocalAPIServiceConfigDoc.Fields[0].Note = ""
+	LocalAPIServiceConfigDoc.Fields[0].Description = "Name of the extension service."
+	LocalAPIServiceConfigDoc.Fields[0].Comments[encoder.LineComment] = "Name of the extension service."
+	LocalAPIServiceConfigDoc.Fields[1].Name = "allowedRoles"
+	LocalAPIServiceConfigDoc.Fields[1].Type = "[]string"
+	LocalAPIServiceConfigDoc.Fields[1].Note = ""
+	LocalAPIServiceConfigDoc.Fields[1].Description = "List of roles granted to the extension service."
+	LocalAPIServiceConfigDoc.Fields[1].Comments[encoder.LineComment] = "List of roles granted to the extension service."
+
+	LocalAPIServiceConfigDoc.Fields[1].AddExample("", []string{"os:reader"})
+
+	LocalAPIVsockConfigDoc.Type = "LocalAPIVsockConfig"
+	LocalAPIVsockConfigDoc.Comments[encoder.LineComment] = "LocalAPIVsockConfig describes the Talos API access over virtio-vsock."
+	LocalAPIVsockConfigDoc.Description = "LocalAPIVsockConfig describes the Talos API access over virtio-vsock."
+	LocalAPIVsockConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "LocalAPIAccessConfig",
+			FieldName: "vsock",
+		},
+	}
+	LocalAPIVsockConfigDoc.Fields = make([]encoder.Doc, 3)
+	LocalAPIVsockConfigDoc.Fields[0].Name = "enabled"
+	LocalAPIVsockConfigDoc.Fields[0].Type = "bool"
+	LocalAPIVsockConfigDoc.Fields[0].Note = ""
+	LocalAPIVsockConfigDoc.Fields[0].Description = "Enable the virtio-vsock listener."
+	LocalAPIVsockConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable the virtio-vsock listener."
+	LocalAPIVsockConfigDoc.Fields[1].Name = "port"
+	LocalAPIVsockConfigDoc.Fields[1].Type = "uint32"
+	LocalAPIVsockConfigDoc.Fields[1].Note = ""
+	LocalAPIVsockConfigDoc.Fields[1].Description = "The vsock port to listen on.\nDefault value is 50000."
+	LocalAPIVsockConfigDoc.Fields[1].Comments[encoder.LineComment] = "The vsock port to listen on."
+	LocalAPIVsockConfigDoc.Fields[2].Name = "allowedRoles"
+	LocalAPIVsockConfigDoc.Fields[2].Type = "[]string"
+	LocalAPIVsockConfigDoc.Fields[2].Note = ""
+	LocalAPIVsockConfigDoc.Fields[2].Description = "List of roles granted to the VM host."
+	LocalAPIVsockConfigDoc.Fields[2].Comments[encoder.LineComment] = "List of roles granted to the VM host."
+
+	LocalAPIVsockConfigDoc.Fields[2].AddExample("", []string{"os:reader"})
+
+	APIServerLoadBalancerConfigDoc.Type = "APIServerLoadBalancerConfig"
+	APIServerLoadBalancerConfigDoc.Comments[encoder.LineComment] = "APIServerLoadBalancerConfig describes the node-local Kubernetes API server load balancer."
+	APIServerLoadBalancerConfigDoc.Description = "APIServerLoadBalancerConfig describes the node-local Kubernetes API server load balancer."
+
+	APIServerLoadBalancerConfigDoc.AddExample("", machineAPIServerLoadBalancerExample)
+	APIServerLoadBalancerConfigDoc.AppearsIn = []encoder.Appearance{
+		{
+			TypeName:  "FeaturesConfig",
+			FieldName: "apiServerLoadBalancer",
+		},
+	}
+	APIServerLoadBalancerConfigDoc.Fields = make([]encoder.Doc, 2)
+	APIServerLoadBalancerConfigDoc.Fields[0].Name = "enabled"
+	APIServerLoadBalancerConfigDoc.Fields[0].Type = "bool"
+	APIServerLoadBalancerConfigDoc.Fields[0].Note = ""
+	APIServerLoadBalancerConfigDoc.Fields[0].Description = "Enable the node-local load balancer."
+	APIServerLoadBalancerConfigDoc.Fields[0].Comments[encoder.LineComment] = "Enable the node-local load balancer."
+	APIServerLoadBalancerConfigDoc.Fields[1].Name = "port"
+	APIServerLoadBalancerConfigDoc.Fields[1].Type = "int"
+	APIServerLoadBalancerConfigDoc.Fields[1].Note = ""
+	APIServerLoadBalancerConfigDoc.Fields[1].Description = "The port the load balancer listens on.\nDefault value is 7445."
+	APIServerLoadBalancerConfigDoc.Fields[1].Comments[encoder.LineComment] = "The port the load balancer listens on."
 
 	VolumeMountConfigDoc.Type = "VolumeMountConfig"
 	VolumeMountConfigDoc.Comments[encoder.LineComment] = "VolumeMountConfig struct describes extra volume mount for the static pods."
@@ -2422,8 +3003,8 @@ func init() {
 	UdevConfigDoc.Fields[0].Name = "rules"
 	UdevConfigDoc.Fields[0].Type = "[]string"
 	UdevConfigDoc.Fields[0].Note = ""
-	UdevConfigDoc.Fields[0].Description = "List of udev rules to apply to the udev system"
-	UdevConfigDoc.Fields[0].Comments[encoder.LineComment] = "List of udev rules to apply to the udev system"
+	UdevConfigDoc.Fields[0].Description = "List of udev rules to apply to the udev system.\n\nRules are applied without a reboot: on change, udev rules are reloaded and matching devices are re-triggered."
+	UdevConfigDoc.Fields[0].Comments[encoder.LineComment] = "List of udev rules to apply to the udev system."
 
 	LoggingConfigDoc.Type = "LoggingConfig"
 	LoggingConfigDoc.Comments[encoder.LineComment] = "LoggingConfig struct configures Talos logging."
@@ -2550,10 +3131,18 @@ func (_ InstallConfig) Doc() *encoder.Doc {
 	return &InstallConfigDoc
 }
 
+func (_ InstallRAIDConfig) Doc() *encoder.Doc {
+	return &InstallRAIDConfigDoc
+}
+
 func (_ InstallDiskSelector) Doc() *encoder.Doc {
 	return &InstallDiskSelectorDoc
 }
 
+func (_ InstallExtraPartition) Doc() *encoder.Doc {
+	return &InstallExtraPartitionDoc
+}
+
 func (_ InstallExtensionConfig) Doc() *encoder.Doc {
 	return &InstallExtensionConfigDoc
 }
@@ -2574,6 +3163,18 @@ func (_ CoreDNS) Doc() *encoder.Doc {
 	return &CoreDNSDoc
 }
 
+func (_ CoreDNSStubDomain) Doc() *encoder.Doc {
+	return &CoreDNSStubDomainDoc
+}
+
+func (_ CoreDNSCacheConfig) Doc() *encoder.Doc {
+	return &CoreDNSCacheConfigDoc
+}
+
+func (_ CoreDNSNodeLocalCacheConfig) Doc() *encoder.Doc {
+	return &CoreDNSNodeLocalCacheConfigDoc
+}
+
 func (_ Endpoint) Doc() *encoder.Doc {
 	return &EndpointDoc
 }
@@ -2626,6 +3227,22 @@ func (_ MachineDisk) Doc() *encoder.Doc {
 	return &MachineDiskDoc
 }
 
+func (_ DiskRAIDConfig) Doc() *encoder.Doc {
+	return &DiskRAIDConfigDoc
+}
+
+func (_ VolumeGroupConfig) Doc() *encoder.Doc {
+	return &VolumeGroupConfigDoc
+}
+
+func (_ PhysicalVolumeConfig) Doc() *encoder.Doc {
+	return &PhysicalVolumeConfigDoc
+}
+
+func (_ LogicalVolumeConfig) Doc() *encoder.Doc {
+	return &LogicalVolumeConfigDoc
+}
+
 func (_ DiskPartition) Doc() *encoder.Doc {
 	return &DiskPartitionDoc
 }
@@ -2718,6 +3335,30 @@ func (_ FeaturesConfig) Doc() *encoder.Doc {
 	return &FeaturesConfigDoc
 }
 
+func (_ ControllerTracingConfig) Doc() *encoder.Doc {
+	return &ControllerTracingConfigDoc
+}
+
+func (_ KubernetesTalosAPIAccessConfig) Doc() *encoder.Doc {
+	return &KubernetesTalosAPIAccessConfigDoc
+}
+
+func (_ LocalAPIAccessConfig) Doc() *encoder.Doc {
+	return &LocalAPIAccessConfigDoc
+}
+
+func (_ LocalAPIServiceConfig) Doc() *encoder.Doc {
+	return &LocalAPIServiceConfigDoc
+}
+
+func (_ LocalAPIVsockConfig) Doc() *encoder.Doc {
+	return &LocalAPIVsockConfigDoc
+}
+
+func (_ APIServerLoadBalancerConfig) Doc() *encoder.Doc {
+	return &APIServerLoadBalancerConfigDoc
+}
+
 func (_ VolumeMountConfig) Doc() *encoder.Doc {
 	return &VolumeMountConfigDoc
 }
@@ -2787,12 +3428,17 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&KubeletNodeIPConfigDoc,
 			&NetworkConfigDoc,
 			&InstallConfigDoc,
+			&InstallRAIDConfigDoc,
 			&InstallDiskSelectorDoc,
+			&InstallExtraPartitionDoc,
 			&InstallExtensionConfigDoc,
 			&TimeConfigDoc,
 			&RegistriesConfigDoc,
 			&PodCheckpointerDoc,
 			&CoreDNSDoc,
+			&CoreDNSStubDomainDoc,
+			&CoreDNSCacheConfigDoc,
+			&CoreDNSNodeLocalCacheConfigDoc,
 			&EndpointDoc,
 			&ControlPlaneConfigDoc,
 			&APIServerConfigDoc,
@@ -2806,6 +3452,10 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&ExternalCloudProviderConfigDoc,
 			&AdminKubeconfigConfigDoc,
 			&MachineDiskDoc,
+			&DiskRAIDConfigDoc,
+			&VolumeGroupConfigDoc,
+			&PhysicalVolumeConfigDoc,
+			&LogicalVolumeConfigDoc,
 			&DiskPartitionDoc,
 			&EncryptionConfigDoc,
 			&EncryptionKeyDoc,
@@ -2829,6 +3479,12 @@ func GetConfigurationDoc() *encoder.FileDoc {
 			&RegistryTLSConfigDoc,
 			&SystemDiskEncryptionConfigDoc,
 			&FeaturesConfigDoc,
+			&ControllerTracingConfigDoc,
+			&KubernetesTalosAPIAccessConfigDoc,
+			&LocalAPIAccessConfigDoc,
+			&LocalAPIServiceConfigDoc,
+			&LocalAPIVsockConfigDoc,
+			&APIServerLoadBalancerConfigDoc,
 			&VolumeMountConfigDoc,
 			&ClusterInlineManifestDoc,
 			&NetworkKubeSpanDoc,
@@ -23,8 +23,12 @@ import (
 	"github.com/talos-systems/talos/pkg/machinery/config"
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
 	"github.com/talos-systems/talos/pkg/machinery/constants"
+	"github.com/talos-systems/talos/pkg/machinery/kernel"
 	"github.com/talos-systems/talos/pkg/machinery/kubelet"
 	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/machinery/raid"
+	"github.com/talos-systems/talos/pkg/machinery/role"
+	"github.com/talos-systems/talos/pkg/machinery/udev"
 )
 
 var (
@@ -89,8 +93,14 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 			result = multierror.Append(result, fmt.Errorf("install instructions are required in %q mode", mode))
 		} else {
 			if opts.Local {
-				if c.MachineConfig.MachineInstall.InstallDisk == "" && len(c.MachineConfig.MachineInstall.DiskMatchers()) == 0 {
-					result = multierror.Append(result, fmt.Errorf("either install disk or diskSelector should be defined"))
+				if c.MachineConfig.MachineInstall.InstallDisk == "" && len(c.MachineConfig.MachineInstall.DiskMatchers()) == 0 && c.MachineConfig.MachineInstall.InstallRAID == nil {
+					result = multierror.Append(result, fmt.Errorf("either install disk, diskSelector or raid should be defined"))
+				}
+			} else if c.MachineConfig.MachineInstall.InstallRAID != nil {
+				for _, device := range c.MachineConfig.MachineInstall.RAIDDevices() {
+					if _, err := os.Stat(device); os.IsNotExist(err) {
+						result = multierror.Append(result, fmt.Errorf("specified install RAID device does not exist: %q", device))
+					}
 				}
 			} else {
 				disk, err := c.MachineConfig.MachineInstall.Disk()
@@ -160,6 +170,16 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 			warnings = append(warnings, warn...)
 			result = multierror.Append(result, err)
 		}
+
+		if hostnameTemplate := c.MachineConfig.MachineNetwork.NetworkHostnameTemplate; hostnameTemplate != "" {
+			if c.MachineConfig.MachineNetwork.NetworkHostname != "" {
+				result = multierror.Append(result, fmt.Errorf("hostname and hostnameTemplate can't be used together"))
+			}
+
+			if _, err := nethelpers.ParseHostnameTemplate(hostnameTemplate); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid hostname template: %w", err))
+			}
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -169,6 +189,64 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 					result = multierror.Append(result, fmt.Errorf("partition for disk %q is set to occupy full disk, but it's not the last partition in the list", disk.Device()))
 				}
 			}
+
+			if disk.DiskRAID != nil {
+				if err := disk.DiskRAID.Validate(disk.Device()); err != nil {
+					result = multierror.Append(result, err)
+				}
+			}
+		}
+	}
+
+	for _, profile := range c.MachineConfig.MachineSysctlProfiles {
+		if _, ok := kernel.ProfileParams(profile); !ok {
+			result = multierror.Append(result, fmt.Errorf("unknown sysctl profile %q, supported profiles: %s", profile, strings.Join(kernel.ProfileNames(), ", ")))
+		}
+	}
+
+	if c.MachineConfig.MachineUdev != nil {
+		for idx, rule := range c.MachineConfig.MachineUdev.UdevRules {
+			if _, err := udev.Parse(rule); err != nil {
+				result = multierror.Append(result, fmt.Errorf("udev.rules[%d]: invalid rule: %w", idx, err))
+			}
+		}
+	}
+
+	volumeGroups := map[string]struct{}{}
+
+	for idx, vg := range c.MachineConfig.MachineVolumeGroups {
+		if _, exists := volumeGroups[vg.VolumeGroupName]; exists {
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d]: duplicate volume group name %q", idx, vg.VolumeGroupName))
+		}
+
+		volumeGroups[vg.VolumeGroupName] = struct{}{}
+
+		if err := vg.Validate(idx); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.APIServerLoadBalancerConfig != nil {
+		if port := c.MachineConfig.MachineFeatures.APIServerLoadBalancerConfig.LoadBalancerPort; port < 0 || port > 65535 {
+			result = multierror.Append(result, fmt.Errorf("API server load balancer port %d is out of range", port))
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.LocalAPIAccessConfig != nil {
+		if err := c.MachineConfig.MachineFeatures.LocalAPIAccessConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.KubernetesTalosAPIAccessConfig != nil {
+		if err := c.MachineConfig.MachineFeatures.KubernetesTalosAPIAccessConfig.Validate(c.Machine().Type()); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.ControllerTracingConfig != nil {
+		if err := c.MachineConfig.MachineFeatures.ControllerTracingConfig.Validate(); err != nil {
+			result = multierror.Append(result, err)
 		}
 	}
 
@@ -229,6 +307,10 @@ func (c *Config) Validate(mode config.RuntimeMode, options ...config.ValidationO
 
 			extensions[ext.Image()] = struct{}{}
 		}
+
+		if err := c.MachineConfig.MachineInstall.Validate(); err != nil {
+			result = multierror.Append(result, err)
+		}
 	}
 
 	if opts.Strict {
@@ -278,6 +360,10 @@ func (c *ClusterConfig) Validate() error {
 		result = multierror.Append(result, ecp.Validate())
 	}
 
+	if c.CoreDNSConfig != nil {
+		result = multierror.Append(result, c.CoreDNSConfig.Validate())
+	}
+
 	if c.EtcdConfig != nil && c.EtcdConfig.EtcdSubnet != "" {
 		if _, _, err := net.ParseCIDR(c.EtcdConfig.EtcdSubnet); err != nil {
 			result = multierror.Append(result, fmt.Errorf("%q is not a valid subnet", c.EtcdConfig.EtcdSubnet))
@@ -340,9 +426,179 @@ func (ecp *ExternalCloudProviderConfig) Validate() error {
 		}
 	}
 
+	switch ecp.ExternalName {
+	case "":
+		if ecp.ExternalImage != "" || ecp.ExternalCloudConfig != "" {
+			result = multierror.Append(result, fmt.Errorf("built-in cloud controller manager image and cloud config require cloud provider name to be set"))
+		}
+	case constants.CloudProviderAWS, constants.CloudProviderGCP:
+	case constants.CloudProviderAzure, constants.CloudProviderOpenStack:
+		if ecp.ExternalCloudConfig == "" {
+			result = multierror.Append(result, fmt.Errorf("cloud config is required for the %q cloud provider", ecp.ExternalName))
+		}
+	case constants.CloudProviderHCloud:
+		if ecp.ExternalCloudConfig != "" {
+			result = multierror.Append(result, fmt.Errorf("cloud config is not supported for the %q cloud provider", ecp.ExternalName))
+		}
+	default:
+		result = multierror.Append(result, fmt.Errorf("unsupported cloud provider %q", ecp.ExternalName))
+	}
+
+	if !ecp.ExternalEnabled && ecp.ExternalName != "" {
+		result = multierror.Append(result, fmt.Errorf("external cloud provider is disabled, but cloud provider %q is configured", ecp.ExternalName))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates CoreDNS configuration.
+//
+//nolint:gocyclo,cyclop
+func (c *CoreDNS) Validate() error {
+	var result *multierror.Error
+
+	for _, upstream := range c.CoreDNSUpstreams {
+		if err := validateDNSServer(upstream); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid CoreDNS upstream %q: %w", upstream, err))
+		}
+	}
+
+	stubDomains := map[string]struct{}{}
+
+	for _, stubDomain := range c.CoreDNSStubDomains {
+		domain := strings.TrimSuffix(stubDomain.StubDomainName, ".")
+
+		if !isValidDNSName(domain) {
+			result = multierror.Append(result, fmt.Errorf("CoreDNS stub domain %q is not a valid DNS name", stubDomain.StubDomainName))
+		}
+
+		if _, ok := stubDomains[domain]; ok {
+			result = multierror.Append(result, fmt.Errorf("CoreDNS stub domain %q is duplicate", stubDomain.StubDomainName))
+		}
+
+		stubDomains[domain] = struct{}{}
+
+		if len(stubDomain.StubDomainServers) == 0 {
+			result = multierror.Append(result, fmt.Errorf("CoreDNS stub domain %q has no servers", stubDomain.StubDomainName))
+		}
+
+		for _, server := range stubDomain.StubDomainServers {
+			if err := validateDNSServer(server); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid CoreDNS stub domain %q server %q: %w", stubDomain.StubDomainName, server, err))
+			}
+		}
+	}
+
+	if cache := c.CoreDNSCacheConfig; cache != nil {
+		if cache.CacheTTL < 0 || cache.CacheSuccessCapacity < 0 || cache.CacheDenialCapacity < 0 {
+			result = multierror.Append(result, fmt.Errorf("CoreDNS cache settings can't be negative"))
+		}
+	}
+
+	for _, host := range c.CoreDNSHosts {
+		if net.ParseIP(host.HostIP) == nil {
+			result = multierror.Append(result, fmt.Errorf("CoreDNS host entry IP %q is not valid", host.HostIP))
+		}
+
+		if len(host.HostAliases) == 0 {
+			result = multierror.Append(result, fmt.Errorf("CoreDNS host entry %q has no hostnames", host.HostIP))
+		}
+
+		for _, alias := range host.HostAliases {
+			if !isValidDNSName(strings.TrimSuffix(alias, ".")) {
+				result = multierror.Append(result, fmt.Errorf("CoreDNS host entry %q hostname %q is not a valid DNS name", host.HostIP, alias))
+			}
+		}
+	}
+
+	for i, block := range c.CoreDNSExtraServerBlocks {
+		if err := validateCorefileServerBlock(block); err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid CoreDNS extra server block %d: %w", i, err))
+		}
+	}
+
+	// NodeLocal DNSCache forwards cache misses to the cluster DNS, so it can't work without CoreDNS
+	if nodeLocalCache := c.CoreDNSNodeLocalCache; nodeLocalCache != nil && nodeLocalCache.NodeLocalCacheEnabled && c.CoreDNSDisabled {
+		result = multierror.Append(result, fmt.Errorf("NodeLocal DNSCache can't be enabled when CoreDNS is disabled"))
+	}
+
+	if nodeLocalCache := c.CoreDNSNodeLocalCache; nodeLocalCache != nil && nodeLocalCache.NodeLocalCacheIP != "" {
+		ip := net.ParseIP(nodeLocalCache.NodeLocalCacheIP)
+
+		switch {
+		case ip == nil:
+			result = multierror.Append(result, fmt.Errorf("NodeLocal DNSCache IP %q is not valid", nodeLocalCache.NodeLocalCacheIP))
+		case !ip.IsLinkLocalUnicast():
+			result = multierror.Append(result, fmt.Errorf("NodeLocal DNSCache IP %q should be a link-local address", nodeLocalCache.NodeLocalCacheIP))
+		}
+	}
+
 	return result.ErrorOrNil()
 }
 
+// validateDNSServer validates DNS server address in CoreDNS `forward` plugin format.
+func validateDNSServer(server string) error {
+	// resolv.conf-like file
+	if strings.HasPrefix(server, "/") {
+		return nil
+	}
+
+	for _, prefix := range []string{"dns://", "tls://"} {
+		server = strings.TrimPrefix(server, prefix)
+	}
+
+	if net.ParseIP(server) != nil {
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(server)
+	if err != nil {
+		return fmt.Errorf("should be an IP address or IP:port")
+	}
+
+	if net.ParseIP(host) == nil {
+		return fmt.Errorf("%q is not a valid IP address", host)
+	}
+
+	if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+		return fmt.Errorf("%q is not a valid port", port)
+	}
+
+	return nil
+}
+
+// validateCorefileServerBlock does basic sanity checks on the Corefile server block.
+func validateCorefileServerBlock(block string) error {
+	if strings.TrimSpace(block) == "" {
+		return fmt.Errorf("server block is empty")
+	}
+
+	if !strings.Contains(block, "{") {
+		return fmt.Errorf("server block should have a body")
+	}
+
+	depth := 0
+
+	for _, ch := range block {
+		switch ch {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+
+		if depth < 0 {
+			return fmt.Errorf("unbalanced braces")
+		}
+	}
+
+	if depth != 0 {
+		return fmt.Errorf("unbalanced braces")
+	}
+
+	return nil
+}
+
 // Validate the inline manifests.
 func (manifests ClusterInlineManifests) Validate() error {
 	var result *multierror.Error
@@ -756,3 +1012,233 @@ func (k *KubeletConfig) Validate() ([]string, error) {
 
 	return nil, result.ErrorOrNil()
 }
+
+// Validate install config.
+func (i *InstallConfig) Validate() error {
+	var result *multierror.Error
+
+	if len(i.InstallExtraPartitions) > 0 && i.InstallEphemeralMaxSize == 0 {
+		result = multierror.Append(result, fmt.Errorf("install.ephemeralMaxSize is required when install.extraPartitions are specified"))
+	}
+
+	labels := map[string]struct{}{}
+
+	for idx, partition := range i.InstallExtraPartitions {
+		switch partition.PartitionLabel {
+		case "":
+			result = multierror.Append(result, fmt.Errorf("install.extraPartitions[%d]: label is required", idx))
+		case constants.EFIPartitionLabel,
+			constants.BIOSGrubPartitionLabel,
+			constants.BootPartitionLabel,
+			constants.MetaPartitionLabel,
+			constants.StatePartitionLabel,
+			constants.EphemeralPartitionLabel,
+			constants.RAIDPartitionLabel:
+			result = multierror.Append(result, fmt.Errorf("install.extraPartitions[%d]: label %q is reserved for system partitions", idx, partition.PartitionLabel))
+		}
+
+		if len(partition.PartitionLabel) > 36 {
+			result = multierror.Append(result, fmt.Errorf("install.extraPartitions[%d]: label %q is too long, maximum length is 36", idx, partition.PartitionLabel))
+		}
+
+		if _, exists := labels[partition.PartitionLabel]; exists {
+			result = multierror.Append(result, fmt.Errorf("install.extraPartitions[%d]: duplicate label %q", idx, partition.PartitionLabel))
+		}
+
+		labels[partition.PartitionLabel] = struct{}{}
+
+		if partition.PartitionSize == 0 && idx != len(i.InstallExtraPartitions)-1 {
+			result = multierror.Append(result, fmt.Errorf("install.extraPartitions[%d]: size can be omitted only for the last partition", idx))
+		}
+	}
+
+	if i.InstallRAID != nil {
+		if len(i.InstallRAID.RAIDDevices) < raid.Level1.MinDevices() {
+			result = multierror.Append(result, fmt.Errorf("install.raid: at least %d devices are required", raid.Level1.MinDevices()))
+		}
+
+		if err := validateUniqueDevices("install.raid", i.InstallRAID.RAIDDevices); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+var (
+	lvmNameRegexp    = regexp.MustCompile(`^[a-zA-Z0-9+_.][a-zA-Z0-9+_.-]*$`)
+	lvmPercentRegexp = regexp.MustCompile(`^(\d+)%$`)
+)
+
+// Validate LVM volume group config.
+//
+//nolint:gocyclo,cyclop
+func (v *VolumeGroupConfig) Validate(idx int) error {
+	var result *multierror.Error
+
+	if !lvmNameRegexp.MatchString(v.VolumeGroupName) {
+		result = multierror.Append(result, fmt.Errorf("volumeGroups[%d]: invalid volume group name %q", idx, v.VolumeGroupName))
+	}
+
+	if len(v.VolumeGroupPhysicalVolumes) == 0 {
+		result = multierror.Append(result, fmt.Errorf("volumeGroups[%d]: at least one physical volume is required", idx))
+	}
+
+	for i, pv := range v.VolumeGroupPhysicalVolumes {
+		if (pv.PhysicalVolumeDevice == "") == (pv.PhysicalVolumeDiskSelector == nil) {
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].physicalVolumes[%d]: exactly one of device or diskSelector should be set", idx, i))
+		}
+	}
+
+	names := map[string]struct{}{}
+
+	for i, lv := range v.VolumeGroupLogicalVolumes {
+		if !lvmNameRegexp.MatchString(lv.LogicalVolumeName) {
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: invalid logical volume name %q", idx, i, lv.LogicalVolumeName))
+		}
+
+		if _, exists := names[lv.LogicalVolumeName]; exists {
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: duplicate logical volume name %q", idx, i, lv.LogicalVolumeName))
+		}
+
+		names[lv.LogicalVolumeName] = struct{}{}
+
+		switch {
+		case lv.LogicalVolumeSize != 0 && lv.LogicalVolumePercent != "":
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: size and percent are mutually exclusive", idx, i))
+		case lv.LogicalVolumePercent != "":
+			matches := lvmPercentRegexp.FindStringSubmatch(lv.LogicalVolumePercent)
+
+			var percent int
+
+			if matches != nil {
+				percent, _ = strconv.Atoi(matches[1]) //nolint:errcheck
+			}
+
+			if percent < 1 || percent > 100 {
+				result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: invalid percent %q", idx, i, lv.LogicalVolumePercent))
+			}
+		case lv.LogicalVolumeSize == 0 && i != len(v.VolumeGroupLogicalVolumes)-1:
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: size can be omitted only for the last logical volume", idx, i))
+		}
+
+		switch lv.Filesystem() {
+		case "xfs":
+		case "none":
+			if lv.LogicalVolumeMountPoint != "" {
+				result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: logical volume without filesystem can't be mounted", idx, i))
+			}
+		default:
+			result = multierror.Append(result, fmt.Errorf("volumeGroups[%d].logicalVolumes[%d]: unsupported filesystem %q", idx, i, lv.LogicalVolumeFilesystem))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate RAID array config.
+func (r *DiskRAIDConfig) Validate(device string) error {
+	var result *multierror.Error
+
+	if !strings.HasPrefix(device, "/dev/md/") || device == "/dev/md/" {
+		result = multierror.Append(result, fmt.Errorf("disk %q: RAID array device should be in the form of /dev/md/<name>", device))
+	}
+
+	level, err := raid.ParseLevel(r.RAIDLevel)
+	if err != nil {
+		result = multierror.Append(result, fmt.Errorf("disk %q: %w", device, err))
+	} else if len(r.RAIDDevices) < level.MinDevices() {
+		result = multierror.Append(result, fmt.Errorf("disk %q: RAID level %q requires at least %d devices", device, r.RAIDLevel, level.MinDevices()))
+	}
+
+	if err = validateUniqueDevices(fmt.Sprintf("disk %q", device), r.RAIDDevices); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateUniqueDevices(prefix string, devices []string) error {
+	var result *multierror.Error
+
+	seen := map[string]struct{}{}
+
+	for _, device := range devices {
+		if _, exists := seen[device]; exists {
+			result = multierror.Append(result, fmt.Errorf("%s: duplicate RAID device %q", prefix, device))
+		}
+
+		seen[device] = struct{}{}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate local API access config.
+func (c *LocalAPIAccessConfig) Validate() error {
+	var result *multierror.Error
+
+	names := map[string]struct{}{}
+
+	for i, service := range c.LocalAPIServices {
+		if service.LocalAPIServiceName == "" {
+			result = multierror.Append(result, fmt.Errorf("localAPIAccess.services[%d]: service name is required", i))
+		}
+
+		if _, exists := names[service.LocalAPIServiceName]; exists {
+			result = multierror.Append(result, fmt.Errorf("localAPIAccess.services[%d]: duplicate service name %q", i, service.LocalAPIServiceName))
+		}
+
+		names[service.LocalAPIServiceName] = struct{}{}
+
+		if _, unknownRoles := role.Parse(service.LocalAPIServiceAllowedRoles); len(unknownRoles) > 0 {
+			result = multierror.Append(result, fmt.Errorf("localAPIAccess.services[%d]: unknown roles %v", i, unknownRoles))
+		}
+	}
+
+	if c.LocalAPIVsock != nil {
+		if _, unknownRoles := role.Parse(c.LocalAPIVsock.LocalAPIVsockAllowedRoles); len(unknownRoles) > 0 {
+			result = multierror.Append(result, fmt.Errorf("localAPIAccess.vsock: unknown roles %v", unknownRoles))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the Talos API access from Kubernetes pods configuration.
+func (c *KubernetesTalosAPIAccessConfig) Validate(machineType machine.Type) error {
+	if !c.AccessEnabled {
+		return nil
+	}
+
+	var result *multierror.Error
+
+	if machineType == machine.TypeWorker {
+		result = multierror.Append(result, fmt.Errorf("kubernetesTalosAPIAccess: feature is only supported on control plane nodes"))
+	}
+
+	if _, unknownRoles := role.Parse(c.AccessAllowedRoles); len(unknownRoles) > 0 {
+		result = multierror.Append(result, fmt.Errorf("kubernetesTalosAPIAccess: unknown roles %v", unknownRoles))
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Validate validates the controller runtime tracing configuration.
+func (c *ControllerTracingConfig) Validate() error {
+	if c.TracingEndpoint == nil || c.TracingEndpoint.URL == nil {
+		return fmt.Errorf("controllerTracing: endpoint is required")
+	}
+
+	var result *multierror.Error
+
+	if c.TracingEndpoint.Scheme != "http" && c.TracingEndpoint.Scheme != "https" {
+		result = multierror.Append(result, fmt.Errorf("controllerTracing: unexpected endpoint scheme %q", c.TracingEndpoint.Scheme))
+	}
+
+	if c.TracingEndpoint.Host == "" {
+		result = multierror.Append(result, fmt.Errorf("controllerTracing: empty endpoint host"))
+	}
+
+	return result.ErrorOrNil()
+}
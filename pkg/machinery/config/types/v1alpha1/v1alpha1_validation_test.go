@@ -185,6 +185,400 @@ func TestValidate(t *testing.T) {
 			requiresInstall: true,
 			expectedError:   "1 error occurred:\n\t* duplicate system extension \"ghcr.io/siderolabs/gvisor:v0.1.0\"\n\n",
 		},
+		{
+			name: "MachineInstallExtraPartitions",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk:             "/dev/vda",
+						InstallEphemeralMaxSize: 100 * 1024 * 1024 * 1024,
+						InstallExtraPartitions: []*v1alpha1.InstallExtraPartition{
+							{
+								PartitionLabel: "CACHE",
+								PartitionSize:  10 * 1024 * 1024 * 1024,
+							},
+							{
+								PartitionLabel: "LOCAL-PV",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+		},
+		{
+			name: "MachineInstallExtraPartitionsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallDisk: "/dev/vda",
+						InstallExtraPartitions: []*v1alpha1.InstallExtraPartition{
+							{
+								PartitionLabel: "STATE",
+								PartitionSize:  1024 * 1024 * 1024,
+							},
+							{
+								PartitionLabel: "LOCAL-PV",
+							},
+							{
+								PartitionLabel: "LOCAL-PV",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+			expectedError:   "4 errors occurred:\n\t* install.ephemeralMaxSize is required when install.extraPartitions are specified\n\t* install.extraPartitions[0]: label \"STATE\" is reserved for system partitions\n\t* install.extraPartitions[1]: size can be omitted only for the last partition\n\t* install.extraPartitions[2]: duplicate label \"LOCAL-PV\"\n\n",
+		},
+		{
+			name: "MachineRAID",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallRAID: &v1alpha1.InstallRAIDConfig{
+							RAIDDevices: []string{"/dev/sda", "/dev/sdb"},
+						},
+					},
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/md/data",
+							DiskRAID: &v1alpha1.DiskRAIDConfig{
+								RAIDLevel:   "raid10",
+								RAIDDevices: []string{"/dev/sdc", "/dev/sdd", "/dev/sde", "/dev/sdf"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+		},
+		{
+			name: "MachineVolumeGroups",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineVolumeGroups: []*v1alpha1.VolumeGroupConfig{
+						{
+							VolumeGroupName: "data",
+							VolumeGroupPhysicalVolumes: []*v1alpha1.PhysicalVolumeConfig{
+								{
+									PhysicalVolumeDevice: "/dev/sdb",
+								},
+								{
+									PhysicalVolumeDiskSelector: &v1alpha1.InstallDiskSelector{
+										Model: "WDC*",
+									},
+								},
+							},
+							VolumeGroupLogicalVolumes: []*v1alpha1.LogicalVolumeConfig{
+								{
+									LogicalVolumeName:       "pv1",
+									LogicalVolumeSize:       10 * 1024 * 1024 * 1024,
+									LogicalVolumeMountPoint: "/var/mnt/pv1",
+								},
+								{
+									LogicalVolumeName:    "pv2",
+									LogicalVolumePercent: "25%",
+								},
+								{
+									LogicalVolumeName:       "raw",
+									LogicalVolumeFilesystem: "none",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MachineVolumeGroupsInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineVolumeGroups: []*v1alpha1.VolumeGroupConfig{
+						{
+							VolumeGroupName: "data",
+							VolumeGroupPhysicalVolumes: []*v1alpha1.PhysicalVolumeConfig{
+								{},
+							},
+							VolumeGroupLogicalVolumes: []*v1alpha1.LogicalVolumeConfig{
+								{
+									LogicalVolumeName: "pv1",
+								},
+								{
+									LogicalVolumeName:    "pv1",
+									LogicalVolumeSize:    1024,
+									LogicalVolumePercent: "25%",
+								},
+								{
+									LogicalVolumeName:       "pv2",
+									LogicalVolumePercent:    "150%",
+									LogicalVolumeFilesystem: "ext4",
+								},
+								{
+									LogicalVolumeName:       "raw",
+									LogicalVolumeFilesystem: "none",
+									LogicalVolumeMountPoint: "/var/mnt/raw",
+								},
+							},
+						},
+						{
+							VolumeGroupName: "data",
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "9 errors occurred:\n\t* volumeGroups[0].physicalVolumes[0]: exactly one of device or diskSelector should be set\n\t* volumeGroups[0].logicalVolumes[0]: size can be omitted only for the last logical volume\n\t* volumeGroups[0].logicalVolumes[1]: duplicate logical volume name \"pv1\"\n\t* volumeGroups[0].logicalVolumes[1]: size and percent are mutually exclusive\n\t* volumeGroups[0].logicalVolumes[2]: invalid percent \"150%\"\n\t* volumeGroups[0].logicalVolumes[2]: unsupported filesystem \"ext4\"\n\t* volumeGroups[0].logicalVolumes[3]: logical volume without filesystem can't be mounted\n\t* volumeGroups[1]: duplicate volume group name \"data\"\n\t* volumeGroups[1]: at least one physical volume is required\n\n",
+		},
+		{
+			name: "MachineLocalAPIAccessInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						LocalAPIAccessConfig: &v1alpha1.LocalAPIAccessConfig{
+							LocalAPIServices: []*v1alpha1.LocalAPIServiceConfig{
+								{
+									LocalAPIServiceName:         "agent",
+									LocalAPIServiceAllowedRoles: []string{"os:reader"},
+								},
+								{
+									LocalAPIServiceName:         "agent",
+									LocalAPIServiceAllowedRoles: []string{"os:superuser"},
+								},
+								{},
+							},
+							LocalAPIVsock: &v1alpha1.LocalAPIVsockConfig{
+								LocalAPIVsockEnabled:      true,
+								LocalAPIVsockAllowedRoles: []string{"reader"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "4 errors occurred:\n\t* localAPIAccess.services[1]: duplicate service name \"agent\"\n\t* localAPIAccess.services[1]: unknown roles [os:superuser]\n\t* localAPIAccess.services[2]: service name is required\n\t* localAPIAccess.vsock: unknown roles [reader]\n\n",
+		},
+		{
+			name: "KubernetesTalosAPIAccessWorker",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						KubernetesTalosAPIAccessConfig: &v1alpha1.KubernetesTalosAPIAccessConfig{
+							AccessEnabled:      true,
+							AccessAllowedRoles: []string{"os:reader", "os:superuser"},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* kubernetesTalosAPIAccess: feature is only supported on control plane nodes\n\t* kubernetesTalosAPIAccess: unknown roles [os:superuser]\n\n",
+		},
+		{
+			name: "HostnameTemplate",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkHostnameTemplate: "talos-{{ hash .SMBIOS.UUID }}",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "HostnameTemplateInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkHostname:         "foo",
+						NetworkHostnameTemplate: "talos-{{ sha1 .SMBIOS.UUID }}",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* hostname and hostnameTemplate can't be used together\n\t* invalid hostname template: template: hostname:1: function \"sha1\" not defined\n\n",
+		},
+		{
+			name: "ControllerTracingInvalidEndpoint",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						ControllerTracingConfig: &v1alpha1.ControllerTracingConfig{
+							TracingEndpoint: &v1alpha1.Endpoint{
+								URL: &url.URL{
+									Scheme: "udp",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* controllerTracing: unexpected endpoint scheme \"udp\"\n\t* controllerTracing: empty endpoint host\n\n",
+		},
+		{
+			name: "MachineUdevRuleInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineUdev: &v1alpha1.UdevConfig{
+						UdevRules: []string{
+							`SUBSYSTEM=="drm", KERNEL=="renderD*", GROUP="44", MODE="0660"`,
+							`SUBSYSTEM=="drm" GROUP="44"`,
+							`SUBSYSTEM==drm`,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* udev.rules[1]: invalid rule: at position 18: expected ',' after value of key \"SUBSYSTEM\"\n\t* udev.rules[2]: invalid rule: at position 12: expected quoted value for key \"SUBSYSTEM\"\n\n",
+		},
+		{
+			name: "MachineSysctlProfileInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType:           "worker",
+					MachineSysctlProfiles: []string{"etcd", "low-throughput"},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* unknown sysctl profile \"low-throughput\", supported profiles: etcd, low-latency, network-throughput\n\n",
+		},
+		{
+			name: "MachineRAIDInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineInstall: &v1alpha1.InstallConfig{
+						InstallRAID: &v1alpha1.InstallRAIDConfig{
+							RAIDDevices: []string{"/dev/sda"},
+						},
+					},
+					MachineDisks: []*v1alpha1.MachineDisk{
+						{
+							DeviceName: "/dev/sdb",
+							DiskRAID: &v1alpha1.DiskRAIDConfig{
+								RAIDLevel:   "raid5",
+								RAIDDevices: []string{"/dev/sdc", "/dev/sdd", "/dev/sde"},
+							},
+						},
+						{
+							DeviceName: "/dev/md/data",
+							DiskRAID: &v1alpha1.DiskRAIDConfig{
+								RAIDLevel:   "raid10",
+								RAIDDevices: []string{"/dev/sdc", "/dev/sdd", "/dev/sdd"},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			requiresInstall: true,
+			expectedError:   "5 errors occurred:\n\t* disk \"/dev/sdb\": RAID array device should be in the form of /dev/md/<name>\n\t* disk \"/dev/sdb\": unsupported RAID level \"raid5\"\n\t* disk \"/dev/md/data\": RAID level \"raid10\" requires at least 4 devices\n\t* disk \"/dev/md/data\": duplicate RAID device \"/dev/sdd\"\n\t* install.raid: at least 2 devices are required\n\n",
+		},
 		{
 			name: "ExternalCloudProviderEnabled",
 			config: &v1alpha1.Config{
@@ -290,6 +684,232 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* invalid external cloud provider manifest url \"/manifest.yaml\": hostname must not be blank\n\n",
 		},
+		{
+			name: "APIServerLoadBalancerInvalidPort",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						APIServerLoadBalancerConfig: &v1alpha1.APIServerLoadBalancerConfig{
+							LoadBalancerEnabled: true,
+							LoadBalancerPort:    70000,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* API server load balancer port 70000 is out of range\n\n",
+		},
+		{
+			name: "ExternalCloudProviderBuiltIn",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ExternalCloudProviderConfig: &v1alpha1.ExternalCloudProviderConfig{
+						ExternalEnabled:     true,
+						ExternalName:        "openstack",
+						ExternalCloudConfig: "[Global]\n",
+					},
+				},
+			},
+		},
+		{
+			name: "ExternalCloudProviderBuiltInInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ExternalCloudProviderConfig: &v1alpha1.ExternalCloudProviderConfig{
+						ExternalEnabled: true,
+						ExternalName:    "digitalocean",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* unsupported cloud provider \"digitalocean\"\n\n",
+		},
+		{
+			name: "ExternalCloudProviderBuiltInNoCloudConfig",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ExternalCloudProviderConfig: &v1alpha1.ExternalCloudProviderConfig{
+						ExternalEnabled: true,
+						ExternalName:    "azure",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* cloud config is required for the \"azure\" cloud provider\n\n",
+		},
+		{
+			name: "ExternalCloudProviderBuiltInDisabled",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ExternalCloudProviderConfig: &v1alpha1.ExternalCloudProviderConfig{
+						ExternalName:        "hcloud",
+						ExternalCloudConfig: "foo",
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n\t* cloud config is not supported for the \"hcloud\" cloud provider\n\t* external cloud provider is disabled, but cloud provider \"hcloud\" is configured\n\n",
+		},
+		{
+			name: "CoreDNSCustomization",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					CoreDNSConfig: &v1alpha1.CoreDNS{
+						CoreDNSUpstreams: []string{"1.1.1.1", "tls://9.9.9.9", "[2001:db8::1]:5353"},
+						CoreDNSStubDomains: []*v1alpha1.CoreDNSStubDomain{
+							{
+								StubDomainName:    "corp.example.com",
+								StubDomainServers: []string{"10.150.0.1"},
+							},
+						},
+						CoreDNSCacheConfig: &v1alpha1.CoreDNSCacheConfig{
+							CacheTTL: 60,
+						},
+						CoreDNSHosts: []*v1alpha1.ExtraHost{
+							{
+								HostIP:      "10.5.0.1",
+								HostAliases: []string{"registry.local"},
+							},
+						},
+						CoreDNSExtraServerBlocks: []string{"consul.local:53 {\n    forward . 10.150.0.1\n}\n"},
+					},
+				},
+			},
+		},
+		{
+			name: "CoreDNSInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					CoreDNSConfig: &v1alpha1.CoreDNS{
+						CoreDNSUpstreams: []string{"dns.example.com"},
+						CoreDNSStubDomains: []*v1alpha1.CoreDNSStubDomain{
+							{
+								StubDomainName: "corp.example.com",
+							},
+							{
+								StubDomainName:    "corp.example.com.",
+								StubDomainServers: []string{"10.150.0.1:99999"},
+							},
+						},
+						CoreDNSCacheConfig: &v1alpha1.CoreDNSCacheConfig{
+							CacheTTL: -1,
+						},
+						CoreDNSHosts: []*v1alpha1.ExtraHost{
+							{
+								HostIP: "10.5.0.300",
+							},
+						},
+						CoreDNSExtraServerBlocks: []string{"consul.local:53 {\n"},
+					},
+				},
+			},
+			expectedError: "8 errors occurred:\n\t* invalid CoreDNS upstream \"dns.example.com\": should be an IP address or IP:port\n\t* CoreDNS stub domain \"corp.example.com\" has no servers\n\t* CoreDNS stub domain \"corp.example.com.\" is duplicate\n\t* invalid CoreDNS stub domain \"corp.example.com.\" server \"10.150.0.1:99999\": \"99999\" is not a valid port\n\t* CoreDNS cache settings can't be negative\n\t* CoreDNS host entry IP \"10.5.0.300\" is not valid\n\t* CoreDNS host entry \"10.5.0.300\" has no hostnames\n\t* invalid CoreDNS extra server block 0: unbalanced braces\n\n",
+		},
+		{
+			name: "CoreDNSNodeLocalCacheInvalidIP",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					CoreDNSConfig: &v1alpha1.CoreDNS{
+						CoreDNSNodeLocalCache: &v1alpha1.CoreDNSNodeLocalCacheConfig{
+							NodeLocalCacheEnabled: true,
+							NodeLocalCacheIP:      "10.96.0.10",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* NodeLocal DNSCache IP \"10.96.0.10\" should be a link-local address\n\n",
+		},
+		{
+			name: "CoreDNSNodeLocalCacheCoreDNSDisabled",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					CoreDNSConfig: &v1alpha1.CoreDNS{
+						CoreDNSDisabled: true,
+						CoreDNSNodeLocalCache: &v1alpha1.CoreDNSNodeLocalCacheConfig{
+							NodeLocalCacheEnabled: true,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* NodeLocal DNSCache can't be enabled when CoreDNS is disabled\n\n",
+		},
 		{
 			name: "InlineManifests",
 			config: &v1alpha1.Config{
@@ -60,6 +60,22 @@ func (in *APIServerConfig) DeepCopy() *APIServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerConfig) DeepCopyInto(out *APIServerLoadBalancerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerConfig.
+func (in *APIServerLoadBalancerConfig) DeepCopy() *APIServerLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdminKubeconfigConfig) DeepCopyInto(out *AdminKubeconfigConfig) {
 	*out = *in
@@ -219,7 +235,7 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 	if in.CoreDNSConfig != nil {
 		in, out := &in.CoreDNSConfig, &out.CoreDNSConfig
 		*out = new(CoreDNS)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ExternalCloudProviderConfig != nil {
 		in, out := &in.ExternalCloudProviderConfig, &out.ExternalCloudProviderConfig
@@ -426,9 +442,71 @@ func (in *ControllerManagerConfig) DeepCopy() *ControllerManagerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerTracingConfig) DeepCopyInto(out *ControllerTracingConfig) {
+	*out = *in
+	if in.TracingEndpoint != nil {
+		in, out := &in.TracingEndpoint, &out.TracingEndpoint
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerTracingConfig.
+func (in *ControllerTracingConfig) DeepCopy() *ControllerTracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerTracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNS) DeepCopyInto(out *CoreDNS) {
 	*out = *in
+	if in.CoreDNSUpstreams != nil {
+		in, out := &in.CoreDNSUpstreams, &out.CoreDNSUpstreams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CoreDNSStubDomains != nil {
+		in, out := &in.CoreDNSStubDomains, &out.CoreDNSStubDomains
+		*out = make([]*CoreDNSStubDomain, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(CoreDNSStubDomain)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CoreDNSCacheConfig != nil {
+		in, out := &in.CoreDNSCacheConfig, &out.CoreDNSCacheConfig
+		*out = new(CoreDNSCacheConfig)
+		**out = **in
+	}
+	if in.CoreDNSHosts != nil {
+		in, out := &in.CoreDNSHosts, &out.CoreDNSHosts
+		*out = make([]*ExtraHost, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ExtraHost)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CoreDNSExtraServerBlocks != nil {
+		in, out := &in.CoreDNSExtraServerBlocks, &out.CoreDNSExtraServerBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CoreDNSNodeLocalCache != nil {
+		in, out := &in.CoreDNSNodeLocalCache, &out.CoreDNSNodeLocalCache
+		*out = new(CoreDNSNodeLocalCacheConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -442,6 +520,59 @@ func (in *CoreDNS) DeepCopy() *CoreDNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSCacheConfig) DeepCopyInto(out *CoreDNSCacheConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSCacheConfig.
+func (in *CoreDNSCacheConfig) DeepCopy() *CoreDNSCacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSCacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSNodeLocalCacheConfig) DeepCopyInto(out *CoreDNSNodeLocalCacheConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSNodeLocalCacheConfig.
+func (in *CoreDNSNodeLocalCacheConfig) DeepCopy() *CoreDNSNodeLocalCacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSNodeLocalCacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSStubDomain) DeepCopyInto(out *CoreDNSStubDomain) {
+	*out = *in
+	if in.StubDomainServers != nil {
+		in, out := &in.StubDomainServers, &out.StubDomainServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSStubDomain.
+func (in *CoreDNSStubDomain) DeepCopy() *CoreDNSStubDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSStubDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 	*out = *in
@@ -644,6 +775,27 @@ func (in *DiskPartition) DeepCopy() *DiskPartition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskRAIDConfig) DeepCopyInto(out *DiskRAIDConfig) {
+	*out = *in
+	if in.RAIDDevices != nil {
+		in, out := &in.RAIDDevices, &out.RAIDDevices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskRAIDConfig.
+func (in *DiskRAIDConfig) DeepCopy() *DiskRAIDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskRAIDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
@@ -811,6 +963,26 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.APIServerLoadBalancerConfig != nil {
+		in, out := &in.APIServerLoadBalancerConfig, &out.APIServerLoadBalancerConfig
+		*out = new(APIServerLoadBalancerConfig)
+		**out = **in
+	}
+	if in.LocalAPIAccessConfig != nil {
+		in, out := &in.LocalAPIAccessConfig, &out.LocalAPIAccessConfig
+		*out = new(LocalAPIAccessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesTalosAPIAccessConfig != nil {
+		in, out := &in.KubernetesTalosAPIAccessConfig, &out.KubernetesTalosAPIAccessConfig
+		*out = new(KubernetesTalosAPIAccessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControllerTracingConfig != nil {
+		in, out := &in.ControllerTracingConfig, &out.ControllerTracingConfig
+		*out = new(ControllerTracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -842,6 +1014,22 @@ func (in *InstallConfig) DeepCopyInto(out *InstallConfig) {
 		*out = make([]InstallExtensionConfig, len(*in))
 		copy(*out, *in)
 	}
+	if in.InstallExtraPartitions != nil {
+		in, out := &in.InstallExtraPartitions, &out.InstallExtraPartitions
+		*out = make([]*InstallExtraPartition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InstallExtraPartition)
+				**out = **in
+			}
+		}
+	}
+	if in.InstallRAID != nil {
+		in, out := &in.InstallRAID, &out.InstallRAID
+		*out = new(InstallRAIDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -901,6 +1089,43 @@ func (in *InstallExtensionConfig) DeepCopy() *InstallExtensionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallExtraPartition) DeepCopyInto(out *InstallExtraPartition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallExtraPartition.
+func (in *InstallExtraPartition) DeepCopy() *InstallExtraPartition {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallExtraPartition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallRAIDConfig) DeepCopyInto(out *InstallRAIDConfig) {
+	*out = *in
+	if in.RAIDDevices != nil {
+		in, out := &in.RAIDDevices, &out.RAIDDevices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallRAIDConfig.
+func (in *InstallRAIDConfig) DeepCopy() *InstallRAIDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallRAIDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelConfig) DeepCopyInto(out *KernelConfig) {
 	*out = *in
@@ -1002,6 +1227,106 @@ func (in *KubeletNodeIPConfig) DeepCopy() *KubeletNodeIPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesTalosAPIAccessConfig) DeepCopyInto(out *KubernetesTalosAPIAccessConfig) {
+	*out = *in
+	if in.AccessAllowedRoles != nil {
+		in, out := &in.AccessAllowedRoles, &out.AccessAllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessAllowedKubernetesNamespaces != nil {
+		in, out := &in.AccessAllowedKubernetesNamespaces, &out.AccessAllowedKubernetesNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesTalosAPIAccessConfig.
+func (in *KubernetesTalosAPIAccessConfig) DeepCopy() *KubernetesTalosAPIAccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesTalosAPIAccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalAPIAccessConfig) DeepCopyInto(out *LocalAPIAccessConfig) {
+	*out = *in
+	if in.LocalAPIServices != nil {
+		in, out := &in.LocalAPIServices, &out.LocalAPIServices
+		*out = make([]*LocalAPIServiceConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LocalAPIServiceConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LocalAPIVsock != nil {
+		in, out := &in.LocalAPIVsock, &out.LocalAPIVsock
+		*out = new(LocalAPIVsockConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalAPIAccessConfig.
+func (in *LocalAPIAccessConfig) DeepCopy() *LocalAPIAccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalAPIAccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalAPIServiceConfig) DeepCopyInto(out *LocalAPIServiceConfig) {
+	*out = *in
+	if in.LocalAPIServiceAllowedRoles != nil {
+		in, out := &in.LocalAPIServiceAllowedRoles, &out.LocalAPIServiceAllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalAPIServiceConfig.
+func (in *LocalAPIServiceConfig) DeepCopy() *LocalAPIServiceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalAPIServiceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalAPIVsockConfig) DeepCopyInto(out *LocalAPIVsockConfig) {
+	*out = *in
+	if in.LocalAPIVsockAllowedRoles != nil {
+		in, out := &in.LocalAPIVsockAllowedRoles, &out.LocalAPIVsockAllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalAPIVsockConfig.
+func (in *LocalAPIVsockConfig) DeepCopy() *LocalAPIVsockConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalAPIVsockConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
 	*out = *in
@@ -1045,6 +1370,22 @@ func (in *LoggingDestination) DeepCopy() *LoggingDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogicalVolumeConfig) DeepCopyInto(out *LogicalVolumeConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalVolumeConfig.
+func (in *LogicalVolumeConfig) DeepCopy() *LogicalVolumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LogicalVolumeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 	*out = *in
@@ -1090,6 +1431,17 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			}
 		}
 	}
+	if in.MachineVolumeGroups != nil {
+		in, out := &in.MachineVolumeGroups, &out.MachineVolumeGroups
+		*out = make([]*VolumeGroupConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VolumeGroupConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.MachineInstall != nil {
 		in, out := &in.MachineInstall, &out.MachineInstall
 		*out = new(InstallConfig)
@@ -1132,6 +1484,11 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.MachineSysctlProfiles != nil {
+		in, out := &in.MachineSysctlProfiles, &out.MachineSysctlProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.MachineRegistries.DeepCopyInto(&out.MachineRegistries)
 	if in.MachineSystemDiskEncryption != nil {
 		in, out := &in.MachineSystemDiskEncryption, &out.MachineSystemDiskEncryption
@@ -1227,6 +1584,11 @@ func (in *MachineDisk) DeepCopyInto(out *MachineDisk) {
 			}
 		}
 	}
+	if in.DiskRAID != nil {
+		in, out := &in.DiskRAID, &out.DiskRAID
+		*out = new(DiskRAIDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1348,6 +1710,27 @@ func (in *NetworkKubeSpan) DeepCopy() *NetworkKubeSpan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalVolumeConfig) DeepCopyInto(out *PhysicalVolumeConfig) {
+	*out = *in
+	if in.PhysicalVolumeDiskSelector != nil {
+		in, out := &in.PhysicalVolumeDiskSelector, &out.PhysicalVolumeDiskSelector
+		*out = new(InstallDiskSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalVolumeConfig.
+func (in *PhysicalVolumeConfig) DeepCopy() *PhysicalVolumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalVolumeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodCheckpointer) DeepCopyInto(out *PodCheckpointer) {
 	*out = *in
@@ -1748,6 +2131,44 @@ func (in *Vlan) DeepCopy() *Vlan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeGroupConfig) DeepCopyInto(out *VolumeGroupConfig) {
+	*out = *in
+	if in.VolumeGroupPhysicalVolumes != nil {
+		in, out := &in.VolumeGroupPhysicalVolumes, &out.VolumeGroupPhysicalVolumes
+		*out = make([]*PhysicalVolumeConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PhysicalVolumeConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VolumeGroupLogicalVolumes != nil {
+		in, out := &in.VolumeGroupLogicalVolumes, &out.VolumeGroupLogicalVolumes
+		*out = make([]*LogicalVolumeConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(LogicalVolumeConfig)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeGroupConfig.
+func (in *VolumeGroupConfig) DeepCopy() *VolumeGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeGroupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountConfig) DeepCopyInto(out *VolumeMountConfig) {
 	*out = *in
@@ -113,6 +113,13 @@ const (
 	// stage bootloader.
 	BIOSGrubPartitionLabel = "BIOS"
 
+	// RAIDPartitionLabel is the label of the partition used as a member of the system disk
+	// software RAID array.
+	RAIDPartitionLabel = "RAID"
+
+	// SystemRAIDArrayName is the name of the software RAID array used as the system disk.
+	SystemRAIDArrayName = "talos-system"
+
 	// MetaPartitionLabel is the label of the meta partition.
 	MetaPartitionLabel = "META"
 
@@ -204,6 +211,35 @@ const (
 	// KubernetesAdminCertDefaultLifetime defines default lifetime for Kubernetes generated admin certificate.
 	KubernetesAdminCertDefaultLifetime = 365 * 24 * time.Hour
 
+	// KubeconfigIssuerMaxCertLifetime is the maximum lifetime of the Kubernetes client certificate issued for a user.
+	//
+	// Kubernetes doesn't support revoking client certificates, so the lifetime is kept short.
+	KubeconfigIssuerMaxCertLifetime = 7 * 24 * time.Hour
+
+	// KubernetesSystemPrefix is the prefix of the Kubernetes users and groups reserved for the Kubernetes components.
+	KubernetesSystemPrefix = "system:"
+
+	// KubernetesTalosAPIServiceAccountGroup is the API group of the Talos API access service account Kubernetes resource.
+	KubernetesTalosAPIServiceAccountGroup = "talos.dev"
+
+	// KubernetesTalosAPIServiceAccountVersion is the API version of the Talos API access service account Kubernetes resource.
+	KubernetesTalosAPIServiceAccountVersion = "v1alpha1"
+
+	// KubernetesTalosAPIServiceAccountResource is the plural name of the Talos API access service account Kubernetes resource.
+	KubernetesTalosAPIServiceAccountResource = "serviceaccounts"
+
+	// KubernetesTalosAPIServiceAccountKind is the kind of the Talos API access service account Kubernetes resource.
+	KubernetesTalosAPIServiceAccountKind = "ServiceAccount"
+
+	// KubernetesTalosAPIServiceAccountSecretKey is the key of the talosconfig in the Talos API access service account secret.
+	KubernetesTalosAPIServiceAccountSecretKey = "config"
+
+	// KubernetesTalosAPIServiceAccountCertTTL is the lifetime of the Talos API client certificates issued to Kubernetes pods.
+	KubernetesTalosAPIServiceAccountCertTTL = 24 * time.Hour
+
+	// KubernetesTalosAPIServiceAccountCertRefresh is the remaining lifetime of the certificate when it gets reissued.
+	KubernetesTalosAPIServiceAccountCertRefresh = 12 * time.Hour
+
 	// KubebernetesStaticSecretsDir defines ephemeral directory which contains rendered secrets for controlplane components.
 	KubebernetesStaticSecretsDir = "/system/secrets/kubernetes"
 
@@ -282,6 +318,42 @@ const (
 	// DefaultCoreDNSVersion is the default version for the CoreDNS.
 	DefaultCoreDNSVersion = "1.9.3"
 
+	// DefaultCoreDNSCacheTTL is the default maximum TTL of the CoreDNS cache in seconds.
+	DefaultCoreDNSCacheTTL = 30
+
+	// NodeLocalDNSImage is the NodeLocal DNSCache image to use.
+	NodeLocalDNSImage = "k8s.gcr.io/dns/k8s-dns-node-cache"
+
+	// DefaultNodeLocalDNSVersion is the default version for the NodeLocal DNSCache.
+	DefaultNodeLocalDNSVersion = "1.21.4"
+
+	// DefaultNodeLocalDNSIP is the default link-local IP address NodeLocal DNSCache listens on.
+	DefaultNodeLocalDNSIP = "169.254.20.10"
+
+	// AWSCloudControllerManagerImage is the image repository of the built-in AWS cloud controller manager.
+	AWSCloudControllerManagerImage = "k8s.gcr.io/provider-aws/cloud-controller-manager"
+
+	// AzureCloudControllerManagerImage is the image repository of the built-in Azure cloud controller manager.
+	AzureCloudControllerManagerImage = "mcr.microsoft.com/oss/kubernetes/azure-cloud-controller-manager"
+
+	// AzureCloudNodeManagerImage is the image repository of the built-in Azure cloud node manager.
+	AzureCloudNodeManagerImage = "mcr.microsoft.com/oss/kubernetes/azure-cloud-node-manager"
+
+	// GCPCloudControllerManagerImage is the image repository of the built-in GCP cloud controller manager.
+	GCPCloudControllerManagerImage = "k8s.gcr.io/cloud-provider-gcp/cloud-controller-manager"
+
+	// DefaultGCPCloudControllerManagerVersion is the default version of the built-in GCP cloud controller manager.
+	DefaultGCPCloudControllerManagerVersion = "v24.0.0"
+
+	// HCloudCloudControllerManagerImage is the image repository of the built-in Hetzner Cloud cloud controller manager.
+	HCloudCloudControllerManagerImage = "docker.io/hetznercloud/hcloud-cloud-controller-manager"
+
+	// DefaultHCloudCloudControllerManagerVersion is the default version of the built-in Hetzner Cloud cloud controller manager.
+	DefaultHCloudCloudControllerManagerVersion = "v1.12.1"
+
+	// OpenStackCloudControllerManagerImage is the image repository of the built-in OpenStack cloud controller manager.
+	OpenStackCloudControllerManagerImage = "docker.io/k8scloudprovider/openstack-cloud-controller-manager"
+
 	// LabelNodeRoleMaster is the node label required by a control plane node.
 	LabelNodeRoleMaster = "node-role.kubernetes.io/master"
 
@@ -294,9 +366,25 @@ const (
 	// TalosManifestPrefix is the prefix for static pod files created in ManifestsDirectory by Talos.
 	TalosManifestPrefix = "talos-"
 
+	// DefaultAPIServerLoadBalancerPort is the default port of the node-local Kubernetes API server load balancer.
+	DefaultAPIServerLoadBalancerPort = 7445
+
+	// APIServerLoadBalancerHost is the host the node-local Kubernetes API server load balancer is reachable at.
+	//
+	// Kubernetes API server certificate always includes `localhost` as a SAN.
+	APIServerLoadBalancerHost = "localhost"
+
+	// APIServerLoadBalancerBindAddress is the address the node-local Kubernetes API server load balancer listens on.
+	APIServerLoadBalancerBindAddress = "127.0.0.1"
+
 	// KubeletKubeconfig is the generated kubeconfig for kubelet.
 	KubeletKubeconfig = "/etc/kubernetes/kubeconfig-kubelet"
 
+	// KubeProxyKubeconfigDir is the directory with the node-specific kubeconfig for kube-proxy.
+	//
+	// The directory is mounted into the kube-proxy pod as /etc/kubernetes.
+	KubeProxyKubeconfigDir = "/etc/kubernetes/kube-proxy"
+
 	// KubeletSystemReservedCPU cpu system reservation value for kubelet kubeconfig.
 	KubeletSystemReservedCPU = "50m"
 
@@ -414,6 +502,12 @@ const (
 	// MachineSocketPath is the path to file socket of machine API.
 	MachineSocketPath = SystemRunPath + "/machined/machine.sock"
 
+	// LocalAPISocketPath is the path to file socket of the local Talos API exposed to the extension services.
+	LocalAPISocketPath = SystemRunPath + "/localapi/api.sock"
+
+	// LocalAPIContainerPath is the path the local Talos API socket directory is mounted to in the extension services.
+	LocalAPIContainerPath = "/var/run/talos"
+
 	// NetworkSocketPath is the path to file socket of network API.
 	NetworkSocketPath = SystemRunPath + "/networkd/networkd.sock"
 
@@ -502,6 +596,21 @@ const (
 	// CgroupKubeletReservedMemory is the hard memory protection for the kubelet processes.
 	CgroupKubeletReservedMemory = 64 * 1024 * 1024
 
+	// CloudProviderAWS is the name of the built-in AWS cloud controller manager.
+	CloudProviderAWS = "aws"
+
+	// CloudProviderAzure is the name of the built-in Azure cloud controller manager.
+	CloudProviderAzure = "azure"
+
+	// CloudProviderGCP is the name of the built-in GCP cloud controller manager.
+	CloudProviderGCP = "gcp"
+
+	// CloudProviderHCloud is the name of the built-in Hetzner Cloud cloud controller manager.
+	CloudProviderHCloud = "hcloud"
+
+	// CloudProviderOpenStack is the name of the built-in OpenStack cloud controller manager.
+	CloudProviderOpenStack = "openstack"
+
 	// FlannelCNI is the string to use Tanos-managed Flannel CNI (default).
 	FlannelCNI = "flannel"
 
@@ -580,6 +689,9 @@ const (
 	// NodeIdentityFilename is the filename to cache node identity across reboots.
 	NodeIdentityFilename = "node-identity.yaml"
 
+	// RemovedMembersFilename is the filename to persist the list of removed cluster members across reboots.
+	RemovedMembersFilename = "removed-members.yaml"
+
 	// DefaultDiscoveryServiceEndpoint is the default endpoint for Talos discovery service.
 	DefaultDiscoveryServiceEndpoint = "https://discovery.talos.dev/"
 
@@ -613,6 +725,9 @@ const (
 	// ClusterNodeIDAnnotation is the node annotation used to represent node ID.
 	ClusterNodeIDAnnotation = "cluster.talos.dev/node-id"
 
+	// ClusterRemovedMembersAnnotation is the control plane node annotation used to list the (comma-separated) node IDs removed from the cluster.
+	ClusterRemovedMembersAnnotation = "cluster.talos.dev/removed-members"
+
 	// KubeSpanIPAnnotation is the node annotation to be used for indicating the Wireguard IP of the node.
 	KubeSpanIPAnnotation = "networking.talos.dev/kubespan-ip"
 
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kernel
+
+import "sort"
+
+// Sysctl tuning profiles.
+const (
+	ProfileNetworkThroughput = "network-throughput"
+	ProfileLowLatency        = "low-latency"
+	ProfileEtcd              = "etcd"
+)
+
+// profiles maps profile names to the sysctls (without the `proc.sys` prefix) the profile expands into.
+var profiles = map[string]map[string]string{
+	// larger socket buffers and queues for high bandwidth (10G+) links.
+	ProfileNetworkThroughput: {
+		"net.core.rmem_max":                  "16777216",
+		"net.core.wmem_max":                  "16777216",
+		"net.ipv4.tcp_rmem":                  "4096 87380 16777216",
+		"net.ipv4.tcp_wmem":                  "4096 65536 16777216",
+		"net.core.netdev_max_backlog":        "30000",
+		"net.core.somaxconn":                 "32768",
+		"net.ipv4.tcp_mtu_probing":           "1",
+		"net.ipv4.tcp_slow_start_after_idle": "0",
+	},
+	// busy polling and reduced timer/statistics jitter at the cost of CPU usage.
+	ProfileLowLatency: {
+		"net.core.busy_read":                 "50",
+		"net.core.busy_poll":                 "50",
+		"net.ipv4.tcp_fastopen":              "3",
+		"net.ipv4.tcp_slow_start_after_idle": "0",
+		"kernel.timer_migration":             "0",
+		"vm.stat_interval":                   "10",
+	},
+	// earlier and smaller writeback to keep etcd fsync latency low.
+	ProfileEtcd: {
+		"vm.dirty_background_ratio":    "5",
+		"vm.dirty_ratio":               "10",
+		"vm.dirty_expire_centisecs":    "1000",
+		"net.core.somaxconn":           "32768",
+		"net.ipv4.tcp_max_syn_backlog": "8192",
+	},
+}
+
+// ProfileNames returns the sorted list of the sysctl profile names.
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+
+	for name := range profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ProfileParams returns the sysctls of the profile, sorted by key.
+//
+// Keys are prefixed with Sysctl.
+func ProfileParams(name string) ([]*Param, bool) {
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, false
+	}
+
+	params := make([]*Param, 0, len(profile))
+
+	for key, value := range profile {
+		params = append(params, &Param{
+			Key:   Sysctl + "." + key,
+			Value: value,
+		})
+	}
+
+	sort.Slice(params, func(i, j int) bool { return params[i].Key < params[j].Key })
+
+	return params, true
+}
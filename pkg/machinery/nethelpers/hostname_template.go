@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// hostnameHashLength is the length of the hex-encoded hash generated by the `hash` template function.
+const hostnameHashLength = 10
+
+// HostnameTemplateData is the data available to the hostname template.
+type HostnameTemplateData struct {
+	SMBIOS   HostnameTemplateSMBIOS
+	Hardware HostnameTemplateHardware
+	Platform HostnameTemplatePlatform
+}
+
+// HostnameTemplateSMBIOS describes SMBIOS system information available to the hostname template.
+type HostnameTemplateSMBIOS struct {
+	UUID         string
+	SerialNumber string
+	Manufacturer string
+	ProductName  string
+	SKUNumber    string
+}
+
+// HostnameTemplatePlatform describes platform metadata available to the hostname template.
+//
+// Fields are empty if the platform doesn't report the metadata.
+type HostnameTemplatePlatform struct {
+	Name       string
+	InstanceID string
+}
+
+// HostnameTemplateHardware describes hardware information available to the hostname template.
+type HostnameTemplateHardware struct {
+	// MACs is a map of link names to the hardware addresses.
+	MACs map[string]HardwareAddr
+}
+
+// MAC returns the hardware address of the link without separators, so that it can be used in the hostname.
+//
+// Error is returned if the link is not found, so that the hostname is not rendered with a missing part.
+func (hw HostnameTemplateHardware) MAC(link string) (string, error) {
+	addr, ok := hw.MACs[link]
+	if !ok {
+		return "", fmt.Errorf("link %q not found", link)
+	}
+
+	return hex.EncodeToString(addr), nil
+}
+
+// ParseHostnameTemplate parses the hostname template.
+//
+// Besides the data fields, the template might use the following functions:
+//   - `hash` returns a stable short hash of the string, e.g. `{{ hash .SMBIOS.UUID }}`, empty string can't be hashed
+//   - `lower` converts the string to lower case
+func ParseHostnameTemplate(text string) (*template.Template, error) {
+	return template.New("hostname").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"hash":  hostnameHash,
+			"lower": strings.ToLower,
+		}).
+		Parse(text)
+}
+
+// RenderHostnameTemplate renders the hostname template with the data.
+func RenderHostnameTemplate(text string, data *HostnameTemplateData) (string, error) {
+	tmpl, err := ParseHostnameTemplate(text)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+
+	if err = tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(sb.String()), nil
+}
+
+func hostnameHash(s string) (string, error) {
+	// hash of the empty string is the same for all nodes, so it can't be used as the hostname
+	if s == "" {
+		return "", errors.New("hash of an empty string")
+	}
+
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:])[:hostnameHashLength], nil
+}
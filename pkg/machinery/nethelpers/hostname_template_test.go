@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+func TestRenderHostnameTemplate(t *testing.T) {
+	t.Parallel()
+
+	mac, err := net.ParseMAC("68:05:ca:b8:f1:f7")
+	require.NoError(t, err)
+
+	data := &nethelpers.HostnameTemplateData{
+		SMBIOS: nethelpers.HostnameTemplateSMBIOS{
+			UUID:         "4c4c4544-0039-3010-8048-b7c04f384432",
+			SerialNumber: "ABC123",
+		},
+		Hardware: nethelpers.HostnameTemplateHardware{
+			MACs: map[string]nethelpers.HardwareAddr{
+				"eth0": nethelpers.HardwareAddr(mac),
+			},
+		},
+		Platform: nethelpers.HostnameTemplatePlatform{
+			Name:       "upcloud",
+			InstanceID: "00123456-1111-2222-3333-123456789012",
+		},
+	}
+
+	for _, tt := range []struct {
+		name          string
+		template      string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "serial",
+			template: "node-{{ lower .SMBIOS.SerialNumber }}",
+			expected: "node-abc123",
+		},
+		{
+			name:     "hash",
+			template: "talos-{{ hash .SMBIOS.UUID }}",
+			expected: "talos-08dd0d5bdd",
+		},
+		{
+			name:     "mac",
+			template: `talos-{{ .Hardware.MAC "eth0" }}`,
+			expected: "talos-6805cab8f1f7",
+		},
+		{
+			name:          "missing link",
+			template:      `talos-{{ .Hardware.MAC "eth1" }}`,
+			expectedError: `template: hostname:1:18: executing "hostname" at <.Hardware.MAC>: error calling MAC: link "eth1" not found`,
+		},
+		{
+			name:     "instance ID",
+			template: "{{ .Platform.Name }}-{{ hash .Platform.InstanceID }}",
+			expected: "upcloud-7eb3b17942",
+		},
+		{
+			name:          "hash of empty string",
+			template:      "talos-{{ hash .SMBIOS.SKUNumber }}",
+			expectedError: `template: hostname:1:9: executing "hostname" at <hash .SMBIOS.SKUNumber>: error calling hash: hash of an empty string`,
+		},
+		{
+			name:          "unknown field",
+			template:      "{{ .Platform.Region }}",
+			expectedError: `template: hostname:1:12: executing "hostname" at <.Platform.Region>: can't evaluate field Region in type nethelpers.HostnameTemplatePlatform`,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hostname, err := nethelpers.RenderHostnameTemplate(tt.template, data)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, hostname)
+		})
+	}
+}
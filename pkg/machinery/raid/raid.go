@@ -0,0 +1,38 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package raid defines software RAID levels supported by Talos.
+package raid
+
+import "fmt"
+
+// Level is the RAID level.
+type Level string
+
+// RAID levels supported by Talos.
+const (
+	Level0  Level = "raid0"
+	Level1  Level = "raid1"
+	Level10 Level = "raid10"
+)
+
+// ParseLevel parses and validates the RAID level.
+func ParseLevel(level string) (Level, error) {
+	switch l := Level(level); l {
+	case Level0, Level1, Level10:
+		return l, nil
+	default:
+		return "", fmt.Errorf("unsupported RAID level %q", level)
+	}
+}
+
+// MinDevices returns the minimum number of member devices for the RAID level.
+func (l Level) MinDevices() int {
+	switch l {
+	case Level10:
+		return 4
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,34 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package raid_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/raid"
+)
+
+func TestParseLevel(t *testing.T) {
+	for _, tt := range []struct {
+		level      string
+		minDevices int
+	}{
+		{"raid0", 2},
+		{"raid1", 2},
+		{"raid10", 4},
+	} {
+		level, err := raid.ParseLevel(tt.level)
+		require.NoError(t, err)
+
+		assert.Equal(t, raid.Level(tt.level), level)
+		assert.Equal(t, tt.minDevices, level.MinDevices())
+	}
+
+	_, err := raid.ParseLevel("raid5")
+	assert.Error(t, err)
+}
@@ -28,7 +28,9 @@ func TestRegisterResource(t *testing.T) {
 		&cluster.Affiliate{},
 		&cluster.Config{},
 		&cluster.Identity{},
+		&cluster.Info{},
 		&cluster.Member{},
+		&cluster.RemovedMember{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
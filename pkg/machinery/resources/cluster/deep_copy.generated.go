@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AffiliateSpec -type IdentitySpec -type MemberSpec -type ConfigSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AffiliateSpec -type IdentitySpec -type InfoSpec -type MemberSpec -type ConfigSpec -type RemovedMemberSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package cluster
 
@@ -34,6 +34,22 @@ func (o IdentitySpec) DeepCopy() IdentitySpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of InfoSpec.
+func (o InfoSpec) DeepCopy() InfoSpec {
+	var cp InfoSpec = o
+	if o.Conflicts != nil {
+		cp.Conflicts = make([]AffiliateConflict, len(o.Conflicts))
+		copy(cp.Conflicts, o.Conflicts)
+		for i2 := range o.Conflicts {
+			if o.Conflicts[i2].NodeIDs != nil {
+				cp.Conflicts[i2].NodeIDs = make([]string, len(o.Conflicts[i2].NodeIDs))
+				copy(cp.Conflicts[i2].NodeIDs, o.Conflicts[i2].NodeIDs)
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of MemberSpec.
 func (o MemberSpec) DeepCopy() MemberSpec {
 	var cp MemberSpec = o
@@ -53,3 +69,9 @@ func (o ConfigSpec) DeepCopy() ConfigSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of RemovedMemberSpec.
+func (o RemovedMemberSpec) DeepCopy() RemovedMemberSpec {
+	var cp RemovedMemberSpec = o
+	return cp
+}
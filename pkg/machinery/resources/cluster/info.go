@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// InfoType is type of Info resource.
+const InfoType = resource.Type("Infos.cluster.talos.dev")
+
+// InfoID is the resource ID for the cluster discovery info.
+const InfoID = resource.ID("current")
+
+// Info resource holds the state of the cluster discovery.
+type Info = typed.Resource[InfoSpec, InfoRD]
+
+// InfoSpec describes the state of the cluster discovery.
+type InfoSpec struct {
+	// Conflicts lists affiliates claiming the same node name.
+	Conflicts []AffiliateConflict `yaml:"conflicts"`
+}
+
+// AffiliateConflict describes affiliates with different node IDs claiming the same node name.
+type AffiliateConflict struct {
+	Nodename string   `yaml:"nodename"`
+	NodeIDs  []string `yaml:"nodeIds"`
+	// ResolvedNodeID is the node ID of the affiliate which was kept, empty if the conflict can't be resolved.
+	ResolvedNodeID string `yaml:"resolvedNodeId,omitempty"`
+}
+
+// NewInfo initializes a Info resource.
+func NewInfo(namespace resource.Namespace, id resource.ID) *Info {
+	return typed.NewResource[InfoSpec, InfoRD](
+		resource.NewMetadata(namespace, InfoType, id, resource.VersionUndefined),
+		InfoSpec{},
+	)
+}
+
+// InfoRD provides auxiliary methods for Info.
+type InfoRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (c InfoRD) ResourceDefinition(resource.Metadata, InfoSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             InfoType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Conflicts",
+				JSONPath: `{.conflicts[*].nodename}`,
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// RemovedMemberType is type of RemovedMember resource.
+const RemovedMemberType = resource.Type("RemovedMembers.cluster.talos.dev")
+
+// RemovedMember resource blocks the node identity from joining the cluster.
+//
+// RemovedMember resource ID is the node ID for the members removed on this node,
+// and `k8s/<node ID>` for the members pulled from the Kubernetes registry.
+type RemovedMember = typed.Resource[RemovedMemberSpec, RemovedMemberRD]
+
+// RemovedMemberSpec describes the removed cluster member.
+type RemovedMemberSpec struct {
+	NodeID   string `yaml:"nodeId"`
+	Nodename string `yaml:"nodename"`
+	Hostname string `yaml:"hostname"`
+}
+
+// NewRemovedMember initializes a RemovedMember resource.
+func NewRemovedMember(namespace resource.Namespace, id resource.ID) *RemovedMember {
+	return typed.NewResource[RemovedMemberSpec, RemovedMemberRD](
+		resource.NewMetadata(namespace, RemovedMemberType, id, resource.VersionUndefined),
+		RemovedMemberSpec{},
+	)
+}
+
+// RemovedMemberRD provides auxiliary methods for RemovedMember.
+type RemovedMemberRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (c RemovedMemberRD) ResourceDefinition(resource.Metadata, RemovedMemberSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RemovedMemberType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Nodename",
+				JSONPath: `{.nodename}`,
+			},
+			{
+				Name:     "Hostname",
+				JSONPath: `{.hostname}`,
+			},
+		},
+	}
+}
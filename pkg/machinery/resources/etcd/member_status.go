@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+//nolint:lll
+//go:generate deep-copy -type MemberStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+
+// MemberStatusType is type of MemberStatus resource.
+const MemberStatusType = resource.Type("MemberStatuses.etcd.talos.dev")
+
+// LocalMemberStatusID is the ID of the MemberStatus resource for the local etcd member.
+const LocalMemberStatusID = resource.ID("local")
+
+// MemberStatus resource holds the status of the local etcd member.
+type MemberStatus = typed.Resource[MemberStatusSpec, MemberStatusRD]
+
+// MemberStatusSpec describes the status of the etcd member.
+type MemberStatusSpec struct {
+	MemberID    string   `yaml:"memberID"`
+	LeaderID    string   `yaml:"leaderID"`
+	IsLeader    bool     `yaml:"isLeader"`
+	IsLearner   bool     `yaml:"isLearner"`
+	Version     string   `yaml:"version"`
+	DBSize      int64    `yaml:"dbSize"`
+	DBSizeInUse int64    `yaml:"dbSizeInUse"`
+	RaftIndex   uint64   `yaml:"raftIndex"`
+	RaftTerm    uint64   `yaml:"raftTerm"`
+	Errors      []string `yaml:"errors,omitempty"`
+}
+
+// NewMemberStatus initializes a MemberStatus resource.
+func NewMemberStatus(namespace resource.Namespace, id resource.ID) *MemberStatus {
+	return typed.NewResource[MemberStatusSpec, MemberStatusRD](
+		resource.NewMetadata(namespace, MemberStatusType, id, resource.VersionUndefined),
+		MemberStatusSpec{},
+	)
+}
+
+// MemberStatusRD provides auxiliary methods for MemberStatus.
+type MemberStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (MemberStatusRD) ResourceDefinition(resource.Metadata, MemberStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MemberStatusType,
+		Aliases:          []resource.Type{"etcdmember", "etcdmembers"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Member",
+				JSONPath: "{.memberID}",
+			},
+			{
+				Name:     "Leader",
+				JSONPath: "{.isLeader}",
+			},
+			{
+				Name:     "Raft Index",
+				JSONPath: "{.raftIndex}",
+			},
+			{
+				Name:     "DB Size",
+				JSONPath: "{.dbSize}",
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// APIServerLoadBalancerConfigType is type of APIServerLoadBalancerConfig resource.
+const APIServerLoadBalancerConfigType = resource.Type("APIServerLoadBalancerConfigs.kubernetes.talos.dev")
+
+// APIServerLoadBalancerConfigID is a singleton resource ID for APIServerLoadBalancerConfig.
+const APIServerLoadBalancerConfigID = resource.ID("apiserver-lb")
+
+// APIServerLoadBalancerConfig resource holds configuration of the node-local Kubernetes API server load balancer.
+type APIServerLoadBalancerConfig = typed.Resource[APIServerLoadBalancerConfigSpec, APIServerLoadBalancerConfigRD]
+
+// APIServerLoadBalancerConfigSpec describes the node-local Kubernetes API server load balancer.
+type APIServerLoadBalancerConfigSpec struct {
+	Host      string   `yaml:"host"`
+	Port      int      `yaml:"port"`
+	Upstreams []string `yaml:"upstreams"`
+}
+
+// NewAPIServerLoadBalancerConfig initializes an APIServerLoadBalancerConfig resource.
+func NewAPIServerLoadBalancerConfig(namespace resource.Namespace, id resource.ID) *APIServerLoadBalancerConfig {
+	return typed.NewResource[APIServerLoadBalancerConfigSpec, APIServerLoadBalancerConfigRD](
+		resource.NewMetadata(namespace, APIServerLoadBalancerConfigType, id, resource.VersionUndefined),
+		APIServerLoadBalancerConfigSpec{},
+	)
+}
+
+// APIServerLoadBalancerConfigRD provides auxiliary methods for APIServerLoadBalancerConfig.
+type APIServerLoadBalancerConfigRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (APIServerLoadBalancerConfigRD) ResourceDefinition(resource.Metadata, APIServerLoadBalancerConfigSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             APIServerLoadBalancerConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Host",
+				JSONPath: "{.host}",
+			},
+			{
+				Name:     "Port",
+				JSONPath: "{.port}",
+			},
+			{
+				Name:     "Upstreams",
+				JSONPath: "{.upstreams}",
+			},
+		},
+	}
+}
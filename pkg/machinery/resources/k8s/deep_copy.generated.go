@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type BootstrapManifestsConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type APIServerLoadBalancerConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type BootstrapManifestsConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodenameSpec -type NodeStatusSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -59,6 +59,16 @@ func (o APIServerConfigSpec) DeepCopy() APIServerConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of APIServerLoadBalancerConfigSpec.
+func (o APIServerLoadBalancerConfigSpec) DeepCopy() APIServerLoadBalancerConfigSpec {
+	var cp APIServerLoadBalancerConfigSpec = o
+	if o.Upstreams != nil {
+		cp.Upstreams = make([]string, len(o.Upstreams))
+		copy(cp.Upstreams, o.Upstreams)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of ConfigStatusSpec.
 func (o ConfigStatusSpec) DeepCopy() ConfigStatusSpec {
 	var cp ConfigStatusSpec = o
@@ -194,6 +204,34 @@ func (o BootstrapManifestsConfigSpec) DeepCopy() BootstrapManifestsConfigSpec {
 		cp.ProxyArgs = make([]string, len(o.ProxyArgs))
 		copy(cp.ProxyArgs, o.ProxyArgs)
 	}
+	if o.CoreDNSUpstreams != nil {
+		cp.CoreDNSUpstreams = make([]string, len(o.CoreDNSUpstreams))
+		copy(cp.CoreDNSUpstreams, o.CoreDNSUpstreams)
+	}
+	if o.CoreDNSStubDomains != nil {
+		cp.CoreDNSStubDomains = make([]CoreDNSStubDomain, len(o.CoreDNSStubDomains))
+		copy(cp.CoreDNSStubDomains, o.CoreDNSStubDomains)
+		for i2 := range o.CoreDNSStubDomains {
+			if o.CoreDNSStubDomains[i2].Servers != nil {
+				cp.CoreDNSStubDomains[i2].Servers = make([]string, len(o.CoreDNSStubDomains[i2].Servers))
+				copy(cp.CoreDNSStubDomains[i2].Servers, o.CoreDNSStubDomains[i2].Servers)
+			}
+		}
+	}
+	if o.CoreDNSHosts != nil {
+		cp.CoreDNSHosts = make([]CoreDNSHost, len(o.CoreDNSHosts))
+		copy(cp.CoreDNSHosts, o.CoreDNSHosts)
+		for i2 := range o.CoreDNSHosts {
+			if o.CoreDNSHosts[i2].Hostnames != nil {
+				cp.CoreDNSHosts[i2].Hostnames = make([]string, len(o.CoreDNSHosts[i2].Hostnames))
+				copy(cp.CoreDNSHosts[i2].Hostnames, o.CoreDNSHosts[i2].Hostnames)
+			}
+		}
+	}
+	if o.CoreDNSExtraServerBlocks != nil {
+		cp.CoreDNSExtraServerBlocks = make([]string, len(o.CoreDNSExtraServerBlocks))
+		copy(cp.CoreDNSExtraServerBlocks, o.CoreDNSExtraServerBlocks)
+	}
 	return cp
 }
 
@@ -227,6 +265,18 @@ func (o NodenameSpec) DeepCopy() NodenameSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NodeStatusSpec.
+func (o NodeStatusSpec) DeepCopy() NodeStatusSpec {
+	var cp NodeStatusSpec = o
+	if o.Labels != nil {
+		cp.Labels = make(map[string]string, len(o.Labels))
+		for k2, v2 := range o.Labels {
+			cp.Labels[k2] = v2
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of SchedulerConfigSpec.
 func (o SchedulerConfigSpec) DeepCopy() SchedulerConfigSpec {
 	var cp SchedulerConfigSpec = o
@@ -27,6 +27,7 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []resource.Resource{
 		&k8s.AdmissionControlConfig{},
 		&k8s.APIServerConfig{},
+		&k8s.APIServerLoadBalancerConfig{},
 		&k8s.ConfigStatus{},
 		&k8s.ControllerManagerConfig{},
 		&k8s.Endpoint{},
@@ -40,6 +41,7 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.Nodename{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
+		&k8s.NodeStatus{},
 		&k8s.SchedulerConfig{},
 		&k8s.SecretsStatus{},
 		&k8s.StaticPodStatus{},
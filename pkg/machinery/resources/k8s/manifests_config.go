@@ -36,6 +36,18 @@ type BootstrapManifestsConfigSpec struct {
 	CoreDNSEnabled bool   `yaml:"coreDNSEnabled"`
 	CoreDNSImage   string `yaml:"coreDNSImage"`
 
+	CoreDNSUpstreams         []string            `yaml:"coreDNSUpstreams"`
+	CoreDNSStubDomains       []CoreDNSStubDomain `yaml:"coreDNSStubDomains"`
+	CoreDNSCacheTTL          int                 `yaml:"coreDNSCacheTTL"`
+	CoreDNSCacheSuccess      int                 `yaml:"coreDNSCacheSuccess"`
+	CoreDNSCacheDenial       int                 `yaml:"coreDNSCacheDenial"`
+	CoreDNSHosts             []CoreDNSHost       `yaml:"coreDNSHosts"`
+	CoreDNSExtraServerBlocks []string            `yaml:"coreDNSExtraServerBlocks"`
+
+	NodeLocalDNSEnabled bool   `yaml:"nodeLocalDNSEnabled"`
+	NodeLocalDNSImage   string `yaml:"nodeLocalDNSImage"`
+	NodeLocalDNSIP      string `yaml:"nodeLocalDNSIP"`
+
 	DNSServiceIP   string `yaml:"dnsServiceIP"`
 	DNSServiceIPv6 string `yaml:"dnsServiceIPv6"`
 
@@ -44,6 +56,27 @@ type BootstrapManifestsConfigSpec struct {
 	FlannelCNIImage string `yaml:"flannelCNIImage"`
 
 	PodSecurityPolicyEnabled bool `yaml:"podSecurityPolicyEnabled"`
+
+	ClusterName string `yaml:"clusterName"`
+
+	CloudProviderName             string `yaml:"cloudProviderName"`
+	CloudProviderImage            string `yaml:"cloudProviderImage"`
+	CloudProviderNodeManagerImage string `yaml:"cloudProviderNodeManagerImage"`
+	CloudProviderConfig           string `yaml:"cloudProviderConfig"`
+
+	TalosAPIServiceAccountCRDEnabled bool `yaml:"talosAPIServiceAccountCRDEnabled"`
+}
+
+// CoreDNSStubDomain describes CoreDNS stub domain.
+type CoreDNSStubDomain struct {
+	Domain  string   `yaml:"domain"`
+	Servers []string `yaml:"servers"`
+}
+
+// CoreDNSHost describes CoreDNS static host entry.
+type CoreDNSHost struct {
+	IP        string   `yaml:"ip"`
+	Hostnames []string `yaml:"hostnames"`
 }
 
 // NewBootstrapManifestsConfig returns new BootstrapManifestsConfig resource.
@@ -61,5 +94,6 @@ func (BootstrapManifestsConfigRD) ResourceDefinition(_ resource.Metadata, _ Boot
 	return meta.ResourceDefinitionSpec{
 		Type:             BootstrapManifestsConfigType,
 		DefaultNamespace: ControlPlaneNamespaceName,
+		Sensitivity:      meta.Sensitive,
 	}
 }
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// NodeStatusType is type of NodeStatus resource.
+const NodeStatusType = resource.Type("NodeStatuses.kubernetes.talos.dev")
+
+// NodeStatus resource holds Kubernetes NodeStatus.
+type NodeStatus = typed.Resource[NodeStatusSpec, NodeStatusRD]
+
+// NodeStatusSpec describes Kubernetes NodeStatus.
+type NodeStatusSpec struct {
+	Nodename       string            `yaml:"nodename"`
+	NodeReady      bool              `yaml:"nodeReady"`
+	Unschedulable  bool              `yaml:"unschedulable"`
+	KubeletVersion string            `yaml:"kubeletVersion"`
+	Labels         map[string]string `yaml:"labels"`
+}
+
+// NewNodeStatus initializes a NodeStatus resource.
+func NewNodeStatus(namespace resource.Namespace, id resource.ID) *NodeStatus {
+	return typed.NewResource[NodeStatusSpec, NodeStatusRD](
+		resource.NewMetadata(namespace, NodeStatusType, id, resource.VersionUndefined),
+		NodeStatusSpec{},
+	)
+}
+
+// NodeStatusRD provides auxiliary methods for NodeStatus.
+type NodeStatusRD struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (NodeStatusRD) ResourceDefinition(resource.Metadata, NodeStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeStatusType,
+		Aliases:          []resource.Type{"nodestatus"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Ready",
+				JSONPath: "{.nodeReady}",
+			},
+			{
+				Name:     "Unschedulable",
+				JSONPath: "{.unschedulable}",
+			},
+		},
+	}
+}
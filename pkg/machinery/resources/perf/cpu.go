@@ -11,7 +11,7 @@ import (
 )
 
 //nolint:lll
-//go:generate deep-copy -type CPUSpec -type MemorySpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type CPUSpec -type DiskIOSpec -type MemorySpec -type NetIOSpec -type PressureSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
 // CPUType is type of Etcd resource.
 const CPUType = resource.Type("CPUStats.perf.talos.dev")
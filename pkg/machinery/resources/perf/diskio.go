@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package perf
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// DiskIOType is type of DiskIO resource.
+const DiskIOType = resource.Type("DiskIOStats.perf.talos.dev")
+
+// DiskIOID is a resource ID of singleton instance.
+const DiskIOID = resource.ID("latest")
+
+// DiskIO represents the last disk I/O stats snapshot.
+type DiskIO = typed.Resource[DiskIOSpec, DiskIORD]
+
+// DiskIOSpec represents the last disk I/O stats snapshot.
+type DiskIOSpec struct {
+	Devices []DiskIOStat `yaml:"devices"`
+	// Total is the sum of the stats of the physical disks, partitions and virtual devices are not included.
+	Total DiskIOStat `yaml:"total"`
+}
+
+// DiskIOStat represents I/O stats of a single block device.
+type DiskIOStat struct {
+	Name             string `yaml:"name,omitempty"`
+	ReadCompleted    uint64 `yaml:"readCompleted"`
+	ReadMerged       uint64 `yaml:"readMerged"`
+	ReadSectors      uint64 `yaml:"readSectors"`
+	ReadTimeMs       uint64 `yaml:"readTimeMs"`
+	WriteCompleted   uint64 `yaml:"writeCompleted"`
+	WriteMerged      uint64 `yaml:"writeMerged"`
+	WriteSectors     uint64 `yaml:"writeSectors"`
+	WriteTimeMs      uint64 `yaml:"writeTimeMs"`
+	IOInProgress     uint64 `yaml:"ioInProgress"`
+	IOTimeMs         uint64 `yaml:"ioTimeMs"`
+	IOTimeWeightedMs uint64 `yaml:"ioTimeWeightedMs"`
+	DiscardCompleted uint64 `yaml:"discardCompleted"`
+	DiscardMerged    uint64 `yaml:"discardMerged"`
+	DiscardSectors   uint64 `yaml:"discardSectors"`
+	DiscardTimeMs    uint64 `yaml:"discardTimeMs"`
+}
+
+// NewDiskIO creates new default DiskIO stats object.
+func NewDiskIO() *DiskIO {
+	return typed.NewResource[DiskIOSpec, DiskIORD](
+		resource.NewMetadata(NamespaceName, DiskIOType, DiskIOID, resource.VersionUndefined),
+		DiskIOSpec{},
+	)
+}
+
+// DiskIORD is an auxiliary type for DiskIO resource.
+type DiskIORD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (DiskIORD) ResourceDefinition(resource.Metadata, DiskIOSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiskIOType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Read Sectors",
+				JSONPath: "{.total.readSectors}",
+			},
+			{
+				Name:     "Write Sectors",
+				JSONPath: "{.total.writeSectors}",
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package perf
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// NetIOType is type of NetIO resource.
+const NetIOType = resource.Type("NetIOStats.perf.talos.dev")
+
+// NetIOID is a resource ID of singleton instance.
+const NetIOID = resource.ID("latest")
+
+// NetIO represents the last network device stats snapshot.
+type NetIO = typed.Resource[NetIOSpec, NetIORD]
+
+// NetIOSpec represents the last network device stats snapshot.
+type NetIOSpec struct {
+	Devices []NetIOStat `yaml:"devices"`
+	Total   NetIOStat   `yaml:"total"`
+}
+
+// NetIOStat represents stats of a single network device.
+type NetIOStat struct {
+	Name         string `yaml:"name,omitempty"`
+	RxBytes      uint64 `yaml:"rxBytes"`
+	RxPackets    uint64 `yaml:"rxPackets"`
+	RxErrors     uint64 `yaml:"rxErrors"`
+	RxDropped    uint64 `yaml:"rxDropped"`
+	RxFIFO       uint64 `yaml:"rxFifo"`
+	RxFrame      uint64 `yaml:"rxFrame"`
+	RxCompressed uint64 `yaml:"rxCompressed"`
+	RxMulticast  uint64 `yaml:"rxMulticast"`
+	TxBytes      uint64 `yaml:"txBytes"`
+	TxPackets    uint64 `yaml:"txPackets"`
+	TxErrors     uint64 `yaml:"txErrors"`
+	TxDropped    uint64 `yaml:"txDropped"`
+	TxFIFO       uint64 `yaml:"txFifo"`
+	TxCollisions uint64 `yaml:"txCollisions"`
+	TxCarrier    uint64 `yaml:"txCarrier"`
+	TxCompressed uint64 `yaml:"txCompressed"`
+}
+
+// NewNetIO creates new default NetIO stats object.
+func NewNetIO() *NetIO {
+	return typed.NewResource[NetIOSpec, NetIORD](
+		resource.NewMetadata(NamespaceName, NetIOType, NetIOID, resource.VersionUndefined),
+		NetIOSpec{},
+	)
+}
+
+// NetIORD is an auxiliary type for NetIO resource.
+type NetIORD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (NetIORD) ResourceDefinition(resource.Metadata, NetIOSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NetIOType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Rx Bytes",
+				JSONPath: "{.total.rxBytes}",
+			},
+			{
+				Name:     "Tx Bytes",
+				JSONPath: "{.total.txBytes}",
+			},
+		},
+	}
+}
@@ -27,6 +27,9 @@ func TestRegisterResource(t *testing.T) {
 	for _, resource := range []resource.Resource{
 		&perf.Memory{},
 		&perf.CPU{},
+		&perf.DiskIO{},
+		&perf.NetIO{},
+		&perf.Pressure{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
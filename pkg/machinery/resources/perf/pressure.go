@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package perf
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// PressureType is type of Pressure resource.
+const PressureType = resource.Type("PressureStats.perf.talos.dev")
+
+// PressureID is a resource ID of singleton instance.
+const PressureID = resource.ID("latest")
+
+// Pressure represents the last pressure stall information (PSI) snapshot.
+type Pressure = typed.Resource[PressureSpec, PressureRD]
+
+// PressureSpec represents the last pressure stall information (PSI) snapshot.
+type PressureSpec struct {
+	CPU    PressureStat `yaml:"cpu"`
+	Memory PressureStat `yaml:"memory"`
+	IO     PressureStat `yaml:"io"`
+}
+
+// PressureStat represents pressure stall information of a single resource.
+//
+// Some is the share of time some tasks were stalled on the resource, Full is the share of time
+// all non-idle tasks were stalled simultaneously.
+type PressureStat struct {
+	Some PressureLine `yaml:"some"`
+	Full PressureLine `yaml:"full"`
+}
+
+// PressureLine represents averages of the stall time share over 10, 60 and 300 seconds (in percent),
+// and the total stall time (in microseconds).
+type PressureLine struct {
+	Avg10  float64 `yaml:"avg10"`
+	Avg60  float64 `yaml:"avg60"`
+	Avg300 float64 `yaml:"avg300"`
+	Total  uint64  `yaml:"total"`
+}
+
+// NewPressure creates new default Pressure stats object.
+func NewPressure() *Pressure {
+	return typed.NewResource[PressureSpec, PressureRD](
+		resource.NewMetadata(NamespaceName, PressureType, PressureID, resource.VersionUndefined),
+		PressureSpec{},
+	)
+}
+
+// PressureRD is an auxiliary type for Pressure resource.
+type PressureRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (PressureRD) ResourceDefinition(resource.Metadata, PressureSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PressureType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "CPU",
+				JSONPath: "{.cpu.some.avg10}",
+			},
+			{
+				Name:     "Memory",
+				JSONPath: "{.memory.some.avg10}",
+			},
+			{
+				Name:     "IO",
+				JSONPath: "{.io.some.avg10}",
+			},
+		},
+	}
+}
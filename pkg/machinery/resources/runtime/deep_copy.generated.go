@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type MountStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type LogicalVolumeStatusSpec -type MountStatusSpec -type PlatformMetadataSpec -type RAIDArrayStatusSpec -type UdevRuleSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -24,6 +24,12 @@ func (o KernelParamStatusSpec) DeepCopy() KernelParamStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LogicalVolumeStatusSpec.
+func (o LogicalVolumeStatusSpec) DeepCopy() LogicalVolumeStatusSpec {
+	var cp LogicalVolumeStatusSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of MountStatusSpec.
 func (o MountStatusSpec) DeepCopy() MountStatusSpec {
 	var cp MountStatusSpec = o
@@ -33,3 +39,33 @@ func (o MountStatusSpec) DeepCopy() MountStatusSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of PlatformMetadataSpec.
+func (o PlatformMetadataSpec) DeepCopy() PlatformMetadataSpec {
+	var cp PlatformMetadataSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of RAIDArrayStatusSpec.
+func (o RAIDArrayStatusSpec) DeepCopy() RAIDArrayStatusSpec {
+	var cp RAIDArrayStatusSpec = o
+	if o.Devices != nil {
+		cp.Devices = make([]string, len(o.Devices))
+		copy(cp.Devices, o.Devices)
+	}
+	if o.FailedDevices != nil {
+		cp.FailedDevices = make([]string, len(o.FailedDevices))
+		copy(cp.FailedDevices, o.FailedDevices)
+	}
+	if o.SpareDevices != nil {
+		cp.SpareDevices = make([]string, len(o.SpareDevices))
+		copy(cp.SpareDevices, o.SpareDevices)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of UdevRuleSpec.
+func (o UdevRuleSpec) DeepCopy() UdevRuleSpec {
+	var cp UdevRuleSpec = o
+	return cp
+}
@@ -21,6 +21,8 @@ type KernelParamStatusSpec struct {
 	Current     string `yaml:"current"`
 	Default     string `yaml:"default"`
 	Unsupported bool   `yaml:"unsupported"`
+	// Conflict describes the Talos default or KSPP required value overridden by the machine config.
+	Conflict string `yaml:"conflict,omitempty"`
 }
 
 // NewKernelParamStatus initializes a KernelParamStatus resource.
@@ -53,6 +55,10 @@ func (KernelParamStatusRD) ResourceDefinition(resource.Metadata, KernelParamStat
 				Name:     "Unsupported",
 				JSONPath: `{.unsupported}`,
 			},
+			{
+				Name:     "Conflict",
+				JSONPath: `{.conflict}`,
+			},
 		},
 	}
 }
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// LogicalVolumeStatusType is type of LogicalVolumeStatus resource.
+const LogicalVolumeStatusType = resource.Type("LogicalVolumeStatuses.runtime.talos.dev")
+
+// LogicalVolumeStatus resource holds the status of the LVM logical volume defined in the machine config.
+type LogicalVolumeStatus = typed.Resource[LogicalVolumeStatusSpec, LogicalVolumeStatusRD]
+
+// LogicalVolumeStatusSpec describes the status of the LVM logical volume.
+type LogicalVolumeStatusSpec struct {
+	VolumeGroup string `yaml:"volumeGroup"`
+	Name        string `yaml:"name"`
+	Device      string `yaml:"device"`
+	Size        uint64 `yaml:"size"`
+	Filesystem  string `yaml:"filesystem"`
+	MountPoint  string `yaml:"mountPoint,omitempty"`
+	Mounted     bool   `yaml:"mounted"`
+}
+
+// NewLogicalVolumeStatus initializes a LogicalVolumeStatus resource.
+func NewLogicalVolumeStatus(namespace resource.Namespace, id resource.ID) *LogicalVolumeStatus {
+	return typed.NewResource[LogicalVolumeStatusSpec, LogicalVolumeStatusRD](
+		resource.NewMetadata(namespace, LogicalVolumeStatusType, id, resource.VersionUndefined),
+		LogicalVolumeStatusSpec{},
+	)
+}
+
+// LogicalVolumeStatusRD is auxiliary resource data for LogicalVolumeStatus.
+type LogicalVolumeStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (LogicalVolumeStatusRD) ResourceDefinition(resource.Metadata, LogicalVolumeStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LogicalVolumeStatusType,
+		Aliases:          []resource.Type{"logicalvolumes", "lvs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Device",
+				JSONPath: `{.device}`,
+			},
+			{
+				Name:     "Size",
+				JSONPath: `{.size}`,
+			},
+			{
+				Name:     "Mount Point",
+				JSONPath: `{.mountPoint}`,
+			},
+			{
+				Name:     "Mounted",
+				JSONPath: `{.mounted}`,
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// PlatformMetadataType is type of PlatformMetadata resource.
+const PlatformMetadataType = resource.Type("PlatformMetadatas.talos.dev")
+
+// PlatformMetadataID is the ID of PlatformMetadata resource.
+const PlatformMetadataID = resource.ID("platformmetadata")
+
+// PlatformMetadata resource holds the metadata reported by the platform.
+type PlatformMetadata = typed.Resource[PlatformMetadataSpec, PlatformMetadataRD]
+
+// PlatformMetadataSpec describes platform metadata properties.
+type PlatformMetadataSpec struct {
+	Platform   string `yaml:"platform"`
+	InstanceID string `yaml:"instanceId,omitempty"`
+}
+
+// NewPlatformMetadata initializes a PlatformMetadata resource.
+func NewPlatformMetadata(namespace resource.Namespace, id resource.ID) *PlatformMetadata {
+	return typed.NewResource[PlatformMetadataSpec, PlatformMetadataRD](
+		resource.NewMetadata(namespace, PlatformMetadataType, id, resource.VersionUndefined),
+		PlatformMetadataSpec{},
+	)
+}
+
+// PlatformMetadataRD is auxiliary resource data for PlatformMetadata.
+type PlatformMetadataRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (PlatformMetadataRD) ResourceDefinition(resource.Metadata, PlatformMetadataSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PlatformMetadataType,
+		Aliases:          []resource.Type{"platformmetadata"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Platform",
+				JSONPath: "{.platform}",
+			},
+			{
+				Name:     "Instance ID",
+				JSONPath: "{.instanceId}",
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// RAIDArrayStatusType is type of RAIDArrayStatus resource.
+const RAIDArrayStatusType = resource.Type("RAIDArrayStatuses.runtime.talos.dev")
+
+// RAIDArrayStatus resource holds the status of the software RAID array.
+type RAIDArrayStatus = typed.Resource[RAIDArrayStatusSpec, RAIDArrayStatusRD]
+
+// RAIDArrayStatusSpec describes the status of the software RAID array.
+type RAIDArrayStatusSpec struct {
+	Name          string   `yaml:"name,omitempty"`
+	Device        string   `yaml:"device"`
+	Level         string   `yaml:"level"`
+	State         string   `yaml:"state"`
+	Devices       []string `yaml:"devices"`
+	FailedDevices []string `yaml:"failedDevices,omitempty"`
+	SpareDevices  []string `yaml:"spareDevices,omitempty"`
+	TotalDevices  int      `yaml:"totalDevices"`
+	ActiveDevices int      `yaml:"activeDevices"`
+	SyncAction    string   `yaml:"syncAction,omitempty"`
+	SyncProgress  float64  `yaml:"syncProgress,omitempty"`
+}
+
+// NewRAIDArrayStatus initializes a RAIDArrayStatus resource.
+func NewRAIDArrayStatus(namespace resource.Namespace, id resource.ID) *RAIDArrayStatus {
+	return typed.NewResource[RAIDArrayStatusSpec, RAIDArrayStatusRD](
+		resource.NewMetadata(namespace, RAIDArrayStatusType, id, resource.VersionUndefined),
+		RAIDArrayStatusSpec{},
+	)
+}
+
+// RAIDArrayStatusRD is auxiliary resource data for RAIDArrayStatus.
+type RAIDArrayStatusRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (RAIDArrayStatusRD) ResourceDefinition(resource.Metadata, RAIDArrayStatusSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RAIDArrayStatusType,
+		Aliases:          []resource.Type{"raidarrays", "raid"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Name",
+				JSONPath: `{.name}`,
+			},
+			{
+				Name:     "Level",
+				JSONPath: `{.level}`,
+			},
+			{
+				Name:     "State",
+				JSONPath: `{.state}`,
+			},
+			{
+				Name:     "Active",
+				JSONPath: `{.activeDevices}`,
+			},
+			{
+				Name:     "Total",
+				JSONPath: `{.totalDevices}`,
+			},
+		},
+	}
+}
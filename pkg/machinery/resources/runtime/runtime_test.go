@@ -29,7 +29,11 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.KernelModuleSpec{},
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamStatus{},
+		&runtime.LogicalVolumeStatus{},
 		&runtime.MountStatus{},
+		&runtime.PlatformMetadata{},
+		&runtime.RAIDArrayStatus{},
+		&runtime.UdevRule{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
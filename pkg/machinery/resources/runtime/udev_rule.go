@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// UdevRuleType is type of UdevRule resource.
+const UdevRuleType = resource.Type("UdevRules.runtime.talos.dev")
+
+// UdevRule resource holds a udev rule to apply.
+//
+// Resource ID defines the order of the rules in the rules file.
+type UdevRule = typed.Resource[UdevRuleSpec, UdevRuleRD]
+
+// UdevRuleSpec describes a udev rule.
+type UdevRuleSpec struct {
+	Rule string `yaml:"rule"`
+}
+
+// NewUdevRule initializes a UdevRule resource.
+func NewUdevRule(namespace resource.Namespace, id resource.ID) *UdevRule {
+	return typed.NewResource[UdevRuleSpec, UdevRuleRD](
+		resource.NewMetadata(namespace, UdevRuleType, id, resource.VersionUndefined),
+		UdevRuleSpec{},
+	)
+}
+
+// UdevRuleRD is auxiliary resource data for UdevRule.
+type UdevRuleRD struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (UdevRuleRD) ResourceDefinition(resource.Metadata, UdevRuleSpec) meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             UdevRuleType,
+		Aliases:          []resource.Type{"udevrule", "udevrules"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Rule",
+				JSONPath: "{.rule}",
+			},
+		},
+	}
+}
@@ -27,6 +27,10 @@ const (
 	// EtcdBackup defines Talos role that allows making etcd backups.
 	EtcdBackup = Role(Prefix + "etcd:backup")
 
+	// KubeconfigIssuer defines Talos role that allows issuing Kubernetes client configuration (kubeconfig)
+	// for any Kubernetes user and groups.
+	KubeconfigIssuer = Role(Prefix + "kubeconfig:issuer")
+
 	// Impersonator defines Talos role for impersonating another user (and their role).
 	// Used internally, but may also be granted to the user.
 	Impersonator = Role(Prefix + "impersonator")
@@ -39,7 +43,7 @@ type Set struct {
 
 var (
 	// All roles that can be granted to users.
-	All = MakeSet(Admin, Reader, EtcdBackup, Impersonator)
+	All = MakeSet(Admin, Reader, EtcdBackup, KubeconfigIssuer, Impersonator)
 
 	// Zero is an empty set of roles.
 	Zero = MakeSet()
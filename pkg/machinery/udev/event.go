@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package udev
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// Netlink multicast groups of the NETLINK_KOBJECT_UEVENT socket.
+const (
+	// KernelGroup receives events as sent by the kernel.
+	KernelGroup = 1
+	// UdevGroup receives events after they were processed by udevd.
+	UdevGroup = 2
+)
+
+const (
+	monitorPrefix = "libudev\x00"
+	monitorMagic  = 0xfeedcafe
+	// prefix, magic, header_size, properties_off, properties_len.
+	monitorHeaderMinSize = 8 + 4*4
+)
+
+// Event is a device event received from the NETLINK_KOBJECT_UEVENT socket.
+type Event struct {
+	Action     string
+	DevPath    string
+	Subsystem  string
+	DevType    string
+	DevName    string
+	Properties map[string]string
+}
+
+// ParseEvent parses the device event message.
+//
+// Both kernel (`action@devpath` header) and udevd (`libudev` header) message formats are supported.
+func ParseEvent(msg []byte) (*Event, error) {
+	var properties []byte
+
+	switch {
+	case bytes.HasPrefix(msg, []byte(monitorPrefix)):
+		if len(msg) < monitorHeaderMinSize {
+			return nil, fmt.Errorf("message is too short: %d bytes", len(msg))
+		}
+
+		if magic := binary.BigEndian.Uint32(msg[8:12]); magic != monitorMagic {
+			return nil, fmt.Errorf("unexpected magic %#x", magic)
+		}
+
+		// the rest of the header is in host byte order, Talos only supports little-endian architectures
+		propertiesOffset := binary.LittleEndian.Uint32(msg[16:20])
+		propertiesLength := binary.LittleEndian.Uint32(msg[20:24])
+
+		if uint64(propertiesOffset)+uint64(propertiesLength) > uint64(len(msg)) {
+			return nil, fmt.Errorf("properties are out of bounds")
+		}
+
+		properties = msg[propertiesOffset : propertiesOffset+propertiesLength]
+	default:
+		idx := bytes.IndexByte(msg, 0)
+		if idx == -1 || !bytes.Contains(msg[:idx], []byte("@")) {
+			return nil, fmt.Errorf("unexpected message header")
+		}
+
+		properties = msg[idx+1:]
+	}
+
+	event := &Event{
+		Properties: map[string]string{},
+	}
+
+	for _, property := range bytes.Split(properties, []byte{0}) {
+		if len(property) == 0 {
+			continue
+		}
+
+		key, value, ok := bytes.Cut(property, []byte("="))
+		if !ok {
+			continue
+		}
+
+		event.Properties[string(key)] = string(value)
+	}
+
+	event.Action = event.Properties["ACTION"]
+	event.DevPath = event.Properties["DEVPATH"]
+	event.Subsystem = event.Properties["SUBSYSTEM"]
+	event.DevType = event.Properties["DEVTYPE"]
+	event.DevName = event.Properties["DEVNAME"]
+
+	if event.Action == "" || event.DevPath == "" {
+		return nil, fmt.Errorf("event is missing ACTION or DEVPATH")
+	}
+
+	return event, nil
+}
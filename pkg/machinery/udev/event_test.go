@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package udev_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/udev"
+)
+
+const properties = "ACTION=add\x00DEVPATH=/devices/virtual/block/loop0\x00SUBSYSTEM=block\x00DEVNAME=/dev/loop0\x00DEVTYPE=disk\x00SEQNUM=1234\x00"
+
+func TestParseEventKernel(t *testing.T) {
+	event, err := udev.ParseEvent([]byte("add@/devices/virtual/block/loop0\x00" + properties))
+	require.NoError(t, err)
+
+	assert.Equal(t, "add", event.Action)
+	assert.Equal(t, "/devices/virtual/block/loop0", event.DevPath)
+	assert.Equal(t, "block", event.Subsystem)
+	assert.Equal(t, "disk", event.DevType)
+	assert.Equal(t, "/dev/loop0", event.DevName)
+	assert.Equal(t, "1234", event.Properties["SEQNUM"])
+}
+
+func TestParseEventUdev(t *testing.T) {
+	header := make([]byte, 40)
+
+	copy(header, "libudev\x00")
+	binary.BigEndian.PutUint32(header[8:], 0xfeedcafe)
+	binary.LittleEndian.PutUint32(header[12:], uint32(len(header)))
+	binary.LittleEndian.PutUint32(header[16:], uint32(len(header)))
+	binary.LittleEndian.PutUint32(header[20:], uint32(len(properties)))
+
+	event, err := udev.ParseEvent(append(header, properties...))
+	require.NoError(t, err)
+
+	assert.Equal(t, "add", event.Action)
+	assert.Equal(t, "/devices/virtual/block/loop0", event.DevPath)
+	assert.Equal(t, "block", event.Subsystem)
+
+	binary.BigEndian.PutUint32(header[8:], 0xcafe)
+
+	_, err = udev.ParseEvent(append(header, properties...))
+	assert.EqualError(t, err, "unexpected magic 0xcafe")
+
+	binary.BigEndian.PutUint32(header[8:], 0xfeedcafe)
+	binary.LittleEndian.PutUint32(header[20:], uint32(len(properties)+1))
+
+	_, err = udev.ParseEvent(append(header, properties...))
+	assert.EqualError(t, err, "properties are out of bounds")
+}
+
+func TestParseEventInvalid(t *testing.T) {
+	_, err := udev.ParseEvent([]byte("garbage"))
+	assert.EqualError(t, err, "unexpected message header")
+
+	_, err = udev.ParseEvent([]byte("add@/devices\x00SUBSYSTEM=block\x00"))
+	assert.EqualError(t, err, "event is missing ACTION or DEVPATH")
+}
@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package udev implements parsing of the udev rules.
+package udev
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operators supported in the udev rules.
+const (
+	OpMatch     = "=="
+	OpNotMatch  = "!="
+	OpAssign    = "="
+	OpAdd       = "+="
+	OpRemove    = "-="
+	OpAssignFin = ":="
+)
+
+// Expression is a single `KEY{attr}OP"value"` expression of the udev rule.
+type Expression struct {
+	Key   string
+	Attr  string
+	Op    string
+	Value string
+}
+
+// Rule is a parsed udev rule.
+type Rule []Expression
+
+// Parse the udev rule.
+//
+// Newlines in the rule are treated as line continuations.
+// Comments are not allowed, as the rule is written to the rules file as a single line.
+func Parse(rule string) (Rule, error) {
+	p := parser{
+		input: strings.NewReplacer("\\\n", " ", "\n", " ").Replace(rule),
+	}
+
+	return p.parse()
+}
+
+// Subsystems returns the list of subsystems the rule is limited to.
+//
+// If the rule doesn't match on the subsystem, nil is returned.
+func (rule Rule) Subsystems() []string {
+	for _, expr := range rule {
+		if expr.Key == "SUBSYSTEM" && expr.Op == OpMatch && !strings.ContainsAny(expr.Value, "*?[") {
+			return strings.Split(expr.Value, "|")
+		}
+	}
+
+	return nil
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+//nolint:gocyclo
+func (p *parser) parse() (Rule, error) {
+	var rule Rule
+
+	p.skipSpaces()
+
+	if p.eof() {
+		return nil, fmt.Errorf("rule is empty")
+	}
+
+	if p.input[p.pos] == '#' {
+		return nil, fmt.Errorf("comments are not supported")
+	}
+
+	for !p.eof() {
+		var expr Expression
+
+		start := p.pos
+
+		for !p.eof() && (p.input[p.pos] >= 'A' && p.input[p.pos] <= 'Z' || p.input[p.pos] == '_') {
+			p.pos++
+		}
+
+		if p.pos == start {
+			return nil, p.errorf("expected key")
+		}
+
+		expr.Key = p.input[start:p.pos]
+
+		if !p.eof() && p.input[p.pos] == '{' {
+			end := strings.IndexByte(p.input[p.pos:], '}')
+			if end == -1 {
+				return nil, p.errorf("unterminated attribute of key %q", expr.Key)
+			}
+
+			expr.Attr = p.input[p.pos+1 : p.pos+end]
+
+			if expr.Attr == "" {
+				return nil, p.errorf("empty attribute of key %q", expr.Key)
+			}
+
+			p.pos += end + 1
+		}
+
+		p.skipSpaces()
+
+		for _, op := range []string{OpMatch, OpNotMatch, OpAdd, OpRemove, OpAssignFin, OpAssign} {
+			if strings.HasPrefix(p.input[p.pos:], op) {
+				expr.Op = op
+
+				break
+			}
+		}
+
+		if expr.Op == "" {
+			return nil, p.errorf("expected operator after key %q", expr.Key)
+		}
+
+		p.pos += len(expr.Op)
+
+		p.skipSpaces()
+
+		// escaped string values
+		if strings.HasPrefix(p.input[p.pos:], `e"`) {
+			p.pos++
+		}
+
+		if p.eof() || p.input[p.pos] != '"' {
+			return nil, p.errorf("expected quoted value for key %q", expr.Key)
+		}
+
+		p.pos++
+
+		var value strings.Builder
+
+		for {
+			if p.eof() {
+				return nil, p.errorf("unterminated value for key %q", expr.Key)
+			}
+
+			c := p.input[p.pos]
+			p.pos++
+
+			if c == '"' {
+				break
+			}
+
+			if c == '\\' && !p.eof() && p.input[p.pos] == '"' {
+				c = '"'
+				p.pos++
+			}
+
+			value.WriteByte(c)
+		}
+
+		expr.Value = value.String()
+
+		rule = append(rule, expr)
+
+		p.skipSpaces()
+
+		if p.eof() {
+			break
+		}
+
+		if p.input[p.pos] != ',' {
+			return nil, p.errorf("expected ',' after value of key %q", expr.Key)
+		}
+
+		p.pos++
+
+		p.skipSpaces()
+	}
+
+	return rule, nil
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) skipSpaces() {
+	for !p.eof() && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("at position %d: %s", p.pos+1, fmt.Sprintf(format, args...))
+}
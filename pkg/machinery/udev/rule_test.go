@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package udev_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/udev"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		rule string
+
+		expected      udev.Rule
+		expectedError string
+	}{
+		{
+			name: "simple",
+			rule: `SUBSYSTEM=="drm", KERNEL=="renderD*", GROUP="44", MODE="0660"`,
+			expected: udev.Rule{
+				{Key: "SUBSYSTEM", Op: "==", Value: "drm"},
+				{Key: "KERNEL", Op: "==", Value: "renderD*"},
+				{Key: "GROUP", Op: "=", Value: "44"},
+				{Key: "MODE", Op: "=", Value: "0660"},
+			},
+		},
+		{
+			name: "attributes and continuation",
+			rule: "ACTION==\"add\", ATTR{queue/rotational}!=\"1\",\nENV{ID_FOO}+=\"a \\\"b\\\"\", RUN:=e\"/bin/true\"",
+			expected: udev.Rule{
+				{Key: "ACTION", Op: "==", Value: "add"},
+				{Key: "ATTR", Attr: "queue/rotational", Op: "!=", Value: "1"},
+				{Key: "ENV", Attr: "ID_FOO", Op: "+=", Value: `a "b"`},
+				{Key: "RUN", Op: ":=", Value: "/bin/true"},
+			},
+		},
+		{
+			name:          "empty",
+			rule:          "  ",
+			expectedError: "rule is empty",
+		},
+		{
+			name:          "comment",
+			rule:          `# SUBSYSTEM=="drm"`,
+			expectedError: "comments are not supported",
+		},
+		{
+			name:          "no operator",
+			rule:          `SUBSYSTEM "drm"`,
+			expectedError: `at position 11: expected operator after key "SUBSYSTEM"`,
+		},
+		{
+			name:          "unquoted value",
+			rule:          `SUBSYSTEM==drm`,
+			expectedError: `at position 12: expected quoted value for key "SUBSYSTEM"`,
+		},
+		{
+			name:          "unterminated value",
+			rule:          `SUBSYSTEM=="drm, GROUP="44"`,
+			expectedError: `at position 25: expected ',' after value of key "SUBSYSTEM"`,
+		},
+		{
+			name:          "missing separator",
+			rule:          `SUBSYSTEM=="drm" GROUP="44"`,
+			expectedError: `at position 18: expected ',' after value of key "SUBSYSTEM"`,
+		},
+		{
+			name:          "lowercase key",
+			rule:          `subsystem=="drm"`,
+			expectedError: "at position 1: expected key",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := udev.Parse(tt.rule)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rule)
+		})
+	}
+}
+
+func TestSubsystems(t *testing.T) {
+	rule, err := udev.Parse(`ACTION=="add", SUBSYSTEM=="block|net", GROUP="44"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"block", "net"}, rule.Subsystems())
+
+	rule, err = udev.Parse(`SUBSYSTEM=="bl*", GROUP="44"`)
+	require.NoError(t, err)
+
+	assert.Nil(t, rule.Subsystems())
+}
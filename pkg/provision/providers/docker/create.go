@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/docker/docker/api/types"
+
 	"github.com/talos-systems/talos/pkg/provision"
 )
 
@@ -33,6 +35,12 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		return nil, fmt.Errorf("unable to create or re-use a docker network: %w", err)
 	}
 
+	for _, extraNetwork := range request.Network.DockerExtraNetworks {
+		if _, err = p.client.NetworkInspect(ctx, extraNetwork, types.NetworkInspectOptions{}); err != nil {
+			return nil, fmt.Errorf("unable to find extra docker network %q: %w", extraNetwork, err)
+		}
+	}
+
 	var nodeInfo []provision.NodeInfo
 
 	fmt.Fprintln(options.LogWriter, "creating master nodes")
@@ -27,6 +27,12 @@ func (p *provisioner) Destroy(ctx context.Context, cluster provision.Cluster, op
 		return err
 	}
 
+	fmt.Fprintln(options.LogWriter, "destroying volumes")
+
+	if err := destroyVolumes(ctx, p.client, cluster.Info().ClusterName); err != nil {
+		return err
+	}
+
 	fmt.Println("destroying network", cluster.Info().Network.Name)
 
 	return p.destroyNetwork(ctx, cluster.Info().Network.Name)
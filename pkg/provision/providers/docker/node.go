@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1/machine"
-	"github.com/talos-systems/talos/pkg/machinery/constants"
 	"github.com/talos-systems/talos/pkg/provision"
 )
 
@@ -85,14 +84,18 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 			"talos.type":         nodeReq.Type.String(),
 		},
 		Volumes: map[string]struct{}{
-			"/var/lib/containerd": {},
-			"/var/lib/kubelet":    {},
-			"/etc/cni":            {},
-			"/run":                {},
-			"/system":             {},
+			"/run":    {},
+			"/system": {},
 		},
 	}
 
+	// Create the volumes for the persistent node state.
+
+	mounts, err := createVolumes(ctx, p.client, clusterReq.Name, nodeReq.Name)
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
 	// Create the host config.
 
 	hostConfig := &container.HostConfig{
@@ -102,6 +105,11 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 			NanoCPUs: nodeReq.NanoCPUs,
 			Memory:   nodeReq.Memory,
 		},
+		Mounts: mounts,
+		// restart the node on reboot, and when Docker or the host restarts
+		RestartPolicy: container.RestartPolicy{
+			Name: "unless-stopped",
+		},
 	}
 
 	if !clusterReq.Network.DockerDisableIPv6 {
@@ -139,8 +147,6 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 
 		hostConfig.PortBindings = generatedPortMap.portBindings
 
-		containerConfig.Volumes[constants.EtcdDataPath] = struct{}{}
-
 		if nodeReq.IPs == nil {
 			return provision.NodeInfo{}, errors.New("an IP address must be provided when creating a master node")
 		}
@@ -156,6 +162,13 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		return provision.NodeInfo{}, err
 	}
 
+	// Attach the container to the extra networks, as only a single network can be specified on create.
+	for _, extraNetwork := range clusterReq.Network.DockerExtraNetworks {
+		if err = p.client.NetworkConnect(ctx, extraNetwork, resp.ID, &network.EndpointSettings{}); err != nil {
+			return provision.NodeInfo{}, fmt.Errorf("error attaching node %q to network %q: %w", nodeReq.Name, extraNetwork, err)
+		}
+	}
+
 	// Start the container.
 	err = p.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{})
 	if err != nil {
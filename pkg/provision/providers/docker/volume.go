@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// nodeVolume is a node path backed by the named volume, so that the contents survive container restarts.
+type nodeVolume struct {
+	name string
+	path string
+}
+
+var nodeVolumes = []nodeVolume{
+	// STATE partition equivalent
+	{name: "state", path: constants.StateMountPoint},
+	// EPHEMERAL partition equivalent
+	{name: "var", path: constants.EphemeralMountPoint},
+	{name: "cni", path: "/etc/cni"},
+}
+
+// createVolumes creates (or re-uses) the named volumes for the node and returns the mounts for them.
+//
+// Docker returns the existing volume if the volume with the same name already exists, so the volume
+// is re-used only if it was created for the same node of the same cluster, otherwise the node would
+// boot with the state of some other node.
+func createVolumes(ctx context.Context, cli client.VolumeAPIClient, clusterName, nodeName string) ([]mount.Mount, error) {
+	mounts := make([]mount.Mount, 0, len(nodeVolumes))
+
+	labels := map[string]string{
+		"talos.owned":        "true",
+		"talos.cluster.name": clusterName,
+		"talos.node.name":    nodeName,
+	}
+
+	for _, nodeVolume := range nodeVolumes {
+		vol, err := cli.VolumeCreate(ctx, volume.VolumeCreateBody{
+			Name:   fmt.Sprintf("%s-%s", nodeName, nodeVolume.name),
+			Labels: labels,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating volume for %q: %w", nodeVolume.path, err)
+		}
+
+		for key, value := range labels {
+			if vol.Labels[key] != value {
+				return nil, fmt.Errorf("volume %q already exists and doesn't belong to the node %q of the cluster %q", vol.Name, nodeName, clusterName)
+			}
+		}
+
+		mounts = append(mounts, mount.Mount{
+			Type:   mount.TypeVolume,
+			Source: vol.Name,
+			Target: nodeVolume.path,
+		})
+	}
+
+	return mounts, nil
+}
+
+func destroyVolumes(ctx context.Context, cli client.VolumeAPIClient, clusterName string) error {
+	filters := filters.NewArgs()
+	filters.Add("label", "talos.owned=true")
+	filters.Add("label", "talos.cluster.name="+clusterName)
+
+	volumes, err := cli.VolumeList(ctx, filters)
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+
+	for _, vol := range volumes.Volumes {
+		if err = cli.VolumeRemove(ctx, vol.Name, true); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker //nolint:testpackage // to test unexported functions
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/machinery/constants"
+)
+
+// mockVolumeClient mimics Docker volume API: creating a volume with the existing name returns the existing volume.
+type mockVolumeClient struct {
+	client.VolumeAPIClient
+
+	volumes map[string]types.Volume
+}
+
+func (m *mockVolumeClient) VolumeCreate(ctx context.Context, options volume.VolumeCreateBody) (types.Volume, error) {
+	if vol, ok := m.volumes[options.Name]; ok {
+		return vol, nil
+	}
+
+	vol := types.Volume{
+		Name:   options.Name,
+		Labels: options.Labels,
+	}
+
+	m.volumes[vol.Name] = vol
+
+	return vol, nil
+}
+
+func (m *mockVolumeClient) VolumeList(ctx context.Context, filter filters.Args) (volume.VolumeListOKBody, error) {
+	var body volume.VolumeListOKBody
+
+	for name := range m.volumes {
+		vol := m.volumes[name]
+
+		if filter.MatchKVList("label", vol.Labels) {
+			body.Volumes = append(body.Volumes, &vol)
+		}
+	}
+
+	return body, nil
+}
+
+func (m *mockVolumeClient) VolumeRemove(ctx context.Context, volumeID string, force bool) error {
+	delete(m.volumes, volumeID)
+
+	return nil
+}
+
+func (m *mockVolumeClient) names() []string {
+	names := make([]string, 0, len(m.volumes))
+
+	for name := range m.volumes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func TestCreateVolumes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cli := &mockVolumeClient{volumes: map[string]types.Volume{}}
+
+	expectedMounts := []mount.Mount{
+		{Type: mount.TypeVolume, Source: "test-master-1-state", Target: constants.StateMountPoint},
+		{Type: mount.TypeVolume, Source: "test-master-1-var", Target: constants.EphemeralMountPoint},
+		{Type: mount.TypeVolume, Source: "test-master-1-cni", Target: "/etc/cni"},
+	}
+
+	mounts, err := createVolumes(ctx, cli, "test", "test-master-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedMounts, mounts)
+	assert.Equal(t, []string{"test-master-1-cni", "test-master-1-state", "test-master-1-var"}, cli.names())
+	assert.Equal(t, map[string]string{
+		"talos.owned":        "true",
+		"talos.cluster.name": "test",
+		"talos.node.name":    "test-master-1",
+	}, cli.volumes["test-master-1-state"].Labels)
+
+	// volumes are re-used when the node is re-created
+	mounts, err = createVolumes(ctx, cli, "test", "test-master-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedMounts, mounts)
+	assert.Len(t, cli.volumes, 3)
+}
+
+func TestCreateVolumesConflict(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name   string
+		labels map[string]string
+	}{
+		{
+			name: "other cluster",
+			labels: map[string]string{
+				"talos.owned":        "true",
+				"talos.cluster.name": "other",
+				"talos.node.name":    "test-master-1",
+			},
+		},
+		{
+			name: "not owned",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := &mockVolumeClient{
+				volumes: map[string]types.Volume{
+					"test-master-1-state": {
+						Name:   "test-master-1-state",
+						Labels: tt.labels,
+					},
+				},
+			}
+
+			_, err := createVolumes(context.Background(), cli, "test", "test-master-1")
+			assert.EqualError(t, err, `volume "test-master-1-state" already exists and doesn't belong to the node "test-master-1" of the cluster "test"`)
+			assert.Equal(t, tt.labels, cli.volumes["test-master-1-state"].Labels)
+		})
+	}
+}
+
+func TestDestroyVolumes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cli := &mockVolumeClient{
+		volumes: map[string]types.Volume{
+			"unrelated": {
+				Name: "unrelated",
+				Labels: map[string]string{
+					"talos.cluster.name": "test",
+				},
+			},
+		},
+	}
+
+	for _, node := range []struct {
+		cluster, name string
+	}{
+		{"test", "test-master-1"},
+		{"test", "test-worker-1"},
+		{"other", "other-master-1"},
+	} {
+		_, err := createVolumes(ctx, cli, node.cluster, node.name)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, destroyVolumes(ctx, cli, "test"))
+
+	assert.Equal(t, []string{"other-master-1-cni", "other-master-1-state", "other-master-1-var", "unrelated"}, cli.names())
+}
@@ -26,6 +26,15 @@ import (
 	"github.com/talos-systems/talos/pkg/provision"
 )
 
+// WithPXEBoot sets the next server and the boot filename in the DHCPv4 response.
+func WithPXEBoot(tftpServer, bootFilename string) dhcpv4.Modifier {
+	return func(resp *dhcpv4.DHCPv4) {
+		resp.ServerIPAddr = net.ParseIP(tftpServer)
+		resp.UpdateOption(dhcpv4.OptTFTPServerName(tftpServer))
+		resp.UpdateOption(dhcpv4.OptBootFileName(bootFilename))
+	}
+}
+
 //nolint:gocyclo
 func handlerDHCP4(serverIP net.IP, statePath string) server4.Handler {
 	return func(conn net.PacketConn, peer net.Addr, m *dhcpv4.DHCPv4) {
@@ -81,9 +90,7 @@ func handlerDHCP4(serverIP net.IP, statePath string) server4.Handler {
 			if match.TFTPServer != "" {
 				log.Printf("sending PXE response to %s: %s/%s", m.ClientHWAddr, match.TFTPServer, match.IPXEBootFilename)
 
-				resp.ServerIPAddr = net.ParseIP(match.TFTPServer)
-				resp.UpdateOption(dhcpv4.OptTFTPServerName(match.TFTPServer))
-				resp.UpdateOption(dhcpv4.OptBootFileName(match.IPXEBootFilename))
+				WithPXEBoot(match.TFTPServer, match.IPXEBootFilename)(resp)
 			}
 		}
 
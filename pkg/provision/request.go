@@ -57,6 +57,8 @@ type NetworkRequest struct {
 
 	// Docker-specific parameters.
 	DockerDisableIPv6 bool
+	// Names of the existing Docker networks to attach the nodes to in addition to the cluster network.
+	DockerExtraNetworks []string
 }
 
 // NodeRequests is a list of NodeRequest.